@@ -1,39 +1,29 @@
 package main
 
 import (
+	"log"
+
 	"go.uber.org/fx"
 
 	"github.com/AI1411/fullstack-react-go/internal/di"
+	"github.com/AI1411/fullstack-react-go/internal/env"
 	"github.com/AI1411/fullstack-react-go/internal/server"
 )
 
-// Swagger メタデータ
-// @title           農業災害支援システム API
-// @version         1.0
-// @description     農業災害の報告と支援申請を管理するためのAPI
-// @termsOfService  http://swagger.io/terms/
-
-// @contact.name   API Support
-// @contact.url    http://www.swagger.io/support
-// @contact.email  support@swagger.io
-
-// @license.name  Apache 2.0
-// @license.url   http://www.apache.org/licenses/LICENSE-2.0.html
-
-// @host      localhost:8080
-// @BasePath  /api/v1
-
-// @securityDefinitions.basic  BasicAuth
-
-// @externalDocs.description  OpenAPI
-// @externalDocs.url          https://swagger.io/resources/open-api/
-
 func main() {
+	e, err := env.NewValues()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	app := fx.New(
 		di.Provider(),
-		fx.Invoke(server.RegisterRoutes),
+		// 停止処理はフックの登録と逆順に実行される。HTTPサーバーを最後に登録し、最初にリクエストの受付を止めてから
+		// バックグラウンドのワーカーを止め、最後にDB接続を閉じる
+		fx.Invoke(server.RegisterScheduler, server.RegisterJobWorker, server.RegisterOutboxRelay, server.RegisterMetrics, server.RegisterRoutes),
+		fx.StopTimeout(e.ServerShutdownTimeout),
 	)
 
-	// Run the application
+	// Run the application. SIGINT/SIGTERM stops it gracefully.
 	app.Run()
 }
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/AI1411/fullstack-react-go/internal/openapi"
+	"github.com/AI1411/fullstack-react-go/internal/server"
+)
+
+// OpenAPIドキュメントをルートの定義から生成し、フロントエンドの型生成に使うファイルへ書き出す
+func main() {
+	out := flag.String("out", "./docs/api/openapi.json", "output file")
+	flag.Parse()
+
+	b, err := openapi.Marshal(server.APISpec())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, b, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -2,20 +2,36 @@ package di
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
 	"github.com/AI1411/fullstack-react-go/internal/env"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/auth"
 	"github.com/AI1411/fullstack-react-go/internal/infra/datastore"
 	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+	"github.com/AI1411/fullstack-react-go/internal/infra/health"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/infra/mail"
+	"github.com/AI1411/fullstack-react-go/internal/infra/metrics"
+	"github.com/AI1411/fullstack-react-go/internal/infra/outbox"
+	"github.com/AI1411/fullstack-react-go/internal/infra/queue"
+	"github.com/AI1411/fullstack-react-go/internal/infra/ratelimit"
+	"github.com/AI1411/fullstack-react-go/internal/infra/scheduler"
+	"github.com/AI1411/fullstack-react-go/internal/infra/storage"
+	"github.com/AI1411/fullstack-react-go/internal/infra/tracing"
+	"github.com/AI1411/fullstack-react-go/internal/infra/webhook"
 	middleware2 "github.com/AI1411/fullstack-react-go/internal/server/middleware"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	"github.com/AI1411/fullstack-react-go/migrations"
 )
 
 // ProvideLogger creates a new logger instance
@@ -28,8 +44,39 @@ func ProvideEnvValues() (*env.Values, error) {
 	return env.NewValues()
 }
 
+// ProvideMetrics creates the Prometheus metrics of the API
+func ProvideMetrics() *metrics.Metrics {
+	return metrics.New()
+}
+
+// ProvideTracerProvider creates the tracer provider of the API and makes it the global one
+func ProvideTracerProvider(lc fx.Lifecycle, l *logger.Logger, env *env.Values) (trace.TracerProvider, error) {
+	tp, err := tracing.NewTracerProvider(context.Background(), tracing.Config{
+		ServiceName:  env.TracingServiceName,
+		Environment:  env.Env,
+		Exporter:     env.TracingExporter,
+		OTLPEndpoint: env.TracingOTLPEndpoint,
+		SampleRatio:  env.TracingSampleRatio,
+		Output:       os.Stdout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tracing.SetGlobal(tp)
+
+	// 他のフックより先に登録し、最後に停止して残りのスパンを送信する
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			l.Info("Flushing traces")
+			return tp.Shutdown(ctx)
+		},
+	})
+
+	return tp, nil
+}
+
 // ProvideDBClient creates a new database client
-func ProvideDBClient(lc fx.Lifecycle, l *logger.Logger) (db.Client, error) {
+func ProvideDBClient(lc fx.Lifecycle, l *logger.Logger, m *metrics.Metrics, tp trace.TracerProvider) (db.Client, error) {
 	e, err := env.NewValues()
 	if err != nil {
 		l.Error("failed to load environment variables", "error", err)
@@ -46,23 +93,63 @@ func ProvideDBClient(lc fx.Lifecycle, l *logger.Logger) (db.Client, error) {
 		MaxIdleConns:    e.ConnectionMaxIdle,
 		MaxOpenConns:    e.ConnectionMaxOpen,
 		ConnMaxLifetime: e.ConnectionMaxLifetime,
+		QueryObserver:   m,
+		TracerProvider:  tp,
 	}, l)
 	if err != nil {
 		l.Error("failed to connect to database", "error", err)
 		return nil, err
 	}
 
+	// 接続プールの統計をメトリクスとして公開する
+	sqlDB, err := dbClient.Conn(context.Background()).DB()
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Register(collectors.NewDBStatsCollector(sqlDB, e.DatabaseName)); err != nil {
+		return nil, err
+	}
+
 	// Register lifecycle hooks for the database client
 	lc.Append(fx.Hook{
 		OnStop: func(ctx context.Context) error {
 			l.Info("Closing database connection")
-			return nil // Add proper cleanup if needed
+			return dbClient.Close()
 		},
 	})
 
 	return dbClient, nil
 }
 
+// ProvideHealthChecker creates the checker of the dependencies readiness depends on
+func ProvideHealthChecker(
+	env *env.Values,
+	dbClient db.Client,
+	mailer domain.Mailer,
+	fileStorage domain.FileStorage,
+) (*health.Checker, error) {
+	schemaVersion, err := migrations.LatestVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	return health.NewChecker(env.HealthCheckTimeout,
+		health.Check{Name: "database", Run: dbClient.Ping},
+		health.Check{Name: "migrations", Run: func(ctx context.Context) error {
+			return datastore.CheckSchemaVersion(ctx, dbClient, schemaVersion)
+		}},
+		// メールはジョブキュー経由で再送されるため、送信できなくてもリクエストは受け付ける
+		health.Check{Name: "mail", Optional: true, CacheFor: env.HealthMailCacheTTL, Run: mailer.Ping},
+		// ファイルを保存できなくても、アップロード以外のリクエストは処理できる
+		health.Check{Name: "storage", Optional: true, Run: fileStorage.Ping},
+	), nil
+}
+
+// ProvideTransactor provides the database client as the transactor that use cases run their units of work with
+func ProvideTransactor(dbClient db.Client) domain.Transactor {
+	return dbClient
+}
+
 func ProvideJWTClient(env *env.Values) (domain.JWT, error) {
 	jwtConfig := auth.JWTConfig{
 		SecretKey:  env.Auth.JWTSecret,
@@ -74,16 +161,78 @@ func ProvideJWTClient(env *env.Values) (domain.JWT, error) {
 }
 
 // ProvideGinEngine creates and configures a new Gin engine
-func ProvideGinEngine(l *logger.Logger) *gin.Engine {
+func ProvideGinEngine(
+	l *logger.Logger,
+	env *env.Values,
+	m *metrics.Metrics,
+	tp trace.TracerProvider,
+	idempotencyKeyRepo domain.IdempotencyKeyRepository,
+	rateLimitRepo domain.RateLimitRepository,
+	rateLimitConfig middleware2.RateLimitConfig,
+) (*gin.Engine, error) {
 	r := gin.Default()
 
+	// 信頼するプロキシ経由のリクエストだけX-Forwarded-ForをクライアントIPとして使う
+	if err := r.SetTrustedProxies(env.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
 	// ミドルウェアの設定
 	r.Use(gin.Logger())
 	r.Use(gin.Recovery())
-	r.Use(middleware2.NewLogging(l))
+	r.Use(middleware2.NewTracing(tp))
+	r.Use(middleware2.NewLogging(l, middleware2.LoggingConfig{
+		RedactHeaders:     env.LogRedactHeaders,
+		RedactFields:      env.LogRedactFields,
+		MaxBodyBytes:      env.LogBodyMaxBytes,
+		SuccessSampleRate: env.LogSuccessSampleRate,
+	}))
+	r.Use(middleware2.NewMetrics(m))
 	r.Use(middleware2.CORSMiddleware())
+	r.Use(middleware2.NewLocale())
+	r.Use(middleware2.NewRateLimit(l, rateLimitRepo, rateLimitConfig))
+	r.Use(middleware2.NewIdempotency(l, idempotencyKeyRepo, env.IdempotencyKeyTTL, env.JWTSecret))
+	// ハンドラーのエラーをレスポンスにするため最後に登録する
+	r.Use(middleware2.NewErrorHandler(l))
+
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(middleware2.NotFound)
+	r.NoMethod(middleware2.MethodNotAllowed)
+
+	return r, nil
+}
+
+// ProvideRateLimitConfig parses the rate limits of the route groups
+func ProvideRateLimitConfig(env *env.Values) (middleware2.RateLimitConfig, error) {
+	limits := make(map[string]model.RateLimit)
+	for group, value := range map[string]string{
+		middleware2.RateLimitGroupAuth:    env.RateLimitAuth,
+		middleware2.RateLimitGroupList:    env.RateLimitList,
+		middleware2.RateLimitGroupDefault: env.RateLimitDefault,
+	} {
+		if value == "" {
+			continue
+		}
+		limit, err := model.ParseRateLimit(value)
+		if err != nil {
+			return middleware2.RateLimitConfig{}, fmt.Errorf("invalid %s rate limit: %w", group, err)
+		}
+		limits[group] = limit
+	}
 
-	return r
+	return middleware2.RateLimitConfig{Limits: limits, JWTSecret: env.JWTSecret}, nil
+}
+
+// ProvideRateLimitRepository creates the store of the rate limiter selected by the environment
+func ProvideRateLimitRepository(env *env.Values, dbClient db.Client) (domain.RateLimitRepository, error) {
+	switch env.RateLimitStore {
+	case "memory":
+		return ratelimit.NewMemoryStore(), nil
+	case "postgres":
+		return datastore.NewRateLimitRepository(context.Background(), dbClient), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit store %q", env.RateLimitStore)
+	}
 }
 
 // ProvideDisasterRepository creates a new disaster repository
@@ -115,9 +264,56 @@ func ProvideEmailVarificationTokenRepository(ctx context.Context, dbClient db.Cl
 	return datastore.NewEmailVarificationTokenRepository(ctx, dbClient)
 }
 
+// ProvideMunicipalityRepository creates a new municipality repository
+func ProvideMunicipalityRepository(dbClient db.Client) domain.MunicipalityRepository {
+	return datastore.NewMunicipalityRepository(context.Background(), dbClient)
+}
+
+// ProvideWorkCategoryRepository creates a new work category repository
+func ProvideWorkCategoryRepository(dbClient db.Client) domain.WorkCategoryRepository {
+	return datastore.NewWorkCategoryRepository(context.Background(), dbClient)
+}
+
+// ProvideIdempotencyKeyRepository creates a new idempotency key repository
+func ProvideIdempotencyKeyRepository(dbClient db.Client) domain.IdempotencyKeyRepository {
+	return datastore.NewIdempotencyKeyRepository(context.Background(), dbClient)
+}
+
+// ProvideAssessmentRepository creates a new assessment repository
+func ProvideAssessmentRepository(dbClient db.Client) domain.AssessmentRepository {
+	return datastore.NewAssessmentRepository(context.Background(), dbClient)
+}
+
+// ProvideBusinessMetricsRepository creates a new business metrics repository
+func ProvideBusinessMetricsRepository(dbClient db.Client) domain.BusinessMetricsRepository {
+	return datastore.NewBusinessMetricsRepository(context.Background(), dbClient)
+}
+
+// ProvideTrashRepository creates a new trash repository
+func ProvideTrashRepository(dbClient db.Client) domain.TrashRepository {
+	return datastore.NewTrashRepository(context.Background(), dbClient)
+}
+
+// ProvideTrashUseCase creates a new trash use case
+func ProvideTrashUseCase(repo domain.TrashRepository) usecase.TrashUseCase {
+	return usecase.NewTrashUseCase(repo)
+}
+
+// ProvideTrashHandler creates a new trash handler
+func ProvideTrashHandler(l *logger.Logger, usecase usecase.TrashUseCase) handler.Trash {
+	return handler.NewTrashHandler(l, usecase)
+}
+
 // ProvideDisasterUseCase creates a new disaster use case
-func ProvideDisasterUseCase(repo datastore.DisasterRepository) usecase.DisasterUseCase {
-	return usecase.NewDisasterUseCase(repo)
+func ProvideDisasterUseCase(
+	repo datastore.DisasterRepository,
+	municipalityRepo domain.MunicipalityRepository,
+	workCategoryRepo domain.WorkCategoryRepository,
+	assessmentRepo domain.AssessmentRepository,
+	supportApplicationRepo domain.SupportApplicationRepository,
+	transactor domain.Transactor,
+) usecase.DisasterUseCase {
+	return usecase.NewDisasterUseCase(repo, municipalityRepo, workCategoryRepo, assessmentRepo, supportApplicationRepo, transactor)
 }
 
 // ProvidePrefectureUseCase creates a new prefecture use case
@@ -195,9 +391,96 @@ func ProvideNotificationRepository(dbClient db.Client) domain.NotificationReposi
 	return datastore.NewNotificationRepository(context.Background(), dbClient)
 }
 
+// ProvideBroadcastNotificationRepository creates a new broadcast notification repository
+func ProvideBroadcastNotificationRepository(dbClient db.Client) domain.BroadcastNotificationRepository {
+	return datastore.NewBroadcastNotificationRepository(context.Background(), dbClient)
+}
+
+// ProvideRelatedEntityRepository creates a new related entity repository
+func ProvideRelatedEntityRepository(dbClient db.Client) domain.RelatedEntityRepository {
+	return datastore.NewRelatedEntityRepository(context.Background(), dbClient)
+}
+
+// ProvideNotificationStream creates a notification listener that runs for the lifetime of the app
+func ProvideNotificationStream(lc fx.Lifecycle, l *logger.Logger, dbClient db.Client) domain.NotificationStream {
+	listener := datastore.NewNotificationListener(l, dbClient)
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			listener.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			l.Info("Stopping notification listener")
+			return listener.Stop(ctx)
+		},
+	})
+
+	return listener
+}
+
 // ProvideNotificationUseCase creates a new notification use case
-func ProvideNotificationUseCase(repo domain.NotificationRepository) usecase.NotificationUseCase {
-	return usecase.NewNotificationUseCase(repo)
+func ProvideNotificationUseCase(
+	repo domain.NotificationRepository,
+	stream domain.NotificationStream,
+	dispatcher usecase.NotificationDispatcher,
+	broadcastRepo domain.BroadcastNotificationRepository,
+	relatedEntityRepo domain.RelatedEntityRepository,
+	jobRepo domain.JobRepository,
+	transactor domain.Transactor,
+) usecase.NotificationUseCase {
+	return usecase.NewNotificationUseCase(repo, stream, dispatcher, broadcastRepo, relatedEntityRepo, jobRepo, transactor)
+}
+
+// ProvideMailer creates a new SMTP mailer
+func ProvideMailer(env *env.Values) domain.Mailer {
+	return mail.NewSMTPMailer(env)
+}
+
+// ProvideFileStorage creates the storage of the uploaded files
+func ProvideFileStorage(env *env.Values) domain.FileStorage {
+	return storage.NewLocalStorage(env)
+}
+
+// ProvideWebhookSender creates a new webhook sender
+func ProvideWebhookSender(env *env.Values) domain.WebhookSender {
+	return webhook.NewHTTPSender(env)
+}
+
+// ProvideNotificationPreferenceRepository creates a new notification preference repository
+func ProvideNotificationPreferenceRepository(dbClient db.Client) domain.NotificationPreferenceRepository {
+	return datastore.NewNotificationPreferenceRepository(context.Background(), dbClient)
+}
+
+// ProvideNotificationDigestRepository creates a new notification digest repository
+func ProvideNotificationDigestRepository(dbClient db.Client) domain.NotificationDigestRepository {
+	return datastore.NewNotificationDigestRepository(context.Background(), dbClient)
+}
+
+// ProvideNotificationDispatcher creates a new notification dispatcher
+func ProvideNotificationDispatcher(
+	notificationRepo domain.NotificationRepository,
+	preferenceRepo domain.NotificationPreferenceRepository,
+	digestRepo domain.NotificationDigestRepository,
+	userRepo domain.UserRepository,
+	emailHistoryRepo domain.EmailHistoryRepository,
+	mailer domain.Mailer,
+	webhookSender domain.WebhookSender,
+	jobRepo domain.JobRepository,
+) usecase.NotificationDispatcher {
+	return usecase.NewNotificationDispatcher(
+		notificationRepo, preferenceRepo, digestRepo, userRepo, emailHistoryRepo, mailer, webhookSender, jobRepo,
+	)
+}
+
+// ProvideNotificationPreferenceUseCase creates a new notification preference use case
+func ProvideNotificationPreferenceUseCase(repo domain.NotificationPreferenceRepository) usecase.NotificationPreferenceUseCase {
+	return usecase.NewNotificationPreferenceUseCase(repo)
+}
+
+// ProvideNotificationPreferenceHandler creates a new notification preference handler
+func ProvideNotificationPreferenceHandler(l *logger.Logger, usecase usecase.NotificationPreferenceUseCase) handler.NotificationPreference {
+	return handler.NewNotificationPreferenceHandler(l, usecase)
 }
 
 // ProvideNotificationHandler creates a new notification handler
@@ -234,8 +517,15 @@ func ProvideEmailHistoryRepository(client db.Client) domain.EmailHistoryReposito
 }
 
 // ProvideUserUseCase creates a new user usecase
-func ProvideUserUseCase(repo domain.UserRepository, emailRepo domain.EmailHistoryRepository, emailVarificationTokenRepo domain.EmailVarificationTokenRepository) usecase.UserUseCase {
-	return usecase.NewUserUseCase(repo, emailRepo, emailVarificationTokenRepo)
+func ProvideUserUseCase(
+	transactor domain.Transactor,
+	repo domain.UserRepository,
+	emailRepo domain.EmailHistoryRepository,
+	emailVarificationTokenRepo domain.EmailVarificationTokenRepository,
+	jobRepo domain.JobRepository,
+	mailer domain.Mailer,
+) usecase.UserUseCase {
+	return usecase.NewUserUseCase(transactor, repo, emailRepo, emailVarificationTokenRepo, jobRepo, mailer)
 }
 
 // ProvideUserHandler creates a new user handler
@@ -253,6 +543,124 @@ func ProvideAuthHandler(l *logger.Logger, env *env.Values, userUseCase usecase.U
 	return handler.NewAuthHandler(l, env, userUseCase, authUsecase, emailVarificationTokenUsecase)
 }
 
+// ProvideJobRunRepository creates a new job run repository
+func ProvideJobRunRepository(dbClient db.Client) domain.JobRunRepository {
+	return datastore.NewJobRunRepository(context.Background(), dbClient)
+}
+
+// ProvideJobLocker creates a job locker backed by Postgres advisory locks
+func ProvideJobLocker(l *logger.Logger, dbClient db.Client) domain.JobLocker {
+	return datastore.NewAdvisoryLocker(l, dbClient)
+}
+
+// ProvideScheduler creates a new job scheduler
+func ProvideScheduler(l *logger.Logger, env *env.Values, locker domain.JobLocker, jobRunRepo domain.JobRunRepository) (*scheduler.Scheduler, error) {
+	location, err := time.LoadLocation(env.SchedulerTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load scheduler timezone: %w", err)
+	}
+
+	return scheduler.New(l, locker, jobRunRepo, location), nil
+}
+
+// ProvideReminderUseCase creates a new reminder use case
+func ProvideReminderUseCase(
+	assessmentRepo domain.AssessmentRepository,
+	supportApplicationRepo domain.SupportApplicationRepository,
+	reminderLogRepo domain.ReminderLogRepository,
+	dispatcher usecase.NotificationDispatcher,
+	transactor domain.Transactor,
+) usecase.ReminderUseCase {
+	return usecase.NewReminderUseCase(assessmentRepo, supportApplicationRepo, reminderLogRepo, dispatcher, transactor)
+}
+
+// ProvideReminderLogRepository creates a new reminder log repository
+func ProvideReminderLogRepository(dbClient db.Client) domain.ReminderLogRepository {
+	return datastore.NewReminderLogRepository(context.Background(), dbClient)
+}
+
+// ProvideJobRepository creates a new job repository
+func ProvideJobRepository(dbClient db.Client) domain.JobRepository {
+	return datastore.NewJobRepository(context.Background(), dbClient)
+}
+
+// ProvideJobWorker creates the background job worker
+func ProvideJobWorker(l *logger.Logger, env *env.Values, jobRepo domain.JobRepository) *queue.Worker {
+	return queue.New(l, jobRepo, queue.Config{
+		Concurrency:  env.JobWorkerConcurrency,
+		PollInterval: env.JobPollInterval,
+		LockTimeout:  env.JobLockTimeout,
+		BackoffBase:  env.JobBackoffBase,
+		BackoffMax:   env.JobBackoffMax,
+	})
+}
+
+// ProvideOutboxRepository creates a new outbox repository
+func ProvideOutboxRepository(dbClient db.Client) domain.OutboxRepository {
+	return datastore.NewOutboxRepository(context.Background(), dbClient)
+}
+
+// ProvideOutboxRelay creates the relay that publishes outbox events to the in-process subscribers
+func ProvideOutboxRelay(l *logger.Logger, env *env.Values, outboxRepo domain.OutboxRepository) *outbox.Relay {
+	return outbox.New(l, outboxRepo, outbox.Config{
+		PollInterval: env.OutboxPollInterval,
+		BatchSize:    env.OutboxBatchSize,
+		LeaseTimeout: env.OutboxLeaseTimeout,
+		BackoffBase:  env.OutboxBackoffBase,
+		BackoffMax:   env.OutboxBackoffMax,
+		MaxAttempts:  env.OutboxMaxAttempts,
+	})
+}
+
+// ProvideJobUseCase creates a new job use case
+func ProvideJobUseCase(repo domain.JobRepository) usecase.JobUseCase {
+	return usecase.NewJobUseCase(repo)
+}
+
+// ProvideJobHandler creates a new job handler
+func ProvideJobHandler(l *logger.Logger, usecase usecase.JobUseCase) handler.Job {
+	return handler.NewJobHandler(l, usecase)
+}
+
+// ProvideWebhookSubscriptionRepository creates a new webhook subscription repository
+func ProvideWebhookSubscriptionRepository(dbClient db.Client) domain.WebhookSubscriptionRepository {
+	return datastore.NewWebhookSubscriptionRepository(context.Background(), dbClient)
+}
+
+// ProvideWebhookDeliveryRepository creates a new webhook delivery repository
+func ProvideWebhookDeliveryRepository(dbClient db.Client) domain.WebhookDeliveryRepository {
+	return datastore.NewWebhookDeliveryRepository(context.Background(), dbClient)
+}
+
+// ProvideWebhookDispatcher creates a new webhook dispatcher
+func ProvideWebhookDispatcher(
+	subscriptionRepo domain.WebhookSubscriptionRepository,
+	deliveryRepo domain.WebhookDeliveryRepository,
+	jobRepo domain.JobRepository,
+	webhookSender domain.WebhookSender,
+) usecase.WebhookDispatcher {
+	return usecase.NewWebhookDispatcher(subscriptionRepo, deliveryRepo, jobRepo, webhookSender)
+}
+
+// ProvideWebhookEventSubscriber creates the subscriber that forwards domain events to webhooks
+func ProvideWebhookEventSubscriber(dispatcher usecase.WebhookDispatcher) usecase.WebhookEventSubscriber {
+	return usecase.NewWebhookEventSubscriber(dispatcher)
+}
+
+// ProvideWebhookUseCase creates a new webhook use case
+func ProvideWebhookUseCase(
+	subscriptionRepo domain.WebhookSubscriptionRepository,
+	deliveryRepo domain.WebhookDeliveryRepository,
+	dispatcher usecase.WebhookDispatcher,
+) usecase.WebhookUseCase {
+	return usecase.NewWebhookUseCase(subscriptionRepo, deliveryRepo, dispatcher)
+}
+
+// ProvideWebhookHandler creates a new webhook handler
+func ProvideWebhookHandler(l *logger.Logger, usecase usecase.WebhookUseCase) handler.Webhook {
+	return handler.NewWebhookHandler(l, usecase)
+}
+
 // ProvideAppContext provides a background context for the application
 func ProvideAppContext() context.Context {
 	return context.Background()
@@ -262,16 +670,28 @@ func Provider() fx.Option {
 	return fx.Provide(
 		ProvideAppContext,
 		ProvideLogger,
+		ProvideMetrics,
+		ProvideTracerProvider,
 		ProvideEnvValues,
 		ProvideDBClient,
+		ProvideTransactor,
+		ProvideHealthChecker,
 		ProvideGinEngine,
 		ProvideDisasterRepository,
+		ProvideMunicipalityRepository,
+		ProvideWorkCategoryRepository,
+		ProvideIdempotencyKeyRepository,
+		ProvideRateLimitRepository,
+		ProvideRateLimitConfig,
 		ProvidePrefectureRepository,
 		ProvideTimelineRepository,
 		ProvideSupportApplicationRepository,
 		ProvideDamageLevelRepository,
 		ProvideFacilityEquipmentRepository,
 		ProvideNotificationRepository,
+		ProvideNotificationStream,
+		ProvideBroadcastNotificationRepository,
+		ProvideRelatedEntityRepository,
 		ProvideOrganizationRepository,
 		ProvideUserRepository,
 		ProvideEmailHistoryRepository,
@@ -298,5 +718,35 @@ func Provider() fx.Option {
 		ProvideAuthHandler,
 		ProvideEmailVarificationTokenRepository,
 		ProvideEmailVarificationTokenUseCase,
+		ProvideAssessmentRepository,
+		ProvideBusinessMetricsRepository,
+		ProvideTrashRepository,
+		ProvideTrashUseCase,
+		ProvideTrashHandler,
+		ProvideMailer,
+		ProvideFileStorage,
+		ProvideWebhookSender,
+		ProvideNotificationPreferenceRepository,
+		ProvideNotificationDigestRepository,
+		ProvideNotificationDispatcher,
+		ProvideNotificationPreferenceUseCase,
+		ProvideNotificationPreferenceHandler,
+		ProvideJobRunRepository,
+		ProvideJobLocker,
+		ProvideScheduler,
+		ProvideReminderUseCase,
+		ProvideReminderLogRepository,
+		ProvideJobRepository,
+		ProvideJobWorker,
+		ProvideJobUseCase,
+		ProvideJobHandler,
+		ProvideWebhookSubscriptionRepository,
+		ProvideWebhookDeliveryRepository,
+		ProvideWebhookDispatcher,
+		ProvideWebhookUseCase,
+		ProvideWebhookEventSubscriber,
+		ProvideOutboxRepository,
+		ProvideOutboxRelay,
+		ProvideWebhookHandler,
 	)
 }
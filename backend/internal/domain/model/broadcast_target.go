@@ -0,0 +1,25 @@
+package model
+
+import "slices"
+
+// 一斉通知の配信対象の種類
+const (
+	BroadcastTargetAll          = "all"
+	BroadcastTargetRole         = "role"
+	BroadcastTargetOrganization = "organization"
+	BroadcastTargetPrefecture   = "prefecture"
+	BroadcastTargetRegion       = "region"
+)
+
+var broadcastTargetTypes = []string{
+	BroadcastTargetAll,
+	BroadcastTargetRole,
+	BroadcastTargetOrganization,
+	BroadcastTargetPrefecture,
+	BroadcastTargetRegion,
+}
+
+// IsValidBroadcastTargetType reports whether t is a known broadcast target type
+func IsValidBroadcastTargetType(t string) bool {
+	return slices.Contains(broadcastTargetTypes, t)
+}
@@ -0,0 +1,13 @@
+package model
+
+// DisasterDeleteSummary reports the records affected by deleting a disaster
+type DisasterDeleteSummary struct {
+	DisasterID string `json:"disaster_id"`
+	// Forced is true when the delete went ahead despite blocking children
+	Forced bool `json:"forced"`
+	// ApprovedAssessments and PaidApplications count the children that block the delete without force
+	ApprovedAssessments int64 `json:"approved_assessments"`
+	PaidApplications    int64 `json:"paid_applications"`
+	// DeletedChildren is the number of soft-deleted child records per table
+	DeletedChildren map[string]int64 `json:"deleted_children"`
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// バックグラウンドジョブのステータス
+const (
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusSucceeded = "succeeded"
+	JobStatusDead      = "dead"
+)
+
+// JobStatuses lists every job status
+var JobStatuses = []string{JobStatusPending, JobStatusRunning, JobStatusSucceeded, JobStatusDead}
+
+// DefaultJobMaxAttempts is how many times a job runs before it is moved to the dead state
+const DefaultJobMaxAttempts = 5
+
+// バックグラウンドジョブの種別
+const (
+	JobTypeWelcomeEmail          = "welcome_email"
+	JobTypeNotificationEmail     = "notification_email"
+	JobTypeNotificationWebhook   = "notification_webhook"
+	JobTypeBroadcastNotification = "broadcast_notification"
+)
+
+// WelcomeEmailPayload is the payload of a JobTypeWelcomeEmail job
+type WelcomeEmailPayload struct {
+	UserID string `json:"user_id"`
+	Token  string `json:"token"`
+}
+
+// NotificationEmailPayload is the payload of a JobTypeNotificationEmail job
+type NotificationEmailPayload struct {
+	UserID  string `json:"user_id"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+}
+
+// NotificationWebhookPayload is the payload of a JobTypeNotificationWebhook job
+type NotificationWebhookPayload struct {
+	URL  string          `json:"url"`
+	Body json.RawMessage `json:"body"`
+}
+
+// BroadcastNotificationPayload is the payload of a JobTypeBroadcastNotification job
+type BroadcastNotificationPayload struct {
+	BroadcastID int32 `json:"broadcast_id"`
+}
+
+// NewJob returns a pending job of the type that runs as soon as a worker is free
+func NewJob(jobType string, payload any) (*Job, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Job{
+		JobType:     jobType,
+		Payload:     string(b),
+		Status:      JobStatusPending,
+		MaxAttempts: DefaultJobMaxAttempts,
+		RunAt:       time.Now(),
+	}, nil
+}
+
+// JobFilter narrows the jobs listed in the admin API. Zero values match everything.
+type JobFilter struct {
+	Status  string
+	JobType string
+	Limit   int
+	// BeforeID returns jobs older than the ID for paging
+	BeforeID int64
+}
@@ -0,0 +1,8 @@
+package model
+
+// 定期ジョブの実行ステータス
+const (
+	JobRunStatusRunning   = "running"
+	JobRunStatusSucceeded = "succeeded"
+	JobRunStatusFailed    = "failed"
+)
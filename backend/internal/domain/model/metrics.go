@@ -0,0 +1,9 @@
+package model
+
+// GroupCount is the number of rows in one group of a GROUP BY, exported as a business metric
+type GroupCount struct {
+	// Group is the second grouping column such as the job type, or empty when the rows are grouped by status only
+	Group  string
+	Status string
+	Count  int64
+}
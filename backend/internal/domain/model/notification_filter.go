@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// NotificationFilter narrows bulk operations on a user's notifications. Unset fields match every notification.
+type NotificationFilter struct {
+	IDs              []int32
+	NotificationType *string
+	// Before matches notifications created at or before this time
+	Before *time.Time
+	// All allows an empty filter in operations that refuse one, such as deleting notifications.
+	// It must be set explicitly so that an omitted condition never deletes every notification.
+	All bool
+}
+
+// IsEmpty reports whether no condition is set
+func (f *NotificationFilter) IsEmpty() bool {
+	return len(f.IDs) == 0 && f.NotificationType == nil && f.Before == nil
+}
@@ -0,0 +1,61 @@
+package model
+
+import "slices"
+
+// 通知種別（notifications.notification_typeのCHECK制約と一致させる）
+const (
+	NotificationTypeSystem      = "システム"
+	NotificationTypeDisaster    = "災害情報"
+	NotificationTypeAssessment  = "査定"
+	NotificationTypeApplication = "申請"
+	NotificationTypeReminder    = "リマインダー"
+	NotificationTypeOther       = "その他"
+)
+
+// NotificationTypes lists every notification type in display order
+var NotificationTypes = []string{
+	NotificationTypeSystem,
+	NotificationTypeDisaster,
+	NotificationTypeAssessment,
+	NotificationTypeApplication,
+	NotificationTypeReminder,
+	NotificationTypeOther,
+}
+
+const (
+	EmailFrequencyImmediate   = "immediate"
+	EmailFrequencyDailyDigest = "daily_digest"
+)
+
+// IsValidNotificationType reports whether t is a known notification type
+func IsValidNotificationType(t string) bool {
+	return slices.Contains(NotificationTypes, t)
+}
+
+// NewDefaultNotificationPreference returns the preference applied when the user has not configured the type:
+// in-app only.
+func NewDefaultNotificationPreference(userID, notificationType string) *NotificationPreference {
+	return &NotificationPreference{
+		UserID:           userID,
+		NotificationType: notificationType,
+		InApp:            true,
+		EmailFrequency:   EmailFrequencyImmediate,
+	}
+}
+
+// 配信チャネルごとの結果
+const (
+	DeliveryStatusSent     = "sent"
+	DeliveryStatusQueued   = "queued"
+	DeliveryStatusFailed   = "failed"
+	DeliveryStatusDisabled = "disabled"
+)
+
+// NotificationDelivery reports how a notification was delivered on each channel
+type NotificationDelivery struct {
+	InApp   string
+	Email   string
+	Webhook string
+	// Failures holds the errors of the channels that failed
+	Failures []error
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ドメインイベントの種別
+const (
+	DomainEventDisasterCreated         = "DisasterCreated"
+	DomainEventDisasterStatusChanged   = "DisasterStatusChanged"
+	DomainEventApplicationTransitioned = "ApplicationTransitioned"
+)
+
+// ドメインイベントの集約の種類
+const (
+	AggregateDisaster           = "disaster"
+	AggregateSupportApplication = "support_application"
+)
+
+// DisasterCreatedEvent is the payload of DomainEventDisasterCreated
+type DisasterCreatedEvent struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Status         string    `json:"status"`
+	MunicipalityID int32     `json:"municipality_id"`
+	WorkCategoryID int64     `json:"work_category_id"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+// DisasterStatusChangedEvent is the payload of DomainEventDisasterStatusChanged
+type DisasterStatusChangedEvent struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status"`
+	MunicipalityID int32     `json:"municipality_id"`
+	WorkCategoryID int64     `json:"work_category_id"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+// ApplicationTransitionedEvent is the payload of DomainEventApplicationTransitioned.
+// PreviousStatus is nil when the application was created in Status.
+type ApplicationTransitionedEvent struct {
+	ApplicationID   string    `json:"application_id"`
+	ApplicationDate time.Time `json:"application_date"`
+	ApplicantName   string    `json:"applicant_name"`
+	ApplicantUserID *string   `json:"applicant_user_id,omitempty"`
+	DisasterName    string    `json:"disaster_name"`
+	RequestedAmount int64     `json:"requested_amount"`
+	PreviousStatus  *string   `json:"previous_status,omitempty"`
+	Status          string    `json:"status"`
+}
+
+// NewOutboxEvent returns an event to be written to the outbox in the transaction of the change it describes
+func NewOutboxEvent(eventType, aggregateType, aggregateID string, payload any) (*OutboxEvent, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &OutboxEvent{
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       string(b),
+		OccurredAt:    now,
+		AvailableAt:   now,
+	}, nil
+}
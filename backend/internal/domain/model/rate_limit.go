@@ -0,0 +1,71 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is a token bucket that holds Requests tokens and refills them evenly over Period.
+// A client may send Requests requests at once and then one every Period/Requests.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseRateLimit parses a limit written as "<requests>/<period>", e.g. "10/1m"
+func ParseRateLimit(s string) (RateLimit, error) {
+	requests, period, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return RateLimit{}, fmt.Errorf("rate limit %q must be <requests>/<period>", s)
+	}
+	n, err := strconv.Atoi(requests)
+	if err != nil || n <= 0 {
+		return RateLimit{}, fmt.Errorf("rate limit %q must allow a positive number of requests", s)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return RateLimit{}, fmt.Errorf("rate limit %q must have a positive period", s)
+	}
+
+	return RateLimit{Requests: n, Period: d}, nil
+}
+
+// RefillRate returns the tokens added to the bucket per second
+func (l RateLimit) RefillRate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Result returns the state of a bucket holding tokens after a request that was allowed or not
+func (l RateLimit) Result(tokens float64, allowed bool) *RateLimitResult {
+	rate := l.RefillRate()
+	result := &RateLimitResult{
+		Allowed:   allowed,
+		Limit:     l.Requests,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     seconds((float64(l.Requests) - tokens) / rate),
+	}
+	if !allowed {
+		result.RetryAfter = seconds((1 - tokens) / rate)
+	}
+
+	return result
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Max(s, 0) * float64(time.Second))
+}
+
+// RateLimitResult is the state of a bucket after taking a token for a request
+type RateLimitResult struct {
+	Allowed bool
+	// Limit is the number of requests the bucket holds
+	Limit     int
+	Remaining int
+	// Reset is how long until the bucket is full again
+	Reset time.Duration
+	// RetryAfter is how long until the next request is allowed when this one was not
+	RetryAfter time.Duration
+}
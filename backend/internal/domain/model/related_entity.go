@@ -0,0 +1,90 @@
+package model
+
+// RelatedEntityType identifies a kind of record a notification can link to
+type RelatedEntityType string
+
+const (
+	RelatedEntityDisaster           RelatedEntityType = "災害"
+	RelatedEntityAssessment         RelatedEntityType = "査定"
+	RelatedEntitySupportApplication RelatedEntityType = "支援申請"
+)
+
+// RelatedEntityIDFormat is how records of a related entity type are identified
+type RelatedEntityIDFormat int
+
+const (
+	RelatedEntityIDUUID RelatedEntityIDFormat = iota
+	RelatedEntityIDSerial
+	// RelatedEntityIDCode is a short business code such as the support application ID "A001"
+	RelatedEntityIDCode
+)
+
+// relatedEntityIDFormats is the registry of entity types notifications may link to
+var relatedEntityIDFormats = map[RelatedEntityType]RelatedEntityIDFormat{
+	RelatedEntityDisaster:           RelatedEntityIDUUID,
+	RelatedEntityAssessment:         RelatedEntityIDSerial,
+	RelatedEntitySupportApplication: RelatedEntityIDCode,
+}
+
+// APIBasePath is the prefix of the current version of the API. Links to records point under it, so that they
+// keep working after the unversioned routes are removed.
+const APIBasePath = "/api/v1"
+
+// relatedEntityPathPrefixes are the API paths records are linked under, followed by the ID of the linked record
+var relatedEntityPathPrefixes = map[RelatedEntityType]string{
+	RelatedEntityDisaster: APIBasePath + "/disasters/",
+	// 査定単体の取得APIはないため、査定が属する災害へリンクする
+	RelatedEntityAssessment:         APIBasePath + "/disasters/",
+	RelatedEntitySupportApplication: APIBasePath + "/support-applications/",
+}
+
+// RelatedEntityTypes lists every registered related entity type in display order
+var RelatedEntityTypes = []RelatedEntityType{
+	RelatedEntityDisaster,
+	RelatedEntityAssessment,
+	RelatedEntitySupportApplication,
+}
+
+// Valid reports whether t is a registered related entity type
+func (t RelatedEntityType) Valid() bool {
+	_, ok := relatedEntityIDFormats[t]
+	return ok
+}
+
+// IDFormat returns how records of type t are identified
+func (t RelatedEntityType) IDFormat() RelatedEntityIDFormat {
+	return relatedEntityIDFormats[t]
+}
+
+// PathPrefix returns the API path the records of type t are linked under
+func (t RelatedEntityType) PathPrefix() string {
+	return relatedEntityPathPrefixes[t]
+}
+
+// RelatedEntityRef points at a record by its registered type and ID
+type RelatedEntityRef struct {
+	Type RelatedEntityType
+	ID   string
+}
+
+// RelatedEntityLink is the resolved target of a notification's related entity
+type RelatedEntityLink struct {
+	Type RelatedEntityType
+	ID   string
+	// Path is the canonical API path of the record, empty when Deleted is set
+	Path   string
+	Label  string
+	Status string
+	// Deleted is set when the record was soft deleted or no longer exists
+	Deleted bool
+}
+
+// NewRelatedEntityRef returns the reference of a notification's related entity, or nil when the
+// notification has none or its type is not registered
+func NewRelatedEntityRef(entityType, id *string) *RelatedEntityRef {
+	if entityType == nil || id == nil || !RelatedEntityType(*entityType).Valid() {
+		return nil
+	}
+
+	return &RelatedEntityRef{Type: RelatedEntityType(*entityType), ID: *id}
+}
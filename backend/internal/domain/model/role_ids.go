@@ -0,0 +1,9 @@
+package model
+
+// Roles seeded by the users table migration
+const (
+	// RoleIDSystemAdmin is the ID of the システム管理者 role
+	RoleIDSystemAdmin int16 = 1
+	// RoleIDApplicationProcessor is the ID of the 申請処理担当者 role
+	RoleIDApplicationProcessor int16 = 4
+)
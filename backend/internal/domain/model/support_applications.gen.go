@@ -24,6 +24,8 @@ type SupportApplication struct {
 	Notes           *string    `gorm:"column:notes;type:text;comment:備考 - 申請に関する備考やメモ" json:"notes"`                                                                                                                    // 備考 - 申請に関する備考やメモ
 	CreatedAt       time.Time  `gorm:"column:created_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;comment:作成日時 - レコード作成日時" json:"created_at"`                                                 // 作成日時 - レコード作成日時
 	UpdatedAt       time.Time  `gorm:"column:updated_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;comment:更新日時 - レコード最終更新日時" json:"updated_at"`                                               // 更新日時 - レコード最終更新日時
+	ApplicantUserID *string    `gorm:"column:applicant_user_id;type:uuid;index:idx_support_applications_applicant_user_id,priority:1;comment:申請者ユーザーID - リマインダー等の通知先（未登録の申請者はNULL）" json:"applicant_user_id"`           // 申請者ユーザーID - リマインダー等の通知先（未登録の申請者はNULL）
+	DisasterID      *string    `gorm:"column:disaster_id;type:uuid;index:idx_support_applications_disaster_id,priority:1;comment:災害ID - 対象となる災害（災害マスタのID、未登録の災害はNULL）" json:"disaster_id"`                              // 災害ID - 対象となる災害（災害マスタのID、未登録の災害はNULL）
 }
 
 // TableName SupportApplication's table name
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+)
+
+// TrashType identifies a kind of soft-deletable record that can be listed and restored from the trash
+type TrashType string
+
+const (
+	TrashTypeDisaster          TrashType = "disaster"
+	TrashTypeTimeline          TrashType = "timeline"
+	TrashTypeFacilityEquipment TrashType = "facility_equipment"
+	TrashTypeGisData           TrashType = "gis_data"
+	TrashTypeAssessment        TrashType = "assessment"
+	TrashTypeUser              TrashType = "user"
+)
+
+// trashTypeUsesUUID maps each trash type to whether its records are identified by UUID
+var trashTypeUsesUUID = map[TrashType]bool{
+	TrashTypeDisaster:          true,
+	TrashTypeTimeline:          false,
+	TrashTypeFacilityEquipment: false,
+	TrashTypeGisData:           false,
+	TrashTypeAssessment:        false,
+	TrashTypeUser:              true,
+}
+
+// Valid reports whether t is a known trash type
+func (t TrashType) Valid() bool {
+	_, ok := trashTypeUsesUUID[t]
+	return ok
+}
+
+// UsesUUID reports whether records of type t are identified by UUID rather than a serial integer
+func (t TrashType) UsesUUID() bool {
+	return trashTypeUsesUUID[t]
+}
+
+// TrashItem is a soft-deleted record shown in the trash
+type TrashItem struct {
+	Type      TrashType `json:"type"`
+	ID        string    `json:"id"`
+	Label     string    `json:"label"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// SoftDeleteRelation links soft-deletable child records to their parent through a foreign key column.
+// The children are soft-deleted and restored together with the parent, and so are their own Children.
+type SoftDeleteRelation struct {
+	Table      string
+	ForeignKey string
+	Children   []SoftDeleteRelation
+}
@@ -16,7 +16,7 @@ type UnitPrice struct {
 	CategoryID     int32      `gorm:"column:category_id;type:integer;not null;index:idx_unit_prices_category_id,priority:1;comment:工種区分ID（外部キー、work_categoriesテーブルのID）" json:"category_id"`                        // 工種区分ID（外部キー、work_categoriesテーブルのID）
 	PrefectureCode string     `gorm:"column:prefecture_code;type:character varying(2);not null;index:idx_unit_prices_prefecture_code,priority:1;comment:都道府県コード（外部キー、prefecturesテーブルのコード）" json:"prefecture_code"` // 都道府県コード（外部キー、prefecturesテーブルのコード）
 	UnitPrice      float64    `gorm:"column:unit_price;type:numeric(12,2);not null;comment:単価（円）" json:"unit_price"`                                                                                               // 単価（円）
-	UnitType       string     `gorm:"column:unit_type;type:character varying(20);not null;index:idx_unit_prices_unit_type,priority:1;comment:単位タイプ（"per_meter", "per_sqm", "per_unit"）" json:"unit_type"`          // 単位タイプ（"per_meter", "per_sqm", "per_unit"）
+	UnitType       string     `gorm:"column:unit_type;type:character varying(20);not null;index:idx_unit_prices_unit_type,priority:1;comment:単位タイプ（per_meter, per_sqm, per_unit）" json:"unit_type"`                // 単位タイプ（per_meter, per_sqm, per_unit）
 	ValidFrom      time.Time  `gorm:"column:valid_from;type:date;not null;index:idx_unit_prices_valid_from,priority:1;default:CURRENT_DATE;comment:有効開始日" json:"valid_from"`                                       // 有効開始日
 	ValidTo        *time.Time `gorm:"column:valid_to;type:date;index:idx_unit_prices_valid_to,priority:1;comment:有効終了日" json:"valid_to"`                                                                           // 有効終了日
 	Notes          *string    `gorm:"column:notes;type:text;comment:備考" json:"notes"`                                                                                                                              // 備考
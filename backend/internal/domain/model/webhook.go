@@ -0,0 +1,80 @@
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// 外部システムへ送信するドメインイベントの種別
+const (
+	WebhookEventDisasterCreated            = "disaster.created"
+	WebhookEventDisasterStatusChanged      = "disaster.status_changed"
+	WebhookEventSupportApplicationApproved = "support_application.approved"
+	WebhookEventTest                       = "webhook.test"
+)
+
+// WebhookEventTypes lists the event types a subscription can subscribe to
+var WebhookEventTypes = []string{
+	WebhookEventDisasterCreated,
+	WebhookEventDisasterStatusChanged,
+	WebhookEventSupportApplicationApproved,
+}
+
+// IsValidWebhookEventType reports whether eventType can be subscribed to
+func IsValidWebhookEventType(eventType string) bool {
+	return slices.Contains(WebhookEventTypes, eventType)
+}
+
+// Webhook配信のステータス
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// Webhook配信のジョブ種別
+const (
+	JobTypeWebhookEvent    = "webhook_event"
+	JobTypeWebhookDelivery = "webhook_delivery"
+)
+
+// WebhookEvent is the body posted to subscribers and the payload of a JobTypeWebhookEvent job
+type WebhookEvent struct {
+	// ID is shared by every delivery of the event so receivers can drop duplicates
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// WebhookDeliveryPayload is the payload of a JobTypeWebhookDelivery job
+type WebhookDeliveryPayload struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
+
+// WebhookResponse is what a subscriber endpoint answered
+type WebhookResponse struct {
+	StatusCode int
+	// Body is the beginning of the response body
+	Body string
+}
+
+// Succeeded reports whether the endpoint accepted the delivery
+func (r *WebhookResponse) Succeeded() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// Events returns the subscribed event types
+func (s *WebhookSubscription) Events() []string {
+	var events []string
+	_ = json.Unmarshal([]byte(s.EventTypes), &events)
+
+	return events
+}
+
+// SetEvents replaces the subscribed event types
+func (s *WebhookSubscription) SetEvents(events []string) {
+	b, _ := json.Marshal(events)
+	s.EventTypes = string(b)
+}
@@ -40,6 +40,7 @@ func newNotification(db *gorm.DB, opts ...gen.DOOption) notification {
 	_notification.CreatedAt = field.NewTime(tableName, "created_at")
 	_notification.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_notification.DeletedAt = field.NewField(tableName, "deleted_at")
+	_notification.BroadcastID = field.NewInt32(tableName, "broadcast_id")
 
 	_notification.fillFieldMap()
 
@@ -62,6 +63,7 @@ type notification struct {
 	CreatedAt         field.Time   // 作成日時 - レコード作成日時
 	UpdatedAt         field.Time   // 更新日時 - レコード最終更新日時
 	DeletedAt         field.Field  // 削除日時 - 論理削除用のタイムスタンプ
+	BroadcastID       field.Int32  // 一斉通知ID - 一斉通知の受信者の場合に本文の参照先となる
 
 	fieldMap map[string]field.Expr
 }
@@ -90,6 +92,7 @@ func (n *notification) updateTableName(table string) *notification {
 	n.CreatedAt = field.NewTime(table, "created_at")
 	n.UpdatedAt = field.NewTime(table, "updated_at")
 	n.DeletedAt = field.NewField(table, "deleted_at")
+	n.BroadcastID = field.NewInt32(table, "broadcast_id")
 
 	n.fillFieldMap()
 
@@ -106,7 +109,7 @@ func (n *notification) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (n *notification) fillFieldMap() {
-	n.fieldMap = make(map[string]field.Expr, 12)
+	n.fieldMap = make(map[string]field.Expr, 13)
 	n.fieldMap["id"] = n.ID
 	n.fieldMap["user_id"] = n.UserID
 	n.fieldMap["title"] = n.Title
@@ -119,6 +122,7 @@ func (n *notification) fillFieldMap() {
 	n.fieldMap["created_at"] = n.CreatedAt
 	n.fieldMap["updated_at"] = n.UpdatedAt
 	n.fieldMap["deleted_at"] = n.DeletedAt
+	n.fieldMap["broadcast_id"] = n.BroadcastID
 }
 
 func (n notification) clone(db *gorm.DB) notification {
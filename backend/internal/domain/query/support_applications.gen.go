@@ -40,6 +40,8 @@ func newSupportApplication(db *gorm.DB, opts ...gen.DOOption) supportApplication
 	_supportApplication.Notes = field.NewString(tableName, "notes")
 	_supportApplication.CreatedAt = field.NewTime(tableName, "created_at")
 	_supportApplication.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_supportApplication.ApplicantUserID = field.NewString(tableName, "applicant_user_id")
+	_supportApplication.DisasterID = field.NewString(tableName, "disaster_id")
 
 	_supportApplication.fillFieldMap()
 
@@ -62,6 +64,8 @@ type supportApplication struct {
 	Notes           field.String // 備考 - 申請に関する備考やメモ
 	CreatedAt       field.Time   // 作成日時 - レコード作成日時
 	UpdatedAt       field.Time   // 更新日時 - レコード最終更新日時
+	ApplicantUserID field.String // 申請者ユーザーID - リマインダー等の通知先（未登録の申請者はNULL）
+	DisasterID      field.String // 災害ID - 対象となる災害（災害マスタのID、未登録の災害はNULL）
 
 	fieldMap map[string]field.Expr
 }
@@ -90,6 +94,8 @@ func (s *supportApplication) updateTableName(table string) *supportApplication {
 	s.Notes = field.NewString(table, "notes")
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
+	s.ApplicantUserID = field.NewString(table, "applicant_user_id")
+	s.DisasterID = field.NewString(table, "disaster_id")
 
 	s.fillFieldMap()
 
@@ -106,7 +112,7 @@ func (s *supportApplication) GetFieldByName(fieldName string) (field.OrderExpr,
 }
 
 func (s *supportApplication) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 12)
+	s.fieldMap = make(map[string]field.Expr, 14)
 	s.fieldMap["application_id"] = s.ApplicationID
 	s.fieldMap["application_date"] = s.ApplicationDate
 	s.fieldMap["applicant_name"] = s.ApplicantName
@@ -119,6 +125,8 @@ func (s *supportApplication) fillFieldMap() {
 	s.fieldMap["notes"] = s.Notes
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["applicant_user_id"] = s.ApplicantUserID
+	s.fieldMap["disaster_id"] = s.DisasterID
 }
 
 func (s supportApplication) clone(db *gorm.DB) supportApplication {
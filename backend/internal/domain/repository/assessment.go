@@ -0,0 +1,17 @@
+//go:generate mockgen -source=assessment.go -destination=../../../tests/mock/domain/assessment.mock.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type AssessmentRepository interface {
+	// CountByDisasterIDForUpdate locks the live assessments of the disaster until the transaction of ctx ends, so
+	// that their statuses can not change before it does, and counts those whose status is one of statuses
+	CountByDisasterIDForUpdate(ctx context.Context, disasterID string, statuses []string) (int64, error)
+	// FindByStatusUpdatedBefore returns the live assessments in the status that have not been updated since before
+	FindByStatusUpdatedBefore(ctx context.Context, status string, before time.Time) ([]*model.Assessment, error)
+}
@@ -0,0 +1,19 @@
+//go:generate mockgen -source=broadcast_notification.go -destination=../../../tests/mock/domain/broadcast_notification.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type BroadcastNotificationRepository interface {
+	// Create stores the broadcast and sets RecipientCount to the number of active users in the target at that time.
+	// ExpandRecipients replaces it with the actual number of recipients.
+	// It returns gorm.ErrRecordNotFound when the target does not exist.
+	Create(ctx context.Context, broadcast *model.BroadcastNotification) error
+	// ExpandRecipients creates a read-state row in notifications for every active user in the target of the broadcast,
+	// in one transaction, and updates RecipientCount to the number of rows. Users who already have a row are skipped,
+	// so it can be run again after a failure. It returns gorm.ErrRecordNotFound when the broadcast does not exist.
+	ExpandRecipients(ctx context.Context, id int32) error
+}
@@ -0,0 +1,20 @@
+//go:generate mockgen -source=business_metrics.go -destination=../../../tests/mock/domain/business_metrics.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+// BusinessMetricsRepository aggregates the counts exported as business metrics
+type BusinessMetricsRepository interface {
+	// CountDisastersByStatus counts the disasters that are not deleted by status
+	CountDisastersByStatus(ctx context.Context) ([]*model.GroupCount, error)
+	// CountSupportApplicationsByStatus counts the support applications whose status is not one of excludeStatuses
+	CountSupportApplicationsByStatus(ctx context.Context, excludeStatuses []string) ([]*model.GroupCount, error)
+	// CountJobsByTypeAndStatus counts the jobs in statuses by job type and status
+	CountJobsByTypeAndStatus(ctx context.Context, statuses []string) ([]*model.GroupCount, error)
+	// CountEmailsByTypeAndStatus counts the email histories in status by email type
+	CountEmailsByTypeAndStatus(ctx context.Context, status string) ([]*model.GroupCount, error)
+}
@@ -1,4 +1,4 @@
-//go:generate mockgen -source=damage_level.go -destination=../../../tests/mock/datastore/damage_level.mock.go
+//go:generate mockgen -source=damage_level.go -destination=../../../tests/mock/domain/damage_level.mock.go
 package domain
 
 import (
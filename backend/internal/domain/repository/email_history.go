@@ -1,3 +1,4 @@
+//go:generate mockgen -source=email_history.go -destination=../../../tests/mock/domain/email_history.mock.go
 package domain
 
 import (
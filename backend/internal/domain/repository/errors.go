@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrVersionConflict is returned by conditional updates when the row was modified after it was read
+	ErrVersionConflict = errors.New("record has been modified by another request")
+	// ErrParentDeleted is returned when restoring a record whose parent is still soft-deleted
+	ErrParentDeleted = errors.New("parent record is still deleted")
+	// ErrReferenced is returned when a record cannot be hard-deleted because other records reference it
+	ErrReferenced = errors.New("record is referenced by other records")
+)
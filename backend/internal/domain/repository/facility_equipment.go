@@ -3,6 +3,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 )
@@ -11,6 +12,8 @@ type FacilityEquipmentRepository interface {
 	Find(ctx context.Context) ([]*model.FacilityEquipment, error)
 	FindByID(ctx context.Context, id int32) (*model.FacilityEquipment, error)
 	Create(ctx context.Context, facilityEquipment *model.FacilityEquipment) error
-	Update(ctx context.Context, facilityEquipment *model.FacilityEquipment) error
+	// Update saves the record only if its updated_at still equals expectedUpdatedAt.
+	// ErrVersionConflict is returned when the row was modified in the meantime.
+	Update(ctx context.Context, facilityEquipment *model.FacilityEquipment, expectedUpdatedAt time.Time) error
 	Delete(ctx context.Context, id int32) error
 }
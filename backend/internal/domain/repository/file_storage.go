@@ -0,0 +1,12 @@
+//go:generate mockgen -source=file_storage.go -destination=../../../tests/mock/domain/file_storage.mock.go
+package domain
+
+import (
+	"context"
+)
+
+// FileStorage keeps the uploaded files, such as the disaster documents
+type FileStorage interface {
+	// Ping checks that files can be stored
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,23 @@
+//go:generate mockgen -source=idempotency_key.go -destination=../../../tests/mock/domain/idempotency_key.mock.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+// IdempotencyKeyRepository stores idempotency keys. Keys are unique within a scope, so that the same key sent by
+// different clients or to different paths never collides.
+type IdempotencyKeyRepository interface {
+	// Reserve stores a new in-progress key. It returns false when an unexpired key already exists in the scope;
+	// expired keys are taken over so that a client may reuse a key once it has expired.
+	Reserve(ctx context.Context, key *model.IdempotencyKey) (bool, error)
+	FindByKey(ctx context.Context, scope, key string) (*model.IdempotencyKey, error)
+	// Complete records the response that will be replayed for later requests with the same key
+	Complete(ctx context.Context, scope, key string, status int32, contentType string, body []byte) error
+	// Release removes an in-progress key so that the request can be retried
+	Release(ctx context.Context, scope, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,29 @@
+//go:generate mockgen -source=job.go -destination=../../../tests/mock/domain/job.mock.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type JobRepository interface {
+	// Enqueue stores a pending job
+	Enqueue(ctx context.Context, job *model.Job) error
+	// Claim locks the oldest runnable job for the worker and marks it running, or returns nil when there is none.
+	// A job left running since before staleBefore is treated as abandoned by a crashed worker and claimed again.
+	// Concurrent workers never claim the same job.
+	Claim(ctx context.Context, workerID string, now, staleBefore time.Time) (*model.Job, error)
+	// MarkSucceeded finishes a job claimed by the worker
+	MarkSucceeded(ctx context.Context, job *model.Job, finishedAt time.Time) error
+	// MarkRetry returns a job claimed by the worker to the queue to run again at runAt
+	MarkRetry(ctx context.Context, job *model.Job, runAt time.Time, lastError string) error
+	// MarkDead moves a job claimed by the worker to the dead-letter state
+	MarkDead(ctx context.Context, job *model.Job, finishedAt time.Time, lastError string) error
+	// Find returns jobs matching the filter, newest first
+	Find(ctx context.Context, filter model.JobFilter) ([]*model.Job, error)
+	// Retry puts a dead job back in the queue with its attempts reset.
+	// It returns gorm.ErrRecordNotFound when no dead job has the ID.
+	Retry(ctx context.Context, id int64, now time.Time) (*model.Job, error)
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -source=job_run.go -destination=../../../tests/mock/domain/job_run.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type JobRunRepository interface {
+	// Start records the run unless one already exists for the same job and scheduled time.
+	// It reports whether the run was recorded, i.e. whether the caller should execute the job.
+	Start(ctx context.Context, run *model.JobRun) (bool, error)
+	// Finish stores the final status, processed count and error message of the run
+	Finish(ctx context.Context, run *model.JobRun) error
+}
+
+// JobLocker serializes job executions across API instances
+type JobLocker interface {
+	// TryLock acquires the named lock without waiting. When acquired is true, unlock must be called to release it.
+	TryLock(ctx context.Context, name string) (unlock func(), acquired bool, err error)
+}
@@ -0,0 +1,15 @@
+//go:generate mockgen -source=mailer.go -destination=../../../tests/mock/domain/mailer.mock.go
+package domain
+
+import (
+	"context"
+)
+
+type Mailer interface {
+	// Send sends an HTML email
+	Send(ctx context.Context, to, subject, htmlBody string) error
+	// Provider is recorded in email_histories.provider
+	Provider() string
+	// Ping checks that the mail server accepts connections
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source=municipality.go -destination=../../../tests/mock/domain/municipality.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type MunicipalityRepository interface {
+	FindByID(ctx context.Context, id int32) (*model.Municipality, error)
+}
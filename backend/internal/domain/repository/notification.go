@@ -10,9 +10,21 @@ import (
 type NotificationRepository interface {
 	Find(ctx context.Context) ([]*model.Notification, error)
 	FindByID(ctx context.Context, id int32) (*model.Notification, error)
-	FindByUserID(ctx context.Context, userID int32) ([]*model.Notification, error)
+	// FindByUserID returns the user's notifications, newest first
+	FindByUserID(ctx context.Context, userID string) ([]*model.Notification, error)
+	// FindByUserIDAfter returns the user's notifications with an ID greater than afterID in ascending ID order
+	FindByUserIDAfter(ctx context.Context, userID string, afterID int32) ([]*model.Notification, error)
+	// FindLatestIDByUserID returns the ID of the user's newest notification, or 0 when there is none
+	FindLatestIDByUserID(ctx context.Context, userID string) (int32, error)
+	// Create stores the notification and signals NotificationStream subscribers once it is committed
 	Create(ctx context.Context, notification *model.Notification) error
 	Update(ctx context.Context, notification *model.Notification) error
 	Delete(ctx context.Context, id int32) error
 	MarkAsRead(ctx context.Context, id int32) error
+	// MarkAllAsReadByUserID marks the user's unread notifications matching the filter as read and returns how many were updated
+	MarkAllAsReadByUserID(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error)
+	// DeleteByUserID deletes the user's notifications matching the filter and returns how many were deleted
+	DeleteByUserID(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error)
+	// CountUnreadByUserID returns the number of the user's unread notifications per notification type
+	CountUnreadByUserID(ctx context.Context, userID string) (map[string]int64, error)
 }
@@ -0,0 +1,17 @@
+//go:generate mockgen -source=notification_digest.go -destination=../../../tests/mock/domain/notification_digest.mock.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+// NotificationDigestRepository queues notifications for users who receive them as a daily digest email
+type NotificationDigestRepository interface {
+	Create(ctx context.Context, entry *model.NotificationDigestEntry) error
+	// FindPending returns unsent entries ordered by user and creation
+	FindPending(ctx context.Context) ([]*model.NotificationDigestEntry, error)
+	MarkSent(ctx context.Context, ids []int64, sentAt time.Time) error
+}
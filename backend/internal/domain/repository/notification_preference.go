@@ -0,0 +1,16 @@
+//go:generate mockgen -source=notification_preference.go -destination=../../../tests/mock/domain/notification_preference.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type NotificationPreferenceRepository interface {
+	// FindByUserID returns only the types the user has configured
+	FindByUserID(ctx context.Context, userID string) ([]*model.NotificationPreference, error)
+	// FindByUserIDAndType returns gorm.ErrRecordNotFound when the user has not configured the type
+	FindByUserIDAndType(ctx context.Context, userID, notificationType string) (*model.NotificationPreference, error)
+	Upsert(ctx context.Context, preferences []*model.NotificationPreference) error
+}
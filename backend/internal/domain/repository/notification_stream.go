@@ -0,0 +1,10 @@
+//go:generate mockgen -source=notification_stream.go -destination=../../../tests/mock/domain/notification_stream.mock.go
+package domain
+
+// NotificationStream signals subscribers when notifications are created for a user on any API instance
+type NotificationStream interface {
+	// Subscribe returns a channel that receives a value whenever new notifications may exist for the user.
+	// Signals are coalesced, so subscribers should load everything after the last notification they sent.
+	// The returned function must be called to release the subscription.
+	Subscribe(userID string) (<-chan struct{}, func())
+}
@@ -0,0 +1,24 @@
+//go:generate mockgen -source=outbox.go -destination=../../../tests/mock/domain/outbox.mock.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+// OutboxRepository reads the domain events that repositories wrote to the outbox together with their changes
+type OutboxRepository interface {
+	// Claim leases up to limit unpublished events that are not dead and are due at now, oldest first, and counts the attempt.
+	// Leased events are hidden from other relays until leaseUntil, after which an unfinished event is claimed again.
+	Claim(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*model.OutboxEvent, error)
+	// MarkPublished records that every subscriber handled the event
+	MarkPublished(ctx context.Context, id int64, publishedAt time.Time) error
+	// MarkFailed makes the event due again at availableAt
+	MarkFailed(ctx context.Context, id int64, availableAt time.Time, lastError string) error
+	// MarkDead stops relaying the event after its last failed attempt. Dead events are kept for inspection.
+	MarkDead(ctx context.Context, id int64, deadAt time.Time, lastError string) error
+	// DeletePublishedBefore deletes events published before before and returns the number deleted
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,18 @@
+//go:generate mockgen -source=rate_limit.go -destination=../../../tests/mock/domain/rate_limit.mock.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+// RateLimitRepository keeps the token buckets of the rate limiter
+type RateLimitRepository interface {
+	// Take takes a token from the bucket of key for a request, refilling it first for the time since the last
+	// request. The request is allowed when a whole token was left.
+	Take(ctx context.Context, key string, limit model.RateLimit) (*model.RateLimitResult, error)
+	// DeleteExpired removes the buckets that have been full since before now, as a full bucket is the same as none
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=related_entity.go -destination=../../../tests/mock/domain/related_entity.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type RelatedEntityRepository interface {
+	// Resolve looks up the referenced records with one query per entity type. Every reference gets a link;
+	// references to soft-deleted, missing or malformed records are returned with Deleted set.
+	Resolve(ctx context.Context, refs []model.RelatedEntityRef) (map[model.RelatedEntityRef]*model.RelatedEntityLink, error)
+}
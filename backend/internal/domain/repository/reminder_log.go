@@ -0,0 +1,16 @@
+//go:generate mockgen -source=reminder_log.go -destination=../../../tests/mock/domain/reminder_log.mock.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+// ReminderLogRepository records the reminders sent, whichever channels delivered them
+type ReminderLogRepository interface {
+	Create(ctx context.Context, log *model.ReminderLog) error
+	// ExistsSince reports whether the user was reminded about the entity at or after since
+	ExistsSince(ctx context.Context, userID string, ref model.RelatedEntityRef, since time.Time) (bool, error)
+}
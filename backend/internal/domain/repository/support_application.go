@@ -3,6 +3,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 )
@@ -10,5 +11,21 @@ import (
 type SupportApplicationRepository interface {
 	Find(ctx context.Context) ([]*model.SupportApplication, error)
 	FindByID(ctx context.Context, id string) (*model.SupportApplication, error)
-	Create(ctx context.Context, supportApplication *model.SupportApplication) error
+	// Create stores the application and writes the events to the outbox in the same transaction
+	Create(ctx context.Context, supportApplication *model.SupportApplication, events ...*model.OutboxEvent) error
+	// UpdateStatus changes the status of the application if it has not been modified since expectedUpdatedAt,
+	// and writes the events to the outbox in the same transaction. It returns ErrVersionConflict when no application
+	// with the ID has that updated_at.
+	UpdateStatus(
+		ctx context.Context,
+		id, status string,
+		updatedAt, expectedUpdatedAt time.Time,
+		events ...*model.OutboxEvent,
+	) error
+	// CountByDisasterIDForUpdate locks the applications for the disaster until the transaction of ctx ends, so
+	// that their statuses can not change before it does, and counts those whose status is one of statuses
+	CountByDisasterIDForUpdate(ctx context.Context, disasterID string, statuses []string) (int64, error)
+	// FindByStatusUpdatedBefore returns the applications linked to an applicant user that are in the status
+	// and have not been updated since before
+	FindByStatusUpdatedBefore(ctx context.Context, status string, before time.Time) ([]*model.SupportApplication, error)
 }
@@ -0,0 +1,11 @@
+//go:generate mockgen -source=transactor.go -destination=../../../tests/mock/domain/transactor.mock.go
+package domain
+
+import "context"
+
+// Transactor runs a unit of work in one database transaction
+type Transactor interface {
+	// Transaction runs fn in a transaction. Repository calls made with the ctx passed to fn join the transaction,
+	// which is committed when fn returns nil and rolled back otherwise.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
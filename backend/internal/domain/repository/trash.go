@@ -0,0 +1,18 @@
+//go:generate mockgen -source=trash.go -destination=../../../tests/mock/domain/trash.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type TrashRepository interface {
+	// FindDeleted returns the soft-deleted records of the given type, most recently deleted first
+	FindDeleted(ctx context.Context, trashType model.TrashType) ([]*model.TrashItem, error)
+	// Restore clears deleted_at on the record and on its children that were deleted together with it.
+	// It returns the number of restored children.
+	Restore(ctx context.Context, trashType model.TrashType, id string, children []model.SoftDeleteRelation) (int64, error)
+	// Purge permanently deletes a soft-deleted record
+	Purge(ctx context.Context, trashType model.TrashType, id string) error
+}
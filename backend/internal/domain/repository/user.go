@@ -11,6 +11,7 @@ type UserRepository interface {
 	Find(ctx context.Context) ([]*model.User, error)
 	FindByID(ctx context.Context, id string) (*model.User, error)
 	FindByEmail(ctx context.Context, email string) (*model.User, error)
+	// Create stores the user and writes the events to the outbox in the same transaction
 	Create(ctx context.Context, user *model.User) error
 	Update(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, id int32) error
@@ -0,0 +1,17 @@
+//go:generate mockgen -source=webhook_sender.go -destination=../../../tests/mock/domain/webhook_sender.mock.go
+package domain
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type WebhookSender interface {
+	// Post sends payload as JSON and fails on a non-2xx response
+	Post(ctx context.Context, url string, payload []byte) error
+	// Deliver sends payload as JSON with the extra headers and returns the response whatever its status.
+	// It fails only when no response was received.
+	Deliver(ctx context.Context, url string, header http.Header, payload []byte) (*model.WebhookResponse, error)
+}
@@ -0,0 +1,30 @@
+//go:generate mockgen -source=webhook_subscription.go -destination=../../../tests/mock/domain/webhook_subscription.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type WebhookSubscriptionRepository interface {
+	Find(ctx context.Context) ([]*model.WebhookSubscription, error)
+	FindByID(ctx context.Context, id string) (*model.WebhookSubscription, error)
+	// FindActiveByEventType returns the active subscriptions that subscribe to the event type
+	FindActiveByEventType(ctx context.Context, eventType string) ([]*model.WebhookSubscription, error)
+	Create(ctx context.Context, subscription *model.WebhookSubscription) error
+	Update(ctx context.Context, subscription *model.WebhookSubscription) error
+	// Delete removes the subscription together with its delivery logs
+	Delete(ctx context.Context, id string) error
+}
+
+type WebhookDeliveryRepository interface {
+	// Create stores the delivery unless the subscription already has one for the event, in which case
+	// delivery is filled with the existing row
+	Create(ctx context.Context, delivery *model.WebhookDelivery) error
+	FindByID(ctx context.Context, id int64) (*model.WebhookDelivery, error)
+	// FindBySubscriptionID returns the latest deliveries of the subscription, newest first
+	FindBySubscriptionID(ctx context.Context, subscriptionID string, limit int) ([]*model.WebhookDelivery, error)
+	// RecordAttempt stores the outcome of the latest attempt
+	RecordAttempt(ctx context.Context, delivery *model.WebhookDelivery) error
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source=work_category.go -destination=../../../tests/mock/domain/work_category.mock.go
+package domain
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+type WorkCategoryRepository interface {
+	FindByID(ctx context.Context, id int64) (*model.WorkCategory, error)
+}
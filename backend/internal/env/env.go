@@ -10,11 +10,152 @@ import (
 
 type Values struct {
 	DB
+	Server
+	API
+	Health
+	Metrics
+	Tracing
+	Logging
+	RateLimit
 	Auth
+	Idempotency
+	Mail
+	Storage
+	Notification
+	Scheduler
+	Queue
+	Outbox
 	Env        string `default:"local" split_words:"true"`
 	ServerPort string `required:"true" split_words:"true"`
 }
 
+type Server struct {
+	ServerReadTimeout  time.Duration `default:"15s" split_words:"true"`
+	ServerWriteTimeout time.Duration `default:"30s" split_words:"true"`
+	ServerIdleTimeout  time.Duration `default:"120s" split_words:"true"`
+	// ServerDrainDelay is how long the server keeps serving with readiness failing before it stops accepting
+	// connections, so that load balancers stop routing to it first
+	ServerDrainDelay time.Duration `default:"0s" split_words:"true"`
+	// ServerShutdownTimeout bounds the whole shutdown: draining requests, stopping workers and closing the database
+	ServerShutdownTimeout time.Duration `default:"30s" split_words:"true"`
+	// TrustedProxies are the IPs or CIDRs of the proxies whose X-Forwarded-For is used as the client IP
+	TrustedProxies []string `split_words:"true"`
+}
+
+type API struct {
+	// APILegacyRoutes keeps serving the routes without the /api/v1 prefix, marked as deprecated
+	APILegacyRoutes bool `default:"true" split_words:"true"`
+	// APILegacyDeprecatedAt is when the unversioned routes were deprecated, as an RFC 3339 time
+	APILegacyDeprecatedAt time.Time `default:"2026-10-19T00:00:00+09:00" split_words:"true"`
+	// APILegacySunset is when the unversioned routes are removed, as an RFC 3339 time; no Sunset header when empty
+	APILegacySunset time.Time `split_words:"true"`
+}
+
+type Health struct {
+	// HealthCheckTimeout is how long each readiness check may take before the component is reported down
+	HealthCheckTimeout time.Duration `default:"2s" split_words:"true"`
+	// HealthMailCacheTTL is how long the result of the mail check is reused, so that probes do not open an
+	// SMTP connection each time
+	HealthMailCacheTTL time.Duration `default:"30s" split_words:"true"`
+}
+
+type Metrics struct {
+	// MetricsPort is the port of the internal listener that serves /metrics. It is separate from ServerPort so
+	// that the metrics are not exposed with the public API.
+	MetricsPort string `default:"9090" split_words:"true"`
+	// MetricsQueryTimeout bounds the queries that read the business metrics
+	MetricsQueryTimeout time.Duration `default:"5s" split_words:"true"`
+	// MetricsCacheTTL is how long the business metrics are reused before the next scrape queries them again
+	MetricsCacheTTL time.Duration `default:"30s" split_words:"true"`
+}
+
+type Tracing struct {
+	// TracingExporter is where spans are sent: otlp, stdout or none. Trace IDs are propagated and logged with none too.
+	TracingExporter    string `default:"none" split_words:"true"`
+	TracingServiceName string `default:"agri-disaster-api" split_words:"true"`
+	// TracingOTLPEndpoint is the OTLP/HTTP collector URL; the OTEL_EXPORTER_OTLP_* variables are used when empty
+	TracingOTLPEndpoint string `split_words:"true"`
+	// TracingSampleRatio is the fraction of traces started by this service that are sampled
+	TracingSampleRatio float64 `default:"1" split_words:"true"`
+}
+
+type Logging struct {
+	// LogRedactHeaders are the request and response headers whose values are not logged
+	LogRedactHeaders []string `default:"Authorization,Proxy-Authorization,Cookie,Set-Cookie,X-Api-Key" split_words:"true"`
+	// LogRedactFields are the JSON fields whose values are not logged; a dotted path such as user.email matches from
+	// the root, a single name matches at any depth
+	LogRedactFields []string `default:"password,token,secret,mfa_secret,password_reset_token,access_token,refresh_token" split_words:"true"`
+	// LogBodyMaxBytes is how much of a request or response body is logged
+	LogBodyMaxBytes int `default:"4096" split_words:"true"`
+	// LogSuccessSampleRate is the fraction of successful requests logged; failed requests are always logged
+	LogSuccessSampleRate float64 `default:"1" split_words:"true"`
+}
+
+type RateLimit struct {
+	// RateLimitStore keeps the buckets in memory, per instance, or in postgres, shared by all instances
+	RateLimitStore string `default:"memory" split_words:"true"`
+	// Limits of the route groups as <requests>/<period>; an empty limit turns off limiting for the group
+	RateLimitAuth    string `default:"10/1m" split_words:"true"`
+	RateLimitList    string `default:"120/1m" split_words:"true"`
+	RateLimitDefault string `default:"300/1m" split_words:"true"`
+	// RateLimitCleanupInterval is how often the buckets that are full again are deleted
+	RateLimitCleanupInterval time.Duration `default:"10m" split_words:"true"`
+}
+
+type Idempotency struct {
+	IdempotencyKeyTTL             time.Duration `default:"24h" split_words:"true"`
+	IdempotencyKeyCleanupInterval time.Duration `default:"1h" split_words:"true"`
+}
+
+type Mail struct {
+	SMTPHost string `default:"mailhog" split_words:"true"`
+	SMTPPort int    `default:"1025" split_words:"true"`
+	MailFrom string `default:"noreply@agri-disaster.jp" split_words:"true"`
+}
+
+type Storage struct {
+	// StorageDir is the directory the uploaded files, such as the disaster documents, are stored in
+	StorageDir string `default:"storage" split_words:"true"`
+}
+
+type Notification struct {
+	NotificationDigestInterval time.Duration `default:"24h" split_words:"true"`
+	NotificationWebhookTimeout time.Duration `default:"10s" split_words:"true"`
+	// WebhookAllowPrivateNetworks lets webhooks reach loopback, private and link-local addresses. Only for local
+	// development: users choose webhook URLs, which must not reach the internal network or cloud metadata.
+	WebhookAllowPrivateNetworks bool `default:"false" split_words:"true"`
+}
+
+type Scheduler struct {
+	// SchedulerTimezone is the time zone cron schedules are evaluated in
+	SchedulerTimezone            string `default:"Asia/Tokyo" split_words:"true"`
+	AssessmentReminderSchedule   string `default:"0 9 * * *" split_words:"true"`
+	AssessmentReminderAfterDays  int    `default:"7" split_words:"true"`
+	ApplicationReminderSchedule  string `default:"0 9 * * *" split_words:"true"`
+	ApplicationReminderAfterDays int    `default:"3" split_words:"true"`
+}
+
+type Queue struct {
+	JobWorkerConcurrency int           `default:"2" split_words:"true"`
+	JobPollInterval      time.Duration `default:"1s" split_words:"true"`
+	JobLockTimeout       time.Duration `default:"10m" split_words:"true"`
+	JobBackoffBase       time.Duration `default:"30s" split_words:"true"`
+	JobBackoffMax        time.Duration `default:"1h" split_words:"true"`
+}
+
+type Outbox struct {
+	OutboxPollInterval time.Duration `default:"1s" split_words:"true"`
+	OutboxBatchSize    int           `default:"100" split_words:"true"`
+	OutboxLeaseTimeout time.Duration `default:"1m" split_words:"true"`
+	OutboxBackoffBase  time.Duration `default:"5s" split_words:"true"`
+	OutboxBackoffMax   time.Duration `default:"10m" split_words:"true"`
+	// OutboxMaxAttempts is how many times an event is relayed before it is marked dead
+	OutboxMaxAttempts int32 `default:"10" split_words:"true"`
+	// OutboxRetention is how long published events are kept before the cleanup job deletes them
+	OutboxRetention       time.Duration `default:"168h" split_words:"true"`
+	OutboxCleanupInterval time.Duration `default:"1h" split_words:"true"`
+}
+
 type Auth struct {
 	OIDCIssuer       string `split_words:"true"`
 	OIDCClientID     string `split_words:"true"`
@@ -11,6 +11,27 @@ const (
 	PrefectureNotFoundError         ErrorCode = "E100002" // 都道府県が存在しないエラー
 	EmailVarificationTokenNotFound  ErrorCode = "E100003" // メール認証トークンが存在しないエラー
 	EmailVarificationTokenUsedError ErrorCode = "E100004" // メール認証トークンが使用済みエラー
+	DisasterNotFoundError           ErrorCode = "E100005" // 災害が存在しないエラー
+	PreconditionFailedError         ErrorCode = "E100006" // 楽観的ロックの競合エラー
+	PreconditionRequiredError       ErrorCode = "E100007" // If-Matchヘッダー未指定エラー
+	IdempotencyKeyReusedError       ErrorCode = "E100008" // Idempotency-Keyが別のリクエストで使用済みエラー
+	IdempotencyKeyInProgressError   ErrorCode = "E100009" // Idempotency-Keyのリクエストが処理中エラー
+	TrashItemNotFoundError          ErrorCode = "E100010" // ゴミ箱に対象のデータが存在しないエラー
+	TrashParentDeletedError         ErrorCode = "E100011" // 親データが削除済みのため復元できないエラー
+	TrashItemReferencedError        ErrorCode = "E100012" // 他のデータから参照されているため完全削除できないエラー
+	DisasterDeleteBlockedError      ErrorCode = "E100013" // 削除できない子データがあるため災害を削除できないエラー
+	JobNotRetryableError            ErrorCode = "E100014" // 再実行できるジョブが存在しないエラー
+	WebhookSubscriptionNotFound     ErrorCode = "E100015" // Webhook購読が存在しないエラー
+	InvalidStatusTransitionError    ErrorCode = "E100016" // 現在のステータスから変更できないエラー
+	RateLimitExceededError          ErrorCode = "E100017" // リクエスト数が上限を超えたエラー
+	NotFoundError                   ErrorCode = "E100018" // 対象のデータが存在しないエラー
+	DuplicateError                  ErrorCode = "E100019" // 一意制約に違反したエラー
+	ReferenceNotFoundError          ErrorCode = "E100020" // 参照先のデータが存在しないエラー
+	ResourceInUseError              ErrorCode = "E100021" // 他のデータから参照されているため変更できないエラー
+	UnauthorizedError               ErrorCode = "E100022" // 認証されていないエラー
+	ForbiddenError                  ErrorCode = "E100023" // 権限がないエラー
+	RouteNotFoundError              ErrorCode = "E100024" // APIが存在しないエラー
+	MethodNotAllowedError           ErrorCode = "E100025" // HTTPメソッドが許可されていないエラー
 )
 
 const (
@@ -19,6 +40,27 @@ const (
 	PrefectureNotFoundErrorMessage             ErrorMessage = "都道府県は存在しません"
 	EmailVarificationTokenNotFoundErrorMessage ErrorMessage = "メール認証トークンが存在しません"
 	EmailVarificationTokenUsedErrorMessage     ErrorMessage = "メール認証トークンは使用済みです"
+	DisasterNotFoundErrorMessage               ErrorMessage = "災害は存在しません"
+	PreconditionFailedErrorMessage             ErrorMessage = "他のユーザーによって更新されています。最新の情報を取得してから再度お試しください"
+	PreconditionRequiredErrorMessage           ErrorMessage = "If-Matchヘッダーを指定してください"
+	IdempotencyKeyReusedErrorMessage           ErrorMessage = "このIdempotency-Keyは別のリクエストで使用されています"
+	IdempotencyKeyInProgressErrorMessage       ErrorMessage = "同じIdempotency-Keyのリクエストを処理中です"
+	TrashItemNotFoundErrorMessage              ErrorMessage = "ゴミ箱に対象のデータが存在しません"
+	TrashParentDeletedErrorMessage             ErrorMessage = "親データが削除されているため復元できません。先に親データを復元してください"
+	TrashItemReferencedErrorMessage            ErrorMessage = "他のデータから参照されているため完全に削除できません"
+	DisasterDeleteBlockedErrorMessage          ErrorMessage = "承認済の査定または支払い済みの支援申請があるため削除できません。削除する場合はforce=trueを指定してください"
+	JobNotRetryableErrorMessage                ErrorMessage = "再実行できるジョブが存在しません。deadのジョブのみ再実行できます"
+	WebhookSubscriptionNotFoundErrorMessage    ErrorMessage = "Webhook購読は存在しません"
+	InvalidStatusTransitionErrorMessage        ErrorMessage = "現在のステータスからは指定のステータスに変更できません"
+	RateLimitExceededErrorMessage              ErrorMessage = "リクエストが多すぎます。しばらく待ってから再度お試しください"
+	NotFoundErrorMessage                       ErrorMessage = "対象のデータは存在しません"
+	DuplicateErrorMessage                      ErrorMessage = "既に登録されています"
+	ReferenceNotFoundErrorMessage              ErrorMessage = "参照先のデータが存在しません"
+	ResourceInUseErrorMessage                  ErrorMessage = "他のデータから参照されているため変更できません"
+	UnauthorizedErrorMessage                   ErrorMessage = "認証が必要です"
+	ForbiddenErrorMessage                      ErrorMessage = "この操作を行う権限がありません"
+	RouteNotFoundErrorMessage                  ErrorMessage = "APIが存在しません"
+	MethodNotAllowedErrorMessage               ErrorMessage = "このHTTPメソッドは許可されていません"
 )
 
 func NewAPIError(code ErrorCode, msg ErrorMessage, originalErr error, internalMsg string) *APIError {
@@ -29,9 +71,29 @@ func NewAPIError(code ErrorCode, msg ErrorMessage, originalErr error, internalMs
 	}
 }
 
+// NewValidationError creates a validation error carrying field-level details
+func NewValidationError(fields ...FieldError) *APIError {
+	return &APIError{
+		Code:     ValidationError,
+		Message:  ValidationErrorMessage,
+		Fields:   fields,
+		internal: errors.New(string(ValidationErrorMessage)),
+	}
+}
+
+// FieldError describes why a single request field was rejected
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	// key and params render the message in other locales; a FieldError without a key keeps its message as it is
+	key    FieldMessage
+	params []any
+}
+
 type APIError struct {
 	Code     ErrorCode
 	Message  ErrorMessage
+	Fields   []FieldError
 	internal error
 }
 
@@ -0,0 +1,260 @@
+package myerrors
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/AI1411/fullstack-react-go/internal/i18n"
+)
+
+// FieldMessage identifies a field error message in the catalogs
+type FieldMessage string
+
+const (
+	FieldRequired            FieldMessage = "required"             // 必須
+	FieldMin                 FieldMessage = "min"                  // 数値の下限 {0}
+	FieldMax                 FieldMessage = "max"                  // 数値の上限 {0}
+	FieldMinLength           FieldMessage = "min_length"           // 文字数の下限 {0}
+	FieldMaxLength           FieldMessage = "max_length"           // 文字数の上限 {0}
+	FieldMinItems            FieldMessage = "min_items"            // 要素数の下限 {0}
+	FieldMaxItems            FieldMessage = "max_items"            // 要素数の上限 {0}
+	FieldRange               FieldMessage = "range"                // 数値の範囲 {0}から{1}
+	FieldOneOf               FieldMessage = "one_of"               // 選択肢 {0}
+	FieldEmail               FieldMessage = "email"                // メールアドレス形式
+	FieldDateTime            FieldMessage = "datetime"             // RFC3339形式の日時
+	FieldDate                FieldMessage = "date"                 // YYYY-MM-DD形式の日付
+	FieldType                FieldMessage = "type"                 // JSONの型 {0}
+	FieldNumeric             FieldMessage = "numeric"              // 数値
+	FieldUUID                FieldMessage = "uuid"                 // UUID形式
+	FieldBoolean             FieldMessage = "boolean"              // trueまたはfalse
+	FieldURL                 FieldMessage = "url"                  // httpまたはhttpsのURL
+	FieldPrefectureCode      FieldMessage = "prefecture_code"      // 2桁の都道府県コード
+	FieldInvalid             FieldMessage = "invalid"              // その他の不正な値
+	FieldMalformedBody       FieldMessage = "malformed_body"       // 解析できないリクエストボディ
+	FieldUnreadableBody      FieldMessage = "unreadable_body"      // 読み込めないリクエストボディ
+	FieldReferenceNotFound   FieldMessage = "reference_not_found"  // 参照先が存在しない
+	FieldReferenceInactive   FieldMessage = "reference_inactive"   // 参照先が無効
+	FieldRequiredWith        FieldMessage = "required_with"        // {0}を指定した場合に必須
+	FieldDuplicated          FieldMessage = "duplicated"           // 同じ値が複数ある
+	FieldAlreadyExists       FieldMessage = "already_exists"       // 一意制約に違反する値
+	FieldAnyOfRequired       FieldMessage = "any_of_required"      // {0}のいずれかが必須
+	FieldWebhookURLRequired  FieldMessage = "webhook_url_required" // Webhook通知を有効にする場合のURL
+	FieldApprovedAssessments FieldMessage = "approved_assessments" // 承認済の査定が{0}件ある
+	FieldPaidApplications    FieldMessage = "paid_applications"    // 支払処理中または完了の支援申請が{0}件ある
+	FieldLoginStateMismatch  FieldMessage = "login_state_mismatch" // ログイン開始時の状態と一致しない
+)
+
+// fieldMessages are the catalogs of the field error messages. {field} is replaced by the display name of the
+// field and {0}, {1}... by the parameters.
+var fieldMessages = map[i18n.Locale]map[FieldMessage]string{
+	i18n.Japanese: {
+		FieldRequired:            "{field}は必須項目です",
+		FieldMin:                 "{field}は{0}以上で指定してください",
+		FieldMax:                 "{field}は{0}以下で指定してください",
+		FieldMinLength:           "{field}は{0}文字以上で指定してください",
+		FieldMaxLength:           "{field}は{0}文字以下で指定してください",
+		FieldMinItems:            "{field}は{0}件以上指定してください",
+		FieldMaxItems:            "{field}は{0}件以下で指定してください",
+		FieldRange:               "{field}は{0}から{1}の範囲で指定してください",
+		FieldOneOf:               "{field}は{0}のいずれかを指定してください",
+		FieldEmail:               "{field}はメールアドレスの形式で指定してください",
+		FieldDateTime:            "{field}はRFC3339形式の日時で指定してください",
+		FieldDate:                "{field}はYYYY-MM-DD形式で指定してください",
+		FieldType:                "{field}は{0}型で指定してください",
+		FieldNumeric:             "{field}は数値で指定してください",
+		FieldUUID:                "{field}はUUID形式で指定してください",
+		FieldBoolean:             "{field}はtrueまたはfalseで指定してください",
+		FieldURL:                 "{field}はhttpまたはhttpsのURLで指定してください",
+		FieldPrefectureCode:      "{field}は2桁の都道府県コードで指定してください",
+		FieldInvalid:             "{field}の値が不正です",
+		FieldMalformedBody:       "リクエストボディの形式が不正です",
+		FieldUnreadableBody:      "リクエストボディを読み込めませんでした",
+		FieldReferenceNotFound:   "指定された{field}は存在しないか削除されています",
+		FieldReferenceInactive:   "指定された{field}は無効です",
+		FieldRequiredWith:        "{0}を指定する場合、{field}は必須です",
+		FieldDuplicated:          "同じ{field}が複数指定されています",
+		FieldAlreadyExists:       "{field}は既に登録されています",
+		FieldAnyOfRequired:       "{0}のいずれかを指定してください",
+		FieldWebhookURLRequired:  "Webhook通知を有効にする場合は{field}にhttpまたはhttpsのURLを指定してください",
+		FieldApprovedAssessments: "承認済の査定が{0}件あります",
+		FieldPaidApplications:    "支払処理中または完了の支援申請が{0}件あります",
+		FieldLoginStateMismatch:  "ログイン状態が一致しません。再度ログインしてください",
+	},
+	i18n.English: {
+		FieldRequired:            "{field} is required",
+		FieldMin:                 "{field} must be {0} or greater",
+		FieldMax:                 "{field} must be {0} or less",
+		FieldMinLength:           "{field} must be at least {0} characters",
+		FieldMaxLength:           "{field} must be at most {0} characters",
+		FieldMinItems:            "{field} must contain at least {0} item(s)",
+		FieldMaxItems:            "{field} must contain at most {0} item(s)",
+		FieldRange:               "{field} must be between {0} and {1}",
+		FieldOneOf:               "{field} must be one of {0}",
+		FieldEmail:               "{field} must be a valid email address",
+		FieldDateTime:            "{field} must be an RFC 3339 date-time",
+		FieldDate:                "{field} must be a date in YYYY-MM-DD format",
+		FieldType:                "{field} must be of type {0}",
+		FieldNumeric:             "{field} must be a number",
+		FieldUUID:                "{field} must be a UUID",
+		FieldBoolean:             "{field} must be true or false",
+		FieldURL:                 "{field} must be an http or https URL",
+		FieldPrefectureCode:      "{field} must be a two-digit prefecture code",
+		FieldInvalid:             "{field} is invalid",
+		FieldMalformedBody:       "The request body is malformed",
+		FieldUnreadableBody:      "The request body could not be read",
+		FieldReferenceNotFound:   "The specified {field} does not exist or has been deleted",
+		FieldReferenceInactive:   "The specified {field} is inactive",
+		FieldRequiredWith:        "{field} is required when {0} is specified",
+		FieldDuplicated:          "{field} is specified more than once",
+		FieldAlreadyExists:       "{field} is already registered",
+		FieldAnyOfRequired:       "Specify at least one of {0}",
+		FieldWebhookURLRequired:  "{field} must be an http or https URL when webhook notifications are enabled",
+		FieldApprovedAssessments: "There are {0} approved assessments",
+		FieldPaidApplications:    "There are {0} support applications that are being paid or have been paid",
+		FieldLoginStateMismatch:  "The login state does not match. Sign in again",
+	},
+}
+
+// fieldNames are the display names of the request fields, keyed by the field name without its parents and indexes
+var fieldNames = map[i18n.Locale]map[string]string{
+	i18n.Japanese: {
+		"address":                 "住所",
+		"affected_area_size":      "被害面積",
+		"applicant_name":          "申請者名",
+		"applicant_user_id":       "申請者",
+		"application_date":        "申請日",
+		"application_id":          "申請ID",
+		"before":                  "日時",
+		"before_id":               "取得開始ID",
+		"body":                    "リクエストボディ",
+		"category_name":           "工種区分名",
+		"description":             "説明",
+		"disaster_id":             "災害",
+		"disaster_name":           "災害名",
+		"email":                   "メールアドレス",
+		"email_frequency":         "メール通知頻度",
+		"estimated_damage_amount": "被害見込額",
+		"event_types":             "イベント種別",
+		"facility_type_id":        "施設種別",
+		"force":                   "強制削除",
+		"icon_name":               "アイコン名",
+		"id":                      "ID",
+		"ids":                     "通知ID",
+		"job_type":                "ジョブ種別",
+		"latitude":                "緯度",
+		"limit":                   "件数",
+		"longitude":               "経度",
+		"message":                 "本文",
+		"municipality_id":         "自治体",
+		"name":                    "名称",
+		"notification_type":       "通知種別",
+		"occurred_at":             "発生日時",
+		"password":                "パスワード",
+		"place_id":                "場所ID",
+		"preferences":             "通知設定",
+		"query":                   "トークン",
+		"related_entity_id":       "関連エンティティ",
+		"related_entity_type":     "関連エンティティ種別",
+		"requested_amount":        "申請額",
+		"secret":                  "シークレット",
+		"state":                   "ログイン状態",
+		"status":                  "ステータス",
+		"summary":                 "概要",
+		"target_id":               "配信対象",
+		"target_type":             "配信対象種別",
+		"title":                   "タイトル",
+		"type":                    "種別",
+		"url":                     "URL",
+		"user_id":                 "ユーザー",
+		"webhook_url":             "Webhook URL",
+		"work_category_id":        "工種区分",
+	},
+	// 英語は表示名がなければフィールド名から作るため、そのままでは読みにくいものだけ定義する
+	i18n.English: {
+		"applicant_user_id": "Applicant",
+		"before_id":         "Cursor ID",
+		"body":              "Request body",
+		"disaster_id":       "Disaster",
+		"email":             "Email address",
+		"facility_type_id":  "Facility type",
+		"ids":               "Notification IDs",
+		"municipality_id":   "Municipality",
+		"query":             "Token",
+		"related_entity_id": "Related entity",
+		"state":             "Login state",
+		"target_id":         "Target",
+		"url":               "URL",
+		"user_id":           "User",
+		"webhook_url":       "Webhook URL",
+		"work_category_id":  "Work category",
+	},
+}
+
+// fieldIndexPattern matches the array indexes in a field path such as preferences[0].notification_type
+var fieldIndexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// NewFieldError creates a field error whose message is rendered from the catalogs with params
+func NewFieldError(field string, key FieldMessage, params ...any) FieldError {
+	fe := FieldError{
+		Field:  field,
+		key:    key,
+		params: params,
+	}
+	fe.Message = fe.render(i18n.DefaultLocale)
+
+	return fe
+}
+
+// Localize returns a copy of the field error with its message in locale
+func (e FieldError) Localize(locale i18n.Locale) FieldError {
+	if e.key == "" {
+		return e
+	}
+	e.Message = e.render(locale)
+
+	return e
+}
+
+func (e FieldError) render(locale i18n.Locale) string {
+	template, ok := fieldMessages[locale][e.key]
+	if !ok {
+		template = fieldMessages[i18n.DefaultLocale][e.key]
+	}
+
+	replacements := []string{"{field}", FieldDisplayName(locale, e.Field)}
+	for i, param := range e.params {
+		replacements = append(replacements, "{"+strconv.Itoa(i)+"}", fmt.Sprint(param))
+	}
+
+	return strings.NewReplacer(replacements...).Replace(template)
+}
+
+// FieldDisplayName returns the name of a request field shown in messages. Fields without a display name are
+// shown by their name, made readable in English.
+func FieldDisplayName(locale i18n.Locale, field string) string {
+	// 配列やネストしたフィールドは末尾の名前で表示名を引く
+	name := fieldIndexPattern.ReplaceAllString(field, "")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+
+	if displayName, ok := fieldNames[locale][name]; ok {
+		return displayName
+	}
+
+	if locale != i18n.English || name == "" {
+		return name
+	}
+
+	words := strings.Split(name, "_")
+	for i, word := range words {
+		if word == "id" {
+			words[i] = "ID"
+		}
+	}
+	readable := strings.Join(words, " ")
+
+	return strings.ToUpper(readable[:1]) + readable[1:]
+}
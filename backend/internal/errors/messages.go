@@ -0,0 +1,73 @@
+package myerrors
+
+import "github.com/AI1411/fullstack-react-go/internal/i18n"
+
+// messages are the catalogs of the error messages keyed by error code
+var messages = map[i18n.Locale]map[ErrorCode]string{
+	i18n.Japanese: {
+		SystemError:                     string(SystemErrorMessage),
+		ValidationError:                 string(ValidationErrorMessage),
+		PrefectureNotFoundError:         string(PrefectureNotFoundErrorMessage),
+		EmailVarificationTokenNotFound:  string(EmailVarificationTokenNotFoundErrorMessage),
+		EmailVarificationTokenUsedError: string(EmailVarificationTokenUsedErrorMessage),
+		DisasterNotFoundError:           string(DisasterNotFoundErrorMessage),
+		PreconditionFailedError:         string(PreconditionFailedErrorMessage),
+		PreconditionRequiredError:       string(PreconditionRequiredErrorMessage),
+		IdempotencyKeyReusedError:       string(IdempotencyKeyReusedErrorMessage),
+		IdempotencyKeyInProgressError:   string(IdempotencyKeyInProgressErrorMessage),
+		TrashItemNotFoundError:          string(TrashItemNotFoundErrorMessage),
+		TrashParentDeletedError:         string(TrashParentDeletedErrorMessage),
+		TrashItemReferencedError:        string(TrashItemReferencedErrorMessage),
+		DisasterDeleteBlockedError:      string(DisasterDeleteBlockedErrorMessage),
+		JobNotRetryableError:            string(JobNotRetryableErrorMessage),
+		WebhookSubscriptionNotFound:     string(WebhookSubscriptionNotFoundErrorMessage),
+		InvalidStatusTransitionError:    string(InvalidStatusTransitionErrorMessage),
+		RateLimitExceededError:          string(RateLimitExceededErrorMessage),
+		NotFoundError:                   string(NotFoundErrorMessage),
+		DuplicateError:                  string(DuplicateErrorMessage),
+		ReferenceNotFoundError:          string(ReferenceNotFoundErrorMessage),
+		ResourceInUseError:              string(ResourceInUseErrorMessage),
+		UnauthorizedError:               string(UnauthorizedErrorMessage),
+		ForbiddenError:                  string(ForbiddenErrorMessage),
+		RouteNotFoundError:              string(RouteNotFoundErrorMessage),
+		MethodNotAllowedError:           string(MethodNotAllowedErrorMessage),
+	},
+	i18n.English: {
+		SystemError:                     "A system error occurred",
+		ValidationError:                 "The request has invalid values",
+		PrefectureNotFoundError:         "The prefecture does not exist",
+		EmailVarificationTokenNotFound:  "The email verification token does not exist",
+		EmailVarificationTokenUsedError: "The email verification token has already been used",
+		DisasterNotFoundError:           "The disaster does not exist",
+		PreconditionFailedError:         "The resource has been updated by another user. Fetch the latest version and try again",
+		PreconditionRequiredError:       "Specify the If-Match header",
+		IdempotencyKeyReusedError:       "This Idempotency-Key has been used for a different request",
+		IdempotencyKeyInProgressError:   "A request with the same Idempotency-Key is being processed",
+		TrashItemNotFoundError:          "The item does not exist in the trash",
+		TrashParentDeletedError:         "The item cannot be restored because its parent is deleted. Restore the parent first",
+		TrashItemReferencedError:        "The item cannot be deleted permanently because other data refers to it",
+		DisasterDeleteBlockedError:      "The disaster has approved assessments or paid support applications. Specify force=true to delete it anyway",
+		JobNotRetryableError:            "There is no job to retry. Only dead jobs can be retried",
+		WebhookSubscriptionNotFound:     "The webhook subscription does not exist",
+		InvalidStatusTransitionError:    "The status cannot be changed from the current status to the requested one",
+		RateLimitExceededError:          "Too many requests. Wait a moment and try again",
+		NotFoundError:                   "The resource does not exist",
+		DuplicateError:                  "The resource already exists",
+		ReferenceNotFoundError:          "A referenced resource does not exist",
+		ResourceInUseError:              "The resource cannot be changed because other data refers to it",
+		UnauthorizedError:               "Authentication is required",
+		ForbiddenError:                  "You do not have permission to perform this operation",
+		RouteNotFoundError:              "The API does not exist",
+		MethodNotAllowedError:           "The HTTP method is not allowed",
+	},
+}
+
+// LocalizedMessage returns the message of the error code in locale, or the message the error was created with
+// when the catalog has none
+func (e APIError) LocalizedMessage(locale i18n.Locale) ErrorMessage {
+	if message, ok := messages[locale][e.Code]; ok {
+		return ErrorMessage(message)
+	}
+
+	return e.Message
+}
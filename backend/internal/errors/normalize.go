@@ -0,0 +1,172 @@
+package myerrors
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/i18n"
+)
+
+// PostgreSQLのエラーコード
+const (
+	uniqueViolation           = "23505"
+	foreignKeyViolation       = "23503"
+	notNullViolation          = "23502"
+	checkViolation            = "23514"
+	invalidTextRepresentation = "22P02"
+)
+
+// statusCodes is the HTTP status each error code is returned with; codes not listed are returned as 500
+var statusCodes = map[ErrorCode]int{
+	ValidationError:                 http.StatusBadRequest,
+	PrefectureNotFoundError:         http.StatusNotFound,
+	EmailVarificationTokenNotFound:  http.StatusNotFound,
+	EmailVarificationTokenUsedError: http.StatusConflict,
+	DisasterNotFoundError:           http.StatusNotFound,
+	PreconditionFailedError:         http.StatusPreconditionFailed,
+	PreconditionRequiredError:       http.StatusPreconditionRequired,
+	IdempotencyKeyReusedError:       http.StatusUnprocessableEntity,
+	IdempotencyKeyInProgressError:   http.StatusConflict,
+	TrashItemNotFoundError:          http.StatusNotFound,
+	TrashParentDeletedError:         http.StatusConflict,
+	TrashItemReferencedError:        http.StatusConflict,
+	DisasterDeleteBlockedError:      http.StatusConflict,
+	JobNotRetryableError:            http.StatusConflict,
+	WebhookSubscriptionNotFound:     http.StatusNotFound,
+	InvalidStatusTransitionError:    http.StatusConflict,
+	RateLimitExceededError:          http.StatusTooManyRequests,
+	NotFoundError:                   http.StatusNotFound,
+	DuplicateError:                  http.StatusConflict,
+	ReferenceNotFoundError:          http.StatusBadRequest,
+	ResourceInUseError:              http.StatusConflict,
+	UnauthorizedError:               http.StatusUnauthorized,
+	ForbiddenError:                  http.StatusForbidden,
+	RouteNotFoundError:              http.StatusNotFound,
+	MethodNotAllowedError:           http.StatusMethodNotAllowed,
+}
+
+// HTTPStatus returns the HTTP status code the error is returned with
+func (e APIError) HTTPStatus() int {
+	if status, ok := statusCodes[e.Code]; ok {
+		return status
+	}
+
+	return http.StatusInternalServerError
+}
+
+// keyColumnsPattern matches the columns in the detail of a constraint violation such as "Key (email)=(a@example.com) already exists."
+var keyColumnsPattern = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+
+// Normalize converts err into the APIError returned to clients.
+// APIErrors are returned as they are, record not found and constraint violations are mapped to their codes,
+// and anything else becomes a SystemError so that internal details are not exposed.
+func Normalize(err error) *APIError {
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	// 値で返されたAPIErrorも同じように扱う
+	var apiErrValue APIError
+	if errors.As(err, &apiErrValue) {
+		return &apiErrValue
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return NewAPIError(NotFoundError, NotFoundErrorMessage, err, "record not found")
+	}
+
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return NewAPIError(DuplicateError, DuplicateErrorMessage, err, "duplicated key")
+	}
+
+	if errors.Is(err, gorm.ErrForeignKeyViolated) {
+		return NewAPIError(ReferenceNotFoundError, ReferenceNotFoundErrorMessage, err, "foreign key violated")
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if normalized := normalizePgError(pgErr, err); normalized != nil {
+			return normalized
+		}
+	}
+
+	return NewAPIError(SystemError, SystemErrorMessage, err, "unexpected error")
+}
+
+// normalizePgError maps the constraint violations caused by client input; it returns nil for any other error
+func normalizePgError(pgErr *pgconn.PgError, err error) *APIError {
+	switch pgErr.Code {
+	case uniqueViolation:
+		apiErr := NewAPIError(DuplicateError, DuplicateErrorMessage, err, "unique violation")
+		apiErr.Fields = constraintFields(pgErr, FieldAlreadyExists)
+
+		return apiErr
+	case foreignKeyViolation:
+		// 削除・更新される側のエラーは参照元が残っていることを表す
+		if strings.HasPrefix(pgErr.Message, "update or delete on table") {
+			return NewAPIError(ResourceInUseError, ResourceInUseErrorMessage, err, "foreign key violation on delete")
+		}
+
+		apiErr := NewAPIError(ReferenceNotFoundError, ReferenceNotFoundErrorMessage, err, "foreign key violation")
+		apiErr.Fields = constraintFields(pgErr, FieldReferenceNotFound)
+
+		return apiErr
+	case notNullViolation, checkViolation, invalidTextRepresentation:
+		apiErr := NewAPIError(ValidationError, ValidationErrorMessage, err, "invalid value")
+		apiErr.Fields = constraintFields(pgErr, FieldInvalid)
+
+		return apiErr
+	default:
+		return nil
+	}
+}
+
+// constraintFields returns the columns of the violated constraint as field errors
+func constraintFields(pgErr *pgconn.PgError, key FieldMessage) []FieldError {
+	var columns []string
+	if pgErr.ColumnName != "" {
+		columns = []string{pgErr.ColumnName}
+	} else if m := keyColumnsPattern.FindStringSubmatch(pgErr.Detail); m != nil {
+		columns = strings.Split(m[1], ", ")
+	}
+
+	fields := make([]FieldError, 0, len(columns))
+	for _, column := range columns {
+		fields = append(fields, NewFieldError(column, key))
+	}
+
+	return fields
+}
+
+// ErrorResponse is the body of every error response
+type ErrorResponse struct {
+	Code    ErrorCode    `json:"code"`
+	Message ErrorMessage `json:"message"`
+	Fields  []FieldError `json:"fields,omitempty"`
+	TraceID string       `json:"trace_id,omitempty"`
+}
+
+// Response returns the body the error is returned with, with its messages in locale
+func (e APIError) Response(locale i18n.Locale, traceID string) ErrorResponse {
+	var fields []FieldError
+	for _, field := range e.Fields {
+		fields = append(fields, field.Localize(locale))
+	}
+
+	return ErrorResponse{
+		Code:    e.Code,
+		Message: e.LocalizedMessage(locale),
+		Fields:  fields,
+		TraceID: traceID,
+	}
+}
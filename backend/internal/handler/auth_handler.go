@@ -3,6 +3,7 @@ package handler
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"net/http"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/env"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 )
@@ -64,22 +66,12 @@ func generateRandomState() (string, error) {
 }
 
 // Login initiates the OIDC authentication flow
-// @title ログイン
-// @id Login
-// @tags auth
-// @accept json
-// @produce json
-// @version 1.0
-// @description OIDCプロバイダーへのログインを開始します
-// @Summary OIDCログイン
-// @Success 302
-// @Router /auth/login [get]
 func (h *authHandler) Login(c *gin.Context) {
 	// Generate random state for CSRF protection
 	state, err := generateRandomState()
 	if err != nil {
 		h.logger.Error("Failed to generate random state", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to initiate login"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -92,20 +84,6 @@ func (h *authHandler) Login(c *gin.Context) {
 }
 
 // Callback handles the OIDC callback
-// @title OIDCコールバック
-// @id Callback
-// @tags auth
-// @accept json
-// @produce json
-// @version 1.0
-// @description OIDCプロバイダーからのコールバックを処理します
-// @Summary OIDCコールバック
-// @Param code query string true "認証コード"
-// @Param state query string true "状態"
-// @Success 200 {object} map[string]string
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /auth/callback [get]
 func (h *authHandler) Callback(c *gin.Context) {
 	ctx := c.Request.Context()
 
@@ -114,7 +92,7 @@ func (h *authHandler) Callback(c *gin.Context) {
 	storedState, err := c.Cookie("auth_state")
 	if err != nil || state != storedState {
 		h.logger.Error("Invalid state", "error", err, "state", state, "storedState", storedState)
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid state"})
+		_ = c.Error(newInvalidParamError("state", myerrors.FieldLoginStateMismatch))
 		return
 	}
 
@@ -126,7 +104,7 @@ func (h *authHandler) Callback(c *gin.Context) {
 	oauth2Token, err := h.oauth2Config.Exchange(ctx, code)
 	if err != nil {
 		h.logger.Error("Failed to exchange code for token", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to exchange code for token"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -134,7 +112,7 @@ func (h *authHandler) Callback(c *gin.Context) {
 	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
 	if !ok {
 		h.logger.Error("No ID token in token response")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "No ID token in token response"})
+		_ = c.Error(errors.New("no id_token in token response"))
 		return
 	}
 
@@ -142,7 +120,7 @@ func (h *authHandler) Callback(c *gin.Context) {
 	idToken, err := h.verifier.Verify(ctx, rawIDToken)
 	if err != nil {
 		h.logger.Error("Failed to verify ID token", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify ID token"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -155,7 +133,7 @@ func (h *authHandler) Callback(c *gin.Context) {
 	}
 	if err := idToken.Claims(&claims); err != nil {
 		h.logger.Error("Failed to extract claims", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to extract claims"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -170,7 +148,7 @@ func (h *authHandler) Callback(c *gin.Context) {
 		}
 		if err := h.userUseCase.CreateUser(ctx, user); err != nil {
 			h.logger.Error("Failed to create user", "error", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user"})
+			_ = c.Error(err)
 			return
 		}
 	}
@@ -179,7 +157,7 @@ func (h *authHandler) Callback(c *gin.Context) {
 	tokenString, err := h.authUsecase.GenerateToken(user)
 	if err != nil {
 		h.logger.Error("Failed to generate token", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -187,32 +165,15 @@ func (h *authHandler) Callback(c *gin.Context) {
 	c.SetCookie("auth_token", tokenString, h.env.JWTExpiration, "/", "", false, true)
 
 	// Return token
-	c.JSON(http.StatusOK, gin.H{
-		"token": tokenString,
-		"user": gin.H{
-			"id":    user.ID,
-			"name":  user.Name,
-			"email": user.Email,
-		},
-	})
+	c.JSON(http.StatusOK, newAuthTokenResponse(tokenString, user))
 }
 
 // Logout logs out the user
-// @title ログアウト
-// @id Logout
-// @tags auth
-// @accept json
-// @produce json
-// @version 1.0
-// @description ユーザーをログアウトします
-// @Summary ログアウト
-// @Success 200 {object} map[string]string
-// @Router /auth/logout [post]
 func (h *authHandler) Logout(c *gin.Context) {
 	// Clear token cookie
 	c.SetCookie("auth_token", "", -1, "/", "", false, true)
 
-	c.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
+	c.JSON(http.StatusOK, &MessageResponse{Message: "Logged out successfully"})
 }
 
 // RegisterRequest defines the request body for user registration
@@ -222,25 +183,38 @@ type RegisterRequest struct {
 	Password string `json:"password" binding:"required,min=6"`
 }
 
-type RegisterResponse struct {
-	Token string       `json:"token"`
-	User  UserResponse `json:"user"`
+// AuthTokenResponse is returned when a login or registration issues a token. The token is also set in the
+// auth_token cookie.
+type AuthTokenResponse struct {
+	Token string           `json:"token"`
+	User  AuthUserResponse `json:"user"`
+}
+
+// AuthUserResponse is the user the token was issued for
+type AuthUserResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// MessageResponse is a response that only carries a message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// newAuthTokenResponse builds the response for a token issued to the user
+func newAuthTokenResponse(token string, user *model.User) *AuthTokenResponse {
+	return &AuthTokenResponse{
+		Token: token,
+		User: AuthUserResponse{
+			ID:    user.ID,
+			Name:  user.Name,
+			Email: user.Email,
+		},
+	}
 }
 
 // Register registers a new user
-// @title ユーザー登録
-// @id Register
-// @tags auth
-// @accept json
-// @produce json
-// @version 1.0
-// @description 新規ユーザーを登録します
-// @Summary ユーザー登録
-// @Param request body RegisterRequest true "ユーザー登録リクエスト"
-// @Success 201 {object} RegisterResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /auth/register [post]
 func (h *authHandler) Register(c *gin.Context) {
 	ctx := c.Request.Context()
 
@@ -248,7 +222,7 @@ func (h *authHandler) Register(c *gin.Context) {
 	var req RegisterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error("Invalid request body", "error", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
@@ -256,7 +230,12 @@ func (h *authHandler) Register(c *gin.Context) {
 	existingUser, err := h.userUseCase.GetUserByEmail(ctx, req.Email)
 	if err == nil && existingUser != nil {
 		h.logger.Error("User already exists", "email", req.Email)
-		c.JSON(http.StatusBadRequest, gin.H{"error": "User with this email already exists"})
+		_ = c.Error(myerrors.NewAPIError(
+			myerrors.DuplicateError,
+			myerrors.DuplicateErrorMessage,
+			errors.New("email is already registered"),
+			"user already exists",
+		))
 		return
 	}
 
@@ -264,7 +243,7 @@ func (h *authHandler) Register(c *gin.Context) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
 		h.logger.Error("Failed to hash password", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -283,7 +262,7 @@ func (h *authHandler) Register(c *gin.Context) {
 
 	if err := h.userUseCase.CreateUser(ctx, user); err != nil {
 		h.logger.Error("Failed to create user", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -291,7 +270,7 @@ func (h *authHandler) Register(c *gin.Context) {
 	tokenString, err := h.authUsecase.GenerateToken(user)
 	if err != nil {
 		h.logger.Error("Failed to generate token", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate authentication token"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -299,14 +278,7 @@ func (h *authHandler) Register(c *gin.Context) {
 	c.SetCookie("auth_token", tokenString, h.env.JWTExpiration, "/", "", false, true)
 
 	// Return token and user info
-	c.JSON(http.StatusCreated, gin.H{
-		"token": tokenString,
-		"user": gin.H{
-			"id":    user.ID,
-			"name":  user.Name,
-			"email": user.Email,
-		},
-	})
+	c.JSON(http.StatusCreated, newAuthTokenResponse(tokenString, user))
 }
 
 type VerifyEmailRequest struct {
@@ -316,7 +288,7 @@ type VerifyEmailRequest struct {
 func (h *authHandler) VerifyEmail(c *gin.Context) {
 	var req VerifyEmailRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "無効なリクエストです"})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
@@ -324,9 +296,9 @@ func (h *authHandler) VerifyEmail(c *gin.Context) {
 	err := h.authUsecase.ValidateEmailVarificationToken(c.Request.Context(), req.Token)
 	if err != nil {
 		h.logger.Error("Failed to verify email", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "メールアドレスの確認に失敗しました"})
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "メールアドレスが確認されました"})
+	c.JSON(http.StatusOK, &MessageResponse{Message: "メールアドレスが確認されました"})
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 )
@@ -50,25 +51,18 @@ type UpdateDamageLevelRequest struct {
 	Description *string `json:"description"`
 }
 
-// ListDamageLevels @title 被害程度一覧取得
-// @id ListDamageLevels
-// @tags damage_levels
-// @accept json
-// @produce json
-// @Summary 被害程度一覧取得
-// @Success 200 {array} DamageLevelResponse
-// @Router /damage-levels [get]
+// ListDamageLevels 被害程度一覧取得
 func (h *damageLevelHandler) ListDamageLevels(c *gin.Context) {
 	ctx := c.Request.Context()
 	damageLevels, err := h.damageLevelUseCase.ListDamageLevels(ctx)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to list damage levels")
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var response []*DamageLevelResponse
+	response := make([]*DamageLevelResponse, 0, len(damageLevels))
 	for _, damageLevel := range damageLevels {
 		response = append(response, &DamageLevelResponse{
 			ID:          damageLevel.ID,
@@ -81,16 +75,7 @@ func (h *damageLevelHandler) ListDamageLevels(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// GetDamageLevel @title 被害程度詳細取得
-// @id GetDamageLevel
-// @tags damage_levels
-// @accept json
-// @produce json
-// @Param id path int true "被害程度ID"
-// @Summary 被害程度詳細取得
-// @Success 200 {object} DamageLevelResponse
-// @Failure 404 {object} map[string]string
-// @Router /damage-levels/{id} [get]
+// GetDamageLevel 被害程度詳細取得
 func (h *damageLevelHandler) GetDamageLevel(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -98,7 +83,7 @@ func (h *damageLevelHandler) GetDamageLevel(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid damage level ID", "damage_level_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid damage level ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
@@ -106,7 +91,7 @@ func (h *damageLevelHandler) GetDamageLevel(c *gin.Context) {
 	damageLevel, err := h.damageLevelUseCase.GetDamageLevelByID(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Damage level not found", "damage_level_id", id)
-		c.JSON(404, gin.H{"error": "Damage level not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -121,20 +106,11 @@ func (h *damageLevelHandler) GetDamageLevel(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateDamageLevel @title 被害程度作成
-// @id CreateDamageLevel
-// @tags damage_levels
-// @accept json
-// @produce json
-// @Param request body CreateDamageLevelRequest true "被害程度作成リクエスト"
-// @Summary 被害程度作成
-// @Success 201 {object} DamageLevelResponse
-// @Failure 400 {object} map[string]string
-// @Router /damage-levels [post]
+// CreateDamageLevel 被害程度作成
 func (h *damageLevelHandler) CreateDamageLevel(c *gin.Context) {
 	var req CreateDamageLevelRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
@@ -146,7 +122,7 @@ func (h *damageLevelHandler) CreateDamageLevel(c *gin.Context) {
 	err := h.damageLevelUseCase.CreateDamageLevel(c.Request.Context(), damageLevel)
 	if err != nil {
 		h.l.ErrorContext(c.Request.Context(), err, "Failed to create damage level")
-		c.JSON(500, gin.H{"error": "Failed to create damage level"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -161,18 +137,7 @@ func (h *damageLevelHandler) CreateDamageLevel(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
-// UpdateDamageLevel @title 被害程度更新
-// @id UpdateDamageLevel
-// @tags damage_levels
-// @accept json
-// @produce json
-// @Param id path int true "被害程度ID"
-// @Param request body UpdateDamageLevelRequest true "被害程度更新リクエスト"
-// @Summary 被害程度更新
-// @Success 200 {object} DamageLevelResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /damage-levels/{id} [put]
+// UpdateDamageLevel 被害程度更新
 func (h *damageLevelHandler) UpdateDamageLevel(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -180,14 +145,14 @@ func (h *damageLevelHandler) UpdateDamageLevel(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid damage level ID", "damage_level_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid damage level ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
 
 	var req UpdateDamageLevelRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
@@ -195,7 +160,7 @@ func (h *damageLevelHandler) UpdateDamageLevel(c *gin.Context) {
 	damageLevel, err := h.damageLevelUseCase.GetDamageLevelByID(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Damage level not found", "damage_level_id", id)
-		c.JSON(404, gin.H{"error": "Damage level not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -212,7 +177,7 @@ func (h *damageLevelHandler) UpdateDamageLevel(c *gin.Context) {
 	err = h.damageLevelUseCase.UpdateDamageLevel(ctx, damageLevel)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to update damage level", "damage_level_id", id)
-		c.JSON(500, gin.H{"error": "Failed to update damage level"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -227,15 +192,7 @@ func (h *damageLevelHandler) UpdateDamageLevel(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// DeleteDamageLevel @title 被害程度削除
-// @id DeleteDamageLevel
-// @tags damage_levels
-// @Param id path int true "被害程度ID"
-// @Summary 被害程度削除
-// @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /damage-levels/{id} [delete]
+// DeleteDamageLevel 被害程度削除
 func (h *damageLevelHandler) DeleteDamageLevel(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -243,7 +200,7 @@ func (h *damageLevelHandler) DeleteDamageLevel(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid damage level ID", "damage_level_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid damage level ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
@@ -252,7 +209,7 @@ func (h *damageLevelHandler) DeleteDamageLevel(c *gin.Context) {
 	_, err = h.damageLevelUseCase.GetDamageLevelByID(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Damage level not found for deletion", "damage_level_id", id)
-		c.JSON(404, gin.H{"error": "Damage level not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -260,7 +217,7 @@ func (h *damageLevelHandler) DeleteDamageLevel(c *gin.Context) {
 	err = h.damageLevelUseCase.DeleteDamageLevel(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to delete damage level", "damage_level_id", id)
-		c.JSON(500, gin.H{"error": "Failed to delete damage level"})
+		_ = c.Error(err)
 
 		return
 	}
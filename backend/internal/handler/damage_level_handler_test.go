@@ -12,16 +12,19 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
 	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
 func setupTest(t *testing.T) (*gin.Engine, *mockusecase.MockDamageLevelUseCase, handler.DamageLevel) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
 	ctrl := gomock.NewController(t)
 	mockUseCase := mockusecase.NewMockDamageLevelUseCase(ctrl)
 	l := logger.New(logger.DefaultConfig())
@@ -150,7 +153,7 @@ func TestDamageLevelHandler_GetDamageLevel(t *testing.T) {
 			name:          "Not Found",
 			damageLevelID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockDamageLevelUseCase) {
-				mockUseCase.EXPECT().GetDamageLevelByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetDamageLevelByID(gomock.Any(), int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -340,7 +343,7 @@ func TestDamageLevelHandler_UpdateDamageLevel(t *testing.T) {
 				Name: "更新被害程度",
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockDamageLevelUseCase) {
-				mockUseCase.EXPECT().GetDamageLevelByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetDamageLevelByID(gomock.Any(), int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -437,7 +440,7 @@ func TestDamageLevelHandler_DeleteDamageLevel(t *testing.T) {
 			name:          "Not Found",
 			damageLevelID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockDamageLevelUseCase) {
-				mockUseCase.EXPECT().GetDamageLevelByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetDamageLevelByID(gomock.Any(), int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
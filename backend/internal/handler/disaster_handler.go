@@ -2,11 +2,13 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/datastore"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
@@ -60,14 +62,14 @@ type DisasterResponse struct {
 	PlaceID               *string      `json:"place_id"`
 	Municipality          Municipality `json:"municipality"`
 	WorkCategory          WorkCategory `json:"work_category"`
-	Timelines             []Timeline   `json:"timelines"`
 }
 
+// ListDisastersRequest filters the disaster list; the dates bound the occurrence time
 type ListDisastersRequest struct {
-	Name           string `form:"name"`
-	MunicipalityID string `form:"municipality_id"`
-	WorkCategoryID string `form:"work_category_id"`
-	Status         string `form:"status"`
+	Name      string `form:"name"`
+	Status    string `form:"status" binding:"omitempty,oneof=pending under_review in_progress completed"`
+	StartDate string `form:"start_date" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EndDate   string `form:"end_date" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 }
 
 type ListDisastersResponse struct {
@@ -76,103 +78,80 @@ type ListDisastersResponse struct {
 }
 
 type CreateDisasterRequest struct {
-	DisasterCode          string   `json:"disaster_code" binding:"required"`
-	Name                  string   `json:"name" binding:"required"`
-	PrefectureID          int32    `json:"prefecture_id" binding:"required"`
-	OccurredAt            string   `json:"occurred_at" binding:"required"`
+	Name                  string   `json:"name" binding:"required,max=100"`
+	MunicipalityID        int32    `json:"municipality_id" binding:"required"`
+	OccurredAt            string   `json:"occurred_at" binding:"required,datetime=2006-01-02T15:04:05Z07:00"`
 	Summary               string   `json:"summary" binding:"required"`
-	DisasterType          string   `json:"disaster_type" binding:"required"`
-	Status                string   `json:"status"`
-	ImpactLevel           string   `json:"impact_level" binding:"required"`
-	AffectedAreaSize      *float64 `json:"affected_area_size"`
-	EstimatedDamageAmount *float64 `json:"estimated_damage_amount"`
+	WorkCategoryID        int64    `json:"work_category_id" binding:"required"`
+	Status                string   `json:"status" binding:"omitempty,oneof=pending under_review in_progress completed"`
+	AffectedAreaSize      *float64 `json:"affected_area_size" binding:"omitempty,gte=0"`
+	EstimatedDamageAmount *float64 `json:"estimated_damage_amount" binding:"omitempty,gte=0"`
+	Latitude              *float64 `json:"latitude" binding:"omitempty,gte=-90,lte=90"`
+	Longitude             *float64 `json:"longitude" binding:"omitempty,gte=-180,lte=180"`
+	Address               *string  `json:"address"`
+	PlaceID               *string  `json:"place_id" binding:"omitempty,max=255"`
 }
 
+// UpdateDisasterRequest is a partial update; omitted fields are left unchanged
 type UpdateDisasterRequest struct {
-	DisasterCode          string   `json:"disaster_code"`
-	Name                  string   `json:"name"`
-	PrefectureID          int32    `json:"prefecture_id"`
-	OccurredAt            string   `json:"occurred_at"`
-	Summary               string   `json:"summary"`
-	DisasterType          string   `json:"disaster_type"`
-	Status                string   `json:"status"`
-	ImpactLevel           string   `json:"impact_level"`
-	AffectedAreaSize      *float64 `json:"affected_area_size"`
-	EstimatedDamageAmount *float64 `json:"estimated_damage_amount"`
+	Name                  *string  `json:"name" binding:"omitempty,min=1,max=100"`
+	MunicipalityID        *int32   `json:"municipality_id" binding:"omitempty,min=1"`
+	OccurredAt            *string  `json:"occurred_at" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Summary               *string  `json:"summary" binding:"omitempty,min=1"`
+	WorkCategoryID        *int64   `json:"work_category_id" binding:"omitempty,min=1"`
+	Status                *string  `json:"status" binding:"omitempty,oneof=pending under_review in_progress completed"`
+	AffectedAreaSize      *float64 `json:"affected_area_size" binding:"omitempty,gte=0"`
+	EstimatedDamageAmount *float64 `json:"estimated_damage_amount" binding:"omitempty,gte=0"`
+	Latitude              *float64 `json:"latitude" binding:"omitempty,gte=-90,lte=90"`
+	Longitude             *float64 `json:"longitude" binding:"omitempty,gte=-180,lte=180"`
+	Address               *string  `json:"address"`
+	PlaceID               *string  `json:"place_id" binding:"omitempty,max=255"`
 }
 
-// ListDisasters @title 災害マスタ一覧取得
-// @id ListDisasters
-// @tags disasters
-// @accept json
-// @produce json
-// @version バージョン(1.0)
-// @description
-// @Summary 災害マスタ一覧取得
-// @Param request body ListDisastersRequest true "request body for listing disasters"
-// @Success 200 {array} ListDisastersResponse
-// @Router /disasters [get]
+// DeleteDisasterResponse summarizes what a disaster delete affected
+type DeleteDisasterResponse struct {
+	DisasterID          string           `json:"disaster_id"`
+	Forced              bool             `json:"forced"`
+	ApprovedAssessments int64            `json:"approved_assessments"`
+	PaidApplications    int64            `json:"paid_applications"`
+	DeletedChildren     map[string]int64 `json:"deleted_children"`
+}
+
+// ListDisasters 災害マスタ一覧取得
 func (h *disasterHandler) ListDisasters(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	// Create search parameters
-	params := &datastore.DisasterSearchParams{}
+	var req ListDisastersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
 
-	// Extract search parameters from query
-	if name := c.Query("name"); name != "" {
-		params.Name = name
+		return
 	}
 
-	if status := c.Query("status"); status != "" {
-		params.Status = status
+	params := &datastore.DisasterSearchParams{
+		Name:   req.Name,
+		Status: req.Status,
 	}
-
-	// Parse date range if provided
-	if startDate := c.Query("start_date"); startDate != "" {
-		if date, err := time.Parse(time.RFC3339, startDate); err == nil {
-			params.StartDate = date
-		}
+	// バインド時に形式を検証済みのためエラーにはならない
+	if req.StartDate != "" {
+		params.StartDate, _ = time.Parse(time.RFC3339, req.StartDate)
 	}
 
-	if endDate := c.Query("end_date"); endDate != "" {
-		if date, err := time.Parse(time.RFC3339, endDate); err == nil {
-			params.EndDate = date
-		}
+	if req.EndDate != "" {
+		params.EndDate, _ = time.Parse(time.RFC3339, req.EndDate)
 	}
 
 	disasters, err := h.disasterUseCase.ListDisasters(ctx, params)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to list disasters")
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var ds []*DisasterResponse
+	ds := make([]*DisasterResponse, 0, len(disasters))
 	for _, disaster := range disasters {
-		ds = append(ds, &DisasterResponse{
-			ID:                    disaster.ID,
-			Name:                  disaster.Name,
-			MunicipalityID:        disaster.MunicipalityID,
-			OccurredAt:            disaster.OccurredAt.Format(time.RFC3339),
-			Summary:               disaster.Summary,
-			WorkCategoryID:        disaster.WorkCategoryID,
-			Status:                disaster.Status,
-			AffectedAreaSize:      disaster.AffectedAreaSize,
-			EstimatedDamageAmount: disaster.EstimatedDamageAmount,
-			Latitude:              disaster.Latitude,
-			Longitude:             disaster.Longitude,
-			Address:               disaster.Address,
-			PlaceID:               disaster.PlaceID,
-			Municipality: Municipality{
-				PrefectureNameKanji:   disaster.Municipality.PrefectureNameKanji,
-				MunicipalityNameKanji: disaster.Municipality.MunicipalityNameKanji,
-			},
-			WorkCategory: WorkCategory{
-				CategoryName: disaster.WorkCategory.CategoryName,
-				IconName:     disaster.WorkCategory.IconName,
-			},
-		})
+		ds = append(ds, toDisasterResponse(disaster))
 	}
 
 	res := &ListDisastersResponse{
@@ -183,16 +162,7 @@ func (h *disasterHandler) ListDisasters(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
-// GetDisaster @title 災害詳細取得
-// @id GetDisaster
-// @tags disasters
-// @accept json
-// @produce json
-// @Param id path string true "災害ID"
-// @Summary 災害詳細取得
-// @Success 200 {object} ListDisastersResponse
-// @Failure 404 {object} map[string]string
-// @Router /disasters/{id} [get]
+// GetDisaster 災害詳細取得
 func (h *disasterHandler) GetDisaster(c *gin.Context) {
 	id := c.Param("id")
 	ctx := c.Request.Context()
@@ -200,169 +170,140 @@ func (h *disasterHandler) GetDisaster(c *gin.Context) {
 	disaster, err := h.disasterUseCase.GetDisasterByID(ctx, id)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to get disaster", "disaster_id", id)
-		c.JSON(404, gin.H{"error": "Disaster not found"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	response := &DisasterResponse{
-		ID:                    disaster.ID,
-		Name:                  disaster.Name,
-		MunicipalityID:        disaster.MunicipalityID,
-		OccurredAt:            disaster.OccurredAt.Format(time.RFC3339),
-		Summary:               disaster.Summary,
-		WorkCategoryID:        disaster.WorkCategoryID,
-		Status:                disaster.Status,
-		AffectedAreaSize:      disaster.AffectedAreaSize,
-		EstimatedDamageAmount: disaster.EstimatedDamageAmount,
-		Latitude:              disaster.Latitude,
-		Longitude:             disaster.Longitude,
-		Address:               disaster.Address,
-		PlaceID:               disaster.PlaceID,
-		Municipality: Municipality{
-			PrefectureNameKanji:   disaster.Municipality.PrefectureNameKanji,
-			MunicipalityNameKanji: disaster.Municipality.MunicipalityNameKanji,
-		},
-		WorkCategory: WorkCategory{
-			CategoryName: disaster.WorkCategory.CategoryName,
-			IconName:     disaster.WorkCategory.IconName,
-		},
-	}
+	response := toDisasterResponse(disaster)
 
 	h.l.InfoContext(ctx, "Successfully retrieved disaster", "disaster_id", id)
+	setETag(c, disaster.UpdatedAt)
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateDisaster @title 災害作成
-// @id CreateDisaster
-// @tags disasters
-// @accept json
-// @produce json
-// @Param request body CreateDisasterRequest true "災害作成リクエスト"
-// @Summary 災害作成
-// @Success 201 {object} ListDisastersResponse
-// @Failure 400 {object} map[string]string
-// @Router /disasters [post]
+// CreateDisaster 災害作成
 func (h *disasterHandler) CreateDisaster(c *gin.Context) {
+	ctx := c.Request.Context()
+
 	var req CreateDisasterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
-	// Parse occurred_at
-	occurredAt, err := time.Parse(time.RFC3339, req.OccurredAt)
-	if err != nil {
-		c.JSON(400, gin.H{"error": "Invalid occurred_at format. Use RFC3339 format"})
-		return
-	}
-
-	// Set default status if not provided
-	status := req.Status
+	// bindingでRFC3339形式であることは検証済み
+	occurredAt, _ := time.Parse(time.RFC3339, req.OccurredAt)
 
 	disaster := &model.Disaster{
 		Name:                  req.Name,
+		MunicipalityID:        req.MunicipalityID,
 		OccurredAt:            occurredAt,
 		Summary:               req.Summary,
-		Status:                status,
+		WorkCategoryID:        req.WorkCategoryID,
+		Status:                req.Status,
 		AffectedAreaSize:      req.AffectedAreaSize,
 		EstimatedDamageAmount: req.EstimatedDamageAmount,
+		Latitude:              req.Latitude,
+		Longitude:             req.Longitude,
+		Address:               req.Address,
+		PlaceID:               req.PlaceID,
 		CreatedAt:             time.Now(),
 		UpdatedAt:             time.Now(),
 	}
 
-	err = h.disasterUseCase.CreateDisaster(c.Request.Context(), disaster)
-	if err != nil {
-		c.JSON(500, gin.H{"error": "Failed to create disaster"})
-		return
-	}
+	if err := h.disasterUseCase.CreateDisaster(ctx, disaster); err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to create disaster")
+		_ = c.Error(err)
 
-	response := &DisasterResponse{
-		ID:                    disaster.ID,
-		Name:                  disaster.Name,
-		MunicipalityID:        disaster.MunicipalityID,
-		OccurredAt:            disaster.OccurredAt.Format(time.RFC3339),
-		Summary:               disaster.Summary,
-		WorkCategoryID:        disaster.WorkCategoryID,
-		Status:                disaster.Status,
-		AffectedAreaSize:      disaster.AffectedAreaSize,
-		EstimatedDamageAmount: disaster.EstimatedDamageAmount,
-		Latitude:              disaster.Latitude,
-		Longitude:             disaster.Longitude,
-		Address:               disaster.Address,
-		PlaceID:               disaster.PlaceID,
-		Municipality:          Municipality{},
+		return
 	}
 
-	c.JSON(http.StatusCreated, response)
+	c.JSON(http.StatusCreated, toDisasterResponse(disaster))
 }
 
-// UpdateDisaster @title 災害更新
-// @id UpdateDisaster
-// @tags disasters
-// @accept json
-// @produce json
-// @Param id path string true "災害ID"
-// @Param request body UpdateDisasterRequest true "災害更新リクエスト"
-// @Summary 災害更新
-// @Success 200 {object} ListDisastersResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /disasters/{id} [put]
+// UpdateDisaster 災害更新
 func (h *disasterHandler) UpdateDisaster(c *gin.Context) {
 	id := c.Param("id")
+	ctx := c.Request.Context()
 
-	var req UpdateDisasterRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+	expectedUpdatedAt, err := parseIfMatch(c)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 
-	// Get existing disaster
-	disaster, err := h.disasterUseCase.GetDisasterByID(c.Request.Context(), id)
-	if err != nil {
-		c.JSON(404, gin.H{"error": "Disaster not found"})
+	var req UpdateDisasterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
-	if req.Name != "" {
-		disaster.Name = req.Name
-	}
-	if req.OccurredAt != "" {
-		occurredAt, err := time.Parse(time.RFC3339, req.OccurredAt)
-		if err != nil {
-			c.JSON(400, gin.H{"error": "Invalid occurred_at format. Use RFC3339 format"})
-			return
-		}
-
-		disaster.OccurredAt = occurredAt
+	params := &usecase.UpdateDisasterParams{
+		ExpectedUpdatedAt:     expectedUpdatedAt,
+		Name:                  req.Name,
+		MunicipalityID:        req.MunicipalityID,
+		Summary:               req.Summary,
+		WorkCategoryID:        req.WorkCategoryID,
+		Status:                req.Status,
+		AffectedAreaSize:      req.AffectedAreaSize,
+		EstimatedDamageAmount: req.EstimatedDamageAmount,
+		Latitude:              req.Latitude,
+		Longitude:             req.Longitude,
+		Address:               req.Address,
+		PlaceID:               req.PlaceID,
 	}
 
-	if req.Summary != "" {
-		disaster.Summary = req.Summary
+	if req.OccurredAt != nil {
+		// bindingでRFC3339形式であることは検証済み
+		occurredAt, _ := time.Parse(time.RFC3339, *req.OccurredAt)
+		params.OccurredAt = &occurredAt
 	}
 
-	if req.Status != "" {
-		disaster.Status = req.Status
-	}
+	disaster, err := h.disasterUseCase.UpdateDisaster(ctx, id, params)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to update disaster", "disaster_id", id)
+		_ = c.Error(err)
 
-	if req.AffectedAreaSize != nil {
-		disaster.AffectedAreaSize = req.AffectedAreaSize
+		return
 	}
 
-	if req.EstimatedDamageAmount != nil {
-		disaster.EstimatedDamageAmount = req.EstimatedDamageAmount
-	}
+	setETag(c, disaster.UpdatedAt)
+	c.JSON(http.StatusOK, toDisasterResponse(disaster))
+}
 
-	disaster.UpdatedAt = time.Now()
+// DeleteDisaster 災害削除
+func (h *disasterHandler) DeleteDisaster(c *gin.Context) {
+	id := c.Param("id")
+	ctx := c.Request.Context()
 
-	err = h.disasterUseCase.UpdateDisaster(c.Request.Context(), disaster)
+	force, err := strconv.ParseBool(c.DefaultQuery("force", "false"))
 	if err != nil {
-		c.JSON(500, gin.H{"error": "Failed to update disaster"})
+		_ = c.Error(newInvalidParamError("force", myerrors.FieldBoolean))
+
 		return
 	}
 
-	response := &DisasterResponse{
+	summary, err := h.disasterUseCase.DeleteDisaster(ctx, id, force)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to delete disaster", "disaster_id", id, "force", force)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully deleted disaster", "disaster_id", id, "forced", summary.Forced, "deleted_children", summary.DeletedChildren)
+	c.JSON(http.StatusOK, &DeleteDisasterResponse{
+		DisasterID:          summary.DisasterID,
+		Forced:              summary.Forced,
+		ApprovedAssessments: summary.ApprovedAssessments,
+		PaidApplications:    summary.PaidApplications,
+		DeletedChildren:     summary.DeletedChildren,
+	})
+}
+
+func toDisasterResponse(disaster *model.Disaster) *DisasterResponse {
+	return &DisasterResponse{
 		ID:                    disaster.ID,
 		Name:                  disaster.Name,
 		MunicipalityID:        disaster.MunicipalityID,
@@ -376,40 +317,13 @@ func (h *disasterHandler) UpdateDisaster(c *gin.Context) {
 		Longitude:             disaster.Longitude,
 		Address:               disaster.Address,
 		PlaceID:               disaster.PlaceID,
-		Municipality:          Municipality{},
-	}
-
-	c.JSON(http.StatusOK, response)
-}
-
-// DeleteDisaster @title 災害削除
-// @id DeleteDisaster
-// @tags disasters
-// @Param id path string true "災害ID"
-// @Summary 災害削除
-// @Success 204
-// @Failure 404 {object} map[string]string
-// @Router /disasters/{id} [delete]
-func (h *disasterHandler) DeleteDisaster(c *gin.Context) {
-	id := c.Param("id")
-	ctx := c.Request.Context()
-
-	// Check if disaster exists
-	_, err := h.disasterUseCase.GetDisasterByID(ctx, id)
-	if err != nil {
-		h.l.ErrorContext(ctx, err, "Disaster not found for deletion", "disaster_id", id)
-		c.JSON(404, gin.H{"error": "Disaster not found"})
-
-		return
-	}
-
-	err = h.disasterUseCase.DeleteDisaster(ctx, id)
-	if err != nil {
-		h.l.ErrorContext(ctx, err, "Failed to delete disaster", "disaster_id", id)
-		c.JSON(500, gin.H{"error": "Failed to delete disaster"})
-
-		return
+		Municipality: Municipality{
+			PrefectureNameKanji:   disaster.Municipality.PrefectureNameKanji,
+			MunicipalityNameKanji: disaster.Municipality.MunicipalityNameKanji,
+		},
+		WorkCategory: WorkCategory{
+			CategoryName: disaster.WorkCategory.CategoryName,
+			IconName:     disaster.WorkCategory.IconName,
+		},
 	}
-
-	c.Status(http.StatusNoContent)
 }
@@ -14,14 +14,18 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
 	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
 func setupDisasterTest(t *testing.T) (*gin.Engine, *mockusecase.MockDisasterUseCase, handler.Disaster) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
 	ctrl := gomock.NewController(t)
 	mockUseCase := mockusecase.NewMockDisasterUseCase(ctrl)
 	l := logger.New(logger.DefaultConfig())
@@ -29,6 +33,33 @@ func setupDisasterTest(t *testing.T) (*gin.Engine, *mockusecase.MockDisasterUseC
 	return r, mockUseCase, h
 }
 
+func newTestDisaster(id, name string) *model.Disaster {
+	occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
+
+	return &model.Disaster{
+		ID:             id,
+		Name:           name,
+		MunicipalityID: 131016,
+		OccurredAt:     occurredAt,
+		Summary:        "Test Summary",
+		WorkCategoryID: 1,
+		Status:         "pending",
+		UpdatedAt:      time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+		Municipality: model.Municipality{
+			ID:                    131016,
+			PrefectureNameKanji:   "東京都",
+			MunicipalityNameKanji: "千代田区",
+			IsActive:              true,
+		},
+		WorkCategory: model.WorkCategory{
+			ID:           1,
+			CategoryName: "農地",
+			IconName:     "field",
+			IsActive:     true,
+		},
+	}
+}
+
 func TestDisasterHandler_ListDisasters(t *testing.T) {
 	// Setup
 	r, mockUseCase, h := setupDisasterTest(t)
@@ -40,135 +71,32 @@ func TestDisasterHandler_ListDisasters(t *testing.T) {
 		queryParams    string
 		mockSetup      func(mockUseCase *mockusecase.MockDisasterUseCase)
 		expectedStatus int
-		expectedBody   *handler.ListDisastersResponse
+		expectedNames  []string
 	}{
 		{
 			name:        "Success",
 			queryParams: "",
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
 				disasters := []*model.Disaster{
-					{
-						ID:           "1",
-						DisasterCode: "D2023-001",
-						Name:         "Test Disaster 1",
-						PrefectureID: 1,
-						Prefecture: model.Prefecture{
-							ID:   1,
-							Name: "東京都",
-						},
-						OccurredAt:            occurredAt,
-						Summary:               "Test Summary 1",
-						DisasterType:          "earthquake",
-						Status:                "pending",
-						ImpactLevel:           "severe",
-						AffectedAreaSize:      nil,
-						EstimatedDamageAmount: nil,
-					},
-					{
-						ID:           "2",
-						DisasterCode: "D2023-002",
-						Name:         "Test Disaster 2",
-						PrefectureID: 2,
-						Prefecture: model.Prefecture{
-							ID:   2,
-							Name: "大阪府",
-						},
-						OccurredAt:            occurredAt,
-						Summary:               "Test Summary 2",
-						DisasterType:          "flood",
-						Status:                "in_progress",
-						ImpactLevel:           "moderate",
-						AffectedAreaSize:      nil,
-						EstimatedDamageAmount: nil,
-					},
+					newTestDisaster("1", "Test Disaster 1"),
+					newTestDisaster("2", "Test Disaster 2"),
 				}
 				mockUseCase.EXPECT().ListDisasters(gomock.Any(), gomock.Any()).Return(disasters, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody: &handler.ListDisastersResponse{
-				Disasters: []*handler.DisasterResponse{
-					{
-						ID:           "1",
-						DisasterCode: "D2023-001",
-						Name:         "Test Disaster 1",
-						Prefecture: handler.PrefectureItem{
-							Name: "東京都",
-						},
-						OccurredAt:            "2023-01-01 00:00:00",
-						Summary:               "Test Summary 1",
-						DisasterType:          "earthquake",
-						Status:                "pending",
-						ImpactLevel:           "severe",
-						AffectedAreaSize:      nil,
-						EstimatedDamageAmount: nil,
-					},
-					{
-						ID:           "2",
-						DisasterCode: "D2023-002",
-						Name:         "Test Disaster 2",
-						Prefecture: handler.PrefectureItem{
-							Name: "大阪府",
-						},
-						OccurredAt:            "2023-01-01 00:00:00",
-						Summary:               "Test Summary 2",
-						DisasterType:          "flood",
-						Status:                "in_progress",
-						ImpactLevel:           "moderate",
-						AffectedAreaSize:      nil,
-						EstimatedDamageAmount: nil,
-					},
-				},
-				Total: 2,
-			},
+			expectedNames:  []string{"Test Disaster 1", "Test Disaster 2"},
 		},
 		{
 			name:        "With Filters",
-			queryParams: "?name=Test&disaster_type=earthquake&status=pending&prefecture_id=1&start_date=2023-01-01T00:00:00Z&end_date=2023-12-31T23:59:59Z",
+			queryParams: "?name=Test&status=pending&start_date=2023-01-01T00:00:00Z&end_date=2023-12-31T23:59:59Z",
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
 				disasters := []*model.Disaster{
-					{
-						ID:           "1",
-						DisasterCode: "D2023-001",
-						Name:         "Test Disaster 1",
-						PrefectureID: 1,
-						Prefecture: model.Prefecture{
-							ID:   1,
-							Name: "東京都",
-						},
-						OccurredAt:            occurredAt,
-						Summary:               "Test Summary 1",
-						DisasterType:          "earthquake",
-						Status:                "pending",
-						ImpactLevel:           "severe",
-						AffectedAreaSize:      nil,
-						EstimatedDamageAmount: nil,
-					},
+					newTestDisaster("1", "Test Disaster 1"),
 				}
 				mockUseCase.EXPECT().ListDisasters(gomock.Any(), gomock.Any()).Return(disasters, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody: &handler.ListDisastersResponse{
-				Disasters: []*handler.DisasterResponse{
-					{
-						ID:           "1",
-						DisasterCode: "D2023-001",
-						Name:         "Test Disaster 1",
-						Prefecture: handler.PrefectureItem{
-							Name: "東京都",
-						},
-						OccurredAt:            "2023-01-01 00:00:00",
-						Summary:               "Test Summary 1",
-						DisasterType:          "earthquake",
-						Status:                "pending",
-						ImpactLevel:           "severe",
-						AffectedAreaSize:      nil,
-						EstimatedDamageAmount: nil,
-					},
-				},
-				Total: 1,
-			},
+			expectedNames:  []string{"Test Disaster 1"},
 		},
 		{
 			name:        "Error",
@@ -177,7 +105,6 @@ func TestDisasterHandler_ListDisasters(t *testing.T) {
 				mockUseCase.EXPECT().ListDisasters(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
-			expectedBody:   nil,
 		},
 	}
 
@@ -199,19 +126,13 @@ func TestDisasterHandler_ListDisasters(t *testing.T) {
 				var response handler.ListDisastersResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedBody.Total, response.Total)
-				assert.Equal(t, len(tt.expectedBody.Disasters), len(response.Disasters))
-
-				for i, expected := range tt.expectedBody.Disasters {
-					assert.Equal(t, expected.ID, response.Disasters[i].ID)
-					assert.Equal(t, expected.DisasterCode, response.Disasters[i].DisasterCode)
-					assert.Equal(t, expected.Name, response.Disasters[i].Name)
-					assert.Equal(t, expected.Prefecture.Name, response.Disasters[i].Prefecture.Name)
-					assert.Equal(t, expected.OccurredAt, response.Disasters[i].OccurredAt)
-					assert.Equal(t, expected.Summary, response.Disasters[i].Summary)
-					assert.Equal(t, expected.DisasterType, response.Disasters[i].DisasterType)
-					assert.Equal(t, expected.Status, response.Disasters[i].Status)
-					assert.Equal(t, expected.ImpactLevel, response.Disasters[i].ImpactLevel)
+				assert.Equal(t, int64(len(tt.expectedNames)), response.Total)
+
+				for i, name := range tt.expectedNames {
+					assert.Equal(t, name, response.Disasters[i].Name)
+					assert.Equal(t, "2023-01-01T00:00:00Z", response.Disasters[i].OccurredAt)
+					assert.Equal(t, "千代田区", response.Disasters[i].Municipality.MunicipalityNameKanji)
+					assert.Equal(t, "農地", response.Disasters[i].WorkCategory.CategoryName)
 				}
 			}
 		})
@@ -229,57 +150,31 @@ func TestDisasterHandler_GetDisaster(t *testing.T) {
 		disasterID     string
 		mockSetup      func(mockUseCase *mockusecase.MockDisasterUseCase)
 		expectedStatus int
-		expectedBody   *handler.DisasterResponse
 	}{
 		{
 			name:       "Success",
 			disasterID: "1",
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
-				disaster := &model.Disaster{
-					ID:           "1",
-					DisasterCode: "D2023-001",
-					Name:         "Test Disaster",
-					PrefectureID: 1,
-					Prefecture: model.Prefecture{
-						ID:   1,
-						Name: "東京都",
-					},
-					OccurredAt:            occurredAt,
-					Summary:               "Test Summary",
-					DisasterType:          "earthquake",
-					Status:                "pending",
-					ImpactLevel:           "severe",
-					AffectedAreaSize:      nil,
-					EstimatedDamageAmount: nil,
-				}
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "1").Return(disaster, nil)
+				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "1").Return(newTestDisaster("1", "Test Disaster"), nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody: &handler.DisasterResponse{
-				ID:           "1",
-				DisasterCode: "D2023-001",
-				Name:         "Test Disaster",
-				Prefecture: handler.PrefectureItem{
-					Name: "東京都",
-				},
-				OccurredAt:            "2023-01-01 00:00:00",
-				Summary:               "Test Summary",
-				DisasterType:          "earthquake",
-				Status:                "pending",
-				ImpactLevel:           "severe",
-				AffectedAreaSize:      nil,
-				EstimatedDamageAmount: nil,
-			},
 		},
 		{
 			name:       "Not Found",
 			disasterID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "999").Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "999").
+					Return(nil, myerrors.NewAPIError(myerrors.DisasterNotFoundError, myerrors.DisasterNotFoundErrorMessage, errors.New("record not found"), "not found"))
 			},
 			expectedStatus: http.StatusNotFound,
-			expectedBody:   nil,
+		},
+		{
+			name:       "Database Error",
+			disasterID: "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
+				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "1").Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
 		},
 	}
 
@@ -298,18 +193,16 @@ func TestDisasterHandler_GetDisaster(t *testing.T) {
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
 			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, etagFor(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)), w.Header().Get("ETag"))
+
 				var response handler.DisasterResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedBody.ID, response.ID)
-				assert.Equal(t, tt.expectedBody.DisasterCode, response.DisasterCode)
-				assert.Equal(t, tt.expectedBody.Name, response.Name)
-				assert.Equal(t, tt.expectedBody.Prefecture.Name, response.Prefecture.Name)
-				assert.Equal(t, tt.expectedBody.OccurredAt, response.OccurredAt)
-				assert.Equal(t, tt.expectedBody.Summary, response.Summary)
-				assert.Equal(t, tt.expectedBody.DisasterType, response.DisasterType)
-				assert.Equal(t, tt.expectedBody.Status, response.Status)
-				assert.Equal(t, tt.expectedBody.ImpactLevel, response.ImpactLevel)
+				assert.Equal(t, "1", response.ID)
+				assert.Equal(t, "Test Disaster", response.Name)
+				assert.Equal(t, int32(131016), response.MunicipalityID)
+				assert.Equal(t, int64(1), response.WorkCategoryID)
+				assert.Equal(t, "東京都", response.Municipality.PrefectureNameKanji)
 			}
 		})
 	}
@@ -320,95 +213,102 @@ func TestDisasterHandler_CreateDisaster(t *testing.T) {
 	r, mockUseCase, h := setupDisasterTest(t)
 	r.POST("/disasters", h.CreateDisaster)
 
+	latitude := 35.6895
+
 	// Test cases
 	tests := []struct {
 		name           string
-		requestBody    handler.CreateDisasterRequest
+		requestBody    any
 		mockSetup      func(mockUseCase *mockusecase.MockDisasterUseCase)
 		expectedStatus int
-		expectedBody   *handler.DisasterResponse
+		expectedFields []string
 	}{
 		{
 			name: "Success",
 			requestBody: handler.CreateDisasterRequest{
-				DisasterCode: "D2023-001",
-				Name:         "Test Disaster",
-				PrefectureID: 1,
-				OccurredAt:   "2023-01-01T00:00:00Z",
-				Summary:      "Test Summary",
-				DisasterType: "earthquake",
-				Status:       "pending",
-				ImpactLevel:  "severe",
+				Name:           "New Disaster",
+				MunicipalityID: 131016,
+				OccurredAt:     "2023-01-01T00:00:00Z",
+				Summary:        "New Summary",
+				WorkCategoryID: 1,
+				Status:         "pending",
+				Latitude:       &latitude,
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
 				mockUseCase.EXPECT().
 					CreateDisaster(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, disaster *model.Disaster) error {
-						disaster.ID = "1" // Simulate ID generation
+					DoAndReturn(func(_ interface{}, d *model.Disaster) error {
+						assert.Equal(t, "New Disaster", d.Name)
+						assert.Equal(t, int32(131016), d.MunicipalityID)
+						assert.Equal(t, int64(1), d.WorkCategoryID)
+						assert.Equal(t, latitude, *d.Latitude)
+						d.ID = "new-id"
 						return nil
 					})
 			},
 			expectedStatus: http.StatusCreated,
-			expectedBody: &handler.DisasterResponse{
-				ID:           "1",
-				DisasterCode: "D2023-001",
-				Name:         "Test Disaster",
-				OccurredAt:   "2023-01-01 00:00:00",
-				Summary:      "Test Summary",
-				DisasterType: "earthquake",
-				Status:       "pending",
-				ImpactLevel:  "severe",
-			},
 		},
 		{
-			name: "Invalid Request - Missing Required Field",
+			name: "Missing Required Fields",
 			requestBody: handler.CreateDisasterRequest{
-				// Missing DisasterCode
-				Name:         "Test Disaster",
-				PrefectureID: 1,
-				OccurredAt:   "2023-01-01T00:00:00Z",
-				Summary:      "Test Summary",
-				DisasterType: "earthquake",
-				ImpactLevel:  "severe",
+				Name:       "New Disaster",
+				OccurredAt: "2023-01-01T00:00:00Z",
+				Summary:    "New Summary",
 			},
 			mockSetup:      func(mockUseCase *mockusecase.MockDisasterUseCase) {},
 			expectedStatus: http.StatusBadRequest,
-			expectedBody:   nil,
+			expectedFields: []string{"municipality_id", "work_category_id"},
 		},
 		{
-			name: "Invalid Request - Invalid Date Format",
+			name: "Invalid OccurredAt And Status",
 			requestBody: handler.CreateDisasterRequest{
-				DisasterCode: "D2023-001",
-				Name:         "Test Disaster",
-				PrefectureID: 1,
-				OccurredAt:   "2023-01-01", // Invalid format
-				Summary:      "Test Summary",
-				DisasterType: "earthquake",
-				ImpactLevel:  "severe",
+				Name:           "New Disaster",
+				MunicipalityID: 131016,
+				OccurredAt:     "2023/01/01",
+				Summary:        "New Summary",
+				WorkCategoryID: 1,
+				Status:         "unknown",
 			},
 			mockSetup:      func(mockUseCase *mockusecase.MockDisasterUseCase) {},
 			expectedStatus: http.StatusBadRequest,
-			expectedBody:   nil,
+			expectedFields: []string{"occurred_at", "status"},
+		},
+		{
+			name:           "Invalid Type",
+			requestBody:    map[string]any{"name": "New Disaster", "municipality_id": "abc"},
+			mockSetup:      func(mockUseCase *mockusecase.MockDisasterUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"municipality_id"},
+		},
+		{
+			name: "Inactive Municipality",
+			requestBody: handler.CreateDisasterRequest{
+				Name:           "New Disaster",
+				MunicipalityID: 999999,
+				OccurredAt:     "2023-01-01T00:00:00Z",
+				Summary:        "New Summary",
+				WorkCategoryID: 1,
+			},
+			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
+				mockUseCase.EXPECT().CreateDisaster(gomock.Any(), gomock.Any()).
+					Return(myerrors.NewValidationError(myerrors.FieldError{Field: "municipality_id", Message: "指定された自治体は無効です"}))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"municipality_id"},
 		},
 		{
 			name: "Database Error",
 			requestBody: handler.CreateDisasterRequest{
-				DisasterCode: "D2023-001",
-				Name:         "Test Disaster",
-				PrefectureID: 1,
-				OccurredAt:   "2023-01-01T00:00:00Z",
-				Summary:      "Test Summary",
-				DisasterType: "earthquake",
-				Status:       "pending",
-				ImpactLevel:  "severe",
+				Name:           "New Disaster",
+				MunicipalityID: 131016,
+				OccurredAt:     "2023-01-01T00:00:00Z",
+				Summary:        "New Summary",
+				WorkCategoryID: 1,
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				mockUseCase.EXPECT().
-					CreateDisaster(gomock.Any(), gomock.Any()).
-					Return(errors.New("database error"))
+				mockUseCase.EXPECT().CreateDisaster(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
-			expectedBody:   nil,
 		},
 	}
 
@@ -418,12 +318,10 @@ func TestDisasterHandler_CreateDisaster(t *testing.T) {
 			// Setup mock
 			tt.mockSetup(mockUseCase)
 
-			// Prepare request body
-			jsonBody, _ := json.Marshal(tt.requestBody)
-
 			// Make request
+			body, _ := json.Marshal(tt.requestBody)
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequest(http.MethodPost, "/disasters", bytes.NewBuffer(jsonBody))
+			req, _ := http.NewRequest(http.MethodPost, "/disasters", bytes.NewBuffer(body))
 			req.Header.Set("Content-Type", "application/json")
 			r.ServeHTTP(w, req)
 
@@ -434,14 +332,12 @@ func TestDisasterHandler_CreateDisaster(t *testing.T) {
 				var response handler.DisasterResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
+				assert.Equal(t, "new-id", response.ID)
+				assert.Equal(t, "New Disaster", response.Name)
+			}
 
-				// Check only fields we can predict
-				assert.Equal(t, tt.expectedBody.DisasterCode, response.DisasterCode)
-				assert.Equal(t, tt.expectedBody.Name, response.Name)
-				assert.Equal(t, tt.expectedBody.Summary, response.Summary)
-				assert.Equal(t, tt.expectedBody.DisasterType, response.DisasterType)
-				assert.Equal(t, tt.expectedBody.Status, response.Status)
-				assert.Equal(t, tt.expectedBody.ImpactLevel, response.ImpactLevel)
+			if tt.expectedFields != nil {
+				assertFieldErrors(t, w.Body.Bytes(), tt.expectedFields)
 			}
 		})
 	}
@@ -450,131 +346,98 @@ func TestDisasterHandler_CreateDisaster(t *testing.T) {
 func TestDisasterHandler_UpdateDisaster(t *testing.T) {
 	// Setup
 	r, mockUseCase, h := setupDisasterTest(t)
-	r.PUT("/disasters/:id", h.UpdateDisaster)
+	r.PATCH("/disasters/:id", h.UpdateDisaster)
+
+	version := time.Date(2024, 1, 1, 9, 0, 0, 123456000, time.UTC)
 
 	// Test cases
 	tests := []struct {
 		name           string
 		disasterID     string
-		requestBody    handler.UpdateDisasterRequest
+		ifMatch        string
+		requestBody    string
 		mockSetup      func(mockUseCase *mockusecase.MockDisasterUseCase)
 		expectedStatus int
-		expectedBody   *handler.DisasterResponse
+		expectedFields []string
 	}{
 		{
-			name:       "Success",
-			disasterID: "1",
-			requestBody: handler.UpdateDisasterRequest{
-				Name:         "Updated Disaster",
-				DisasterType: "flood",
-				Status:       "in_progress",
-			},
+			name:        "Partial Update",
+			disasterID:  "1",
+			ifMatch:     etagFor(version),
+			requestBody: `{"name":"Updated Disaster","occurred_at":"2023-02-01T00:00:00Z"}`,
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
-				disaster := &model.Disaster{
-					ID:           "1",
-					DisasterCode: "D2023-001",
-					Name:         "Test Disaster",
-					PrefectureID: 1,
-					Prefecture: model.Prefecture{
-						ID:   1,
-						Name: "東京都",
-					},
-					OccurredAt:            occurredAt,
-					Summary:               "Test Summary",
-					DisasterType:          "earthquake",
-					Status:                "pending",
-					ImpactLevel:           "severe",
-					AffectedAreaSize:      nil,
-					EstimatedDamageAmount: nil,
-				}
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "1").Return(disaster, nil)
 				mockUseCase.EXPECT().
-					UpdateDisaster(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, d *model.Disaster) error {
-						assert.Equal(t, "Updated Disaster", d.Name)
-						assert.Equal(t, "flood", d.DisasterType)
-						assert.Equal(t, "in_progress", d.Status)
-						return nil
+					UpdateDisaster(gomock.Any(), "1", gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ string, p *usecase.UpdateDisasterParams) (*model.Disaster, error) {
+						assert.True(t, version.Equal(p.ExpectedUpdatedAt))
+						assert.Equal(t, "Updated Disaster", *p.Name)
+						assert.Equal(t, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), p.OccurredAt.UTC())
+						assert.Nil(t, p.Summary)
+						assert.Nil(t, p.MunicipalityID)
+						assert.Nil(t, p.Status)
+						return newTestDisaster("1", "Updated Disaster"), nil
 					})
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody: &handler.DisasterResponse{
-				ID:           "1",
-				DisasterCode: "D2023-001",
-				Name:         "Updated Disaster",
-				Prefecture: handler.PrefectureItem{
-					Name: "東京都",
-				},
-				OccurredAt:            "2023-01-01 00:00:00",
-				Summary:               "Test Summary",
-				DisasterType:          "flood",
-				Status:                "in_progress",
-				ImpactLevel:           "severe",
-				AffectedAreaSize:      nil,
-				EstimatedDamageAmount: nil,
-			},
 		},
 		{
-			name:       "Not Found",
-			disasterID: "999",
-			requestBody: handler.UpdateDisasterRequest{
-				Name: "Updated Disaster",
+			name:           "Invalid Values",
+			disasterID:     "1",
+			ifMatch:        etagFor(version),
+			requestBody:    `{"status":"done","latitude":100}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockDisasterUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"status", "latitude"},
+		},
+		{
+			name:        "Inactive Work Category",
+			disasterID:  "1",
+			ifMatch:     etagFor(version),
+			requestBody: `{"work_category_id":9}`,
+			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
+				mockUseCase.EXPECT().UpdateDisaster(gomock.Any(), "1", gomock.Any()).
+					Return(nil, myerrors.NewValidationError(myerrors.FieldError{Field: "work_category_id", Message: "指定された工種区分は無効です"}))
 			},
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"work_category_id"},
+		},
+		{
+			name:        "Not Found",
+			disasterID:  "999",
+			ifMatch:     etagFor(version),
+			requestBody: `{"name":"Updated Disaster"}`,
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "999").Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().UpdateDisaster(gomock.Any(), "999", gomock.Any()).
+					Return(nil, myerrors.NewAPIError(myerrors.DisasterNotFoundError, myerrors.DisasterNotFoundErrorMessage, errors.New("record not found"), "not found"))
 			},
 			expectedStatus: http.StatusNotFound,
-			expectedBody:   nil,
 		},
 		{
-			name:       "Invalid Date Format",
-			disasterID: "1",
-			requestBody: handler.UpdateDisasterRequest{
-				OccurredAt: "2023-01-01", // Invalid format
-			},
+			name:           "Missing If-Match",
+			disasterID:     "1",
+			requestBody:    `{"name":"Updated Disaster"}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockDisasterUseCase) {},
+			expectedStatus: http.StatusPreconditionRequired,
+		},
+		{
+			name:        "Version Conflict",
+			disasterID:  "1",
+			ifMatch:     etagFor(version),
+			requestBody: `{"name":"Updated Disaster"}`,
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
-				disaster := &model.Disaster{
-					ID:           "1",
-					DisasterCode: "D2023-001",
-					Name:         "Test Disaster",
-					PrefectureID: 1,
-					OccurredAt:   occurredAt,
-					Summary:      "Test Summary",
-					DisasterType: "earthquake",
-					Status:       "pending",
-					ImpactLevel:  "severe",
-				}
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "1").Return(disaster, nil)
+				mockUseCase.EXPECT().UpdateDisaster(gomock.Any(), "1", gomock.Any()).Return(nil, newPreconditionFailedError())
 			},
-			expectedStatus: http.StatusBadRequest,
-			expectedBody:   nil,
+			expectedStatus: http.StatusPreconditionFailed,
 		},
 		{
-			name:       "Database Error",
-			disasterID: "1",
-			requestBody: handler.UpdateDisasterRequest{
-				Name: "Updated Disaster",
-			},
+			name:        "Database Error",
+			disasterID:  "1",
+			ifMatch:     etagFor(version),
+			requestBody: `{"name":"Updated Disaster"}`,
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
-				disaster := &model.Disaster{
-					ID:           "1",
-					DisasterCode: "D2023-001",
-					Name:         "Test Disaster",
-					PrefectureID: 1,
-					OccurredAt:   occurredAt,
-					Summary:      "Test Summary",
-					DisasterType: "earthquake",
-					Status:       "pending",
-					ImpactLevel:  "severe",
-				}
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "1").Return(disaster, nil)
-				mockUseCase.EXPECT().UpdateDisaster(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+				mockUseCase.EXPECT().UpdateDisaster(gomock.Any(), "1", gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
-			expectedBody:   nil,
 		},
 	}
 
@@ -584,32 +447,29 @@ func TestDisasterHandler_UpdateDisaster(t *testing.T) {
 			// Setup mock
 			tt.mockSetup(mockUseCase)
 
-			// Prepare request body
-			jsonBody, _ := json.Marshal(tt.requestBody)
-
 			// Make request
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequest(http.MethodPut, "/disasters/"+tt.disasterID, bytes.NewBuffer(jsonBody))
+			req, _ := http.NewRequest(http.MethodPatch, "/disasters/"+tt.disasterID, bytes.NewBufferString(tt.requestBody))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			r.ServeHTTP(w, req)
 
 			// Check response
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
 			if tt.expectedStatus == http.StatusOK {
+				assert.NotEmpty(t, w.Header().Get("ETag"))
+
 				var response handler.DisasterResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
+				assert.Equal(t, "Updated Disaster", response.Name)
+			}
 
-				if tt.requestBody.Name != "" {
-					assert.Equal(t, tt.requestBody.Name, response.Name)
-				}
-				if tt.requestBody.DisasterType != "" {
-					assert.Equal(t, tt.requestBody.DisasterType, response.DisasterType)
-				}
-				if tt.requestBody.Status != "" {
-					assert.Equal(t, tt.requestBody.Status, response.Status)
-				}
+			if tt.expectedFields != nil {
+				assertFieldErrors(t, w.Body.Bytes(), tt.expectedFields)
 			}
 		})
 	}
@@ -622,58 +482,83 @@ func TestDisasterHandler_DeleteDisaster(t *testing.T) {
 
 	// Test cases
 	tests := []struct {
-		name           string
-		disasterID     string
-		mockSetup      func(mockUseCase *mockusecase.MockDisasterUseCase)
-		expectedStatus int
+		name              string
+		disasterID        string
+		query             string
+		mockSetup         func(mockUseCase *mockusecase.MockDisasterUseCase)
+		expectedStatus    int
+		expectedBody      *handler.DeleteDisasterResponse
+		expectedFields    []string
+		expectedBlockedBy []string
 	}{
 		{
 			name:       "Success",
 			disasterID: "1",
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
-				disaster := &model.Disaster{
-					ID:           "1",
-					DisasterCode: "D2023-001",
-					Name:         "Test Disaster",
-					PrefectureID: 1,
-					OccurredAt:   occurredAt,
-					Summary:      "Test Summary",
-					DisasterType: "earthquake",
-					Status:       "pending",
-					ImpactLevel:  "severe",
-				}
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "1").Return(disaster, nil)
-				mockUseCase.EXPECT().DeleteDisaster(gomock.Any(), "1").Return(nil)
+				mockUseCase.EXPECT().DeleteDisaster(gomock.Any(), "1", false).Return(&model.DisasterDeleteSummary{
+					DisasterID:      "1",
+					DeletedChildren: map[string]int64{"timelines": 2, "gis_data": 1},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &handler.DeleteDisasterResponse{
+				DisasterID:      "1",
+				DeletedChildren: map[string]int64{"timelines": 2, "gis_data": 1},
+			},
+		},
+		{
+			name:       "Forced",
+			disasterID: "1",
+			query:      "?force=true",
+			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
+				mockUseCase.EXPECT().DeleteDisaster(gomock.Any(), "1", true).Return(&model.DisasterDeleteSummary{
+					DisasterID:          "1",
+					Forced:              true,
+					ApprovedAssessments: 1,
+					DeletedChildren:     map[string]int64{"assessments": 1},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &handler.DeleteDisasterResponse{
+				DisasterID:          "1",
+				Forced:              true,
+				ApprovedAssessments: 1,
+				DeletedChildren:     map[string]int64{"assessments": 1},
 			},
-			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "Invalid Force",
+			disasterID:     "1",
+			query:          "?force=yes-please",
+			mockSetup:      func(mockUseCase *mockusecase.MockDisasterUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"force"},
+		},
+		{
+			name:       "Blocked",
+			disasterID: "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
+				apiErr := myerrors.NewAPIError(myerrors.DisasterDeleteBlockedError, myerrors.DisasterDeleteBlockedErrorMessage, errors.New("blocked"), "delete blocked")
+				apiErr.Fields = []myerrors.FieldError{{Field: "paid_applications", Message: "支払処理中または完了の支援申請が1件あります"}}
+				mockUseCase.EXPECT().DeleteDisaster(gomock.Any(), "1", false).Return(nil, apiErr)
+			},
+			expectedStatus:    http.StatusConflict,
+			expectedBlockedBy: []string{"paid_applications"},
 		},
 		{
 			name:       "Not Found",
 			disasterID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "999").Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().DeleteDisaster(gomock.Any(), "999", false).
+					Return(nil, myerrors.NewAPIError(myerrors.DisasterNotFoundError, myerrors.DisasterNotFoundErrorMessage, errors.New("record not found"), "not found"))
 			},
 			expectedStatus: http.StatusNotFound,
 		},
 		{
-			name:       "Database Error",
+			name:       "Delete Error",
 			disasterID: "1",
 			mockSetup: func(mockUseCase *mockusecase.MockDisasterUseCase) {
-				occurredAt, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
-				disaster := &model.Disaster{
-					ID:           "1",
-					DisasterCode: "D2023-001",
-					Name:         "Test Disaster",
-					PrefectureID: 1,
-					OccurredAt:   occurredAt,
-					Summary:      "Test Summary",
-					DisasterType: "earthquake",
-					Status:       "pending",
-					ImpactLevel:  "severe",
-				}
-				mockUseCase.EXPECT().GetDisasterByID(gomock.Any(), "1").Return(disaster, nil)
-				mockUseCase.EXPECT().DeleteDisaster(gomock.Any(), "1").Return(errors.New("database error"))
+				mockUseCase.EXPECT().DeleteDisaster(gomock.Any(), "1", false).Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -687,11 +572,61 @@ func TestDisasterHandler_DeleteDisaster(t *testing.T) {
 
 			// Make request
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequest(http.MethodDelete, "/disasters/"+tt.disasterID, nil)
+			req, _ := http.NewRequest(http.MethodDelete, "/disasters/"+tt.disasterID+tt.query, nil)
 			r.ServeHTTP(w, req)
 
 			// Check response
 			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response handler.DeleteDisasterResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, &response)
+			}
+
+			if tt.expectedFields != nil {
+				assertFieldErrors(t, w.Body.Bytes(), tt.expectedFields)
+			}
+
+			if tt.expectedBlockedBy != nil {
+				var response struct {
+					Code   string                `json:"code"`
+					Fields []myerrors.FieldError `json:"fields"`
+				}
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, string(myerrors.DisasterDeleteBlockedError), response.Code)
+
+				var blockedBy []string
+				for _, f := range response.Fields {
+					blockedBy = append(blockedBy, f.Field)
+				}
+				assert.Equal(t, tt.expectedBlockedBy, blockedBy)
+			}
 		})
 	}
 }
+
+// assertFieldErrors checks that a validation error response names exactly the expected fields
+func assertFieldErrors(t *testing.T, body []byte, expected []string) {
+	t.Helper()
+
+	var response struct {
+		Code   string `json:"code"`
+		Fields []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields"`
+	}
+	err := json.Unmarshal(body, &response)
+	assert.NoError(t, err)
+	assert.Equal(t, string(myerrors.ValidationError), response.Code)
+
+	var fields []string
+	for _, f := range response.Fields {
+		fields = append(fields, f.Field)
+		assert.NotEmpty(t, f.Message)
+	}
+	assert.ElementsMatch(t, expected, fields)
+}
@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"errors"
+
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+// newUnauthorizedError returns the error for a request that reached a handler without an authenticated user
+func newUnauthorizedError() *myerrors.APIError {
+	return myerrors.NewAPIError(
+		myerrors.UnauthorizedError,
+		myerrors.UnauthorizedErrorMessage,
+		errors.New("user id is not set in context"),
+		"unauthorized",
+	)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+// newETag derives a strong entity tag from a record's updated_at.
+// PostgreSQL stores timestamps with microsecond precision, so the tag is built from UnixMicro
+// to round-trip exactly into the conditional update.
+func newETag(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixMicro(), 36) + `"`
+}
+
+// setETag writes the ETag header for the given record version
+func setETag(c *gin.Context, updatedAt time.Time) {
+	c.Header("ETag", newETag(updatedAt))
+}
+
+// parseIfMatch extracts the updated_at encoded in the If-Match header.
+// A missing header (or "*") yields PreconditionRequiredError; a tag that was not issued by newETag,
+// including weak tags which never match under strong comparison, yields PreconditionFailedError.
+func parseIfMatch(c *gin.Context) (time.Time, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return time.Time{}, myerrors.NewAPIError(
+			myerrors.PreconditionRequiredError,
+			myerrors.PreconditionRequiredErrorMessage,
+			errors.New("if-match header is missing"),
+			"precondition required",
+		)
+	}
+
+	tag, ok := strings.CutPrefix(header, `"`)
+	if ok {
+		tag, ok = strings.CutSuffix(tag, `"`)
+	}
+
+	micro, err := strconv.ParseInt(tag, 36, 64)
+	if !ok || err != nil {
+		return time.Time{}, myerrors.NewAPIError(
+			myerrors.PreconditionFailedError,
+			myerrors.PreconditionFailedErrorMessage,
+			errors.New("if-match header does not match any version"),
+			"precondition failed",
+		)
+	}
+
+	return time.UnixMicro(micro), nil
+}
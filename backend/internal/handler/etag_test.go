@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+// etagFor builds the ETag the handlers issue for a record last updated at updatedAt
+func etagFor(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixMicro(), 36) + `"`
+}
+
+func newPreconditionFailedError() error {
+	return myerrors.NewAPIError(
+		myerrors.PreconditionFailedError,
+		myerrors.PreconditionFailedErrorMessage,
+		errors.New("version conflict"),
+		"version conflict",
+	)
+}
+
+func TestETag_RoundTrip(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupOrganizationTest(t)
+	r.GET("/organizations/:id", h.GetOrganization)
+	r.PUT("/organizations/:id", h.UpdateOrganization)
+
+	// PostgreSQLの精度（マイクロ秒）で保存された更新日時
+	updatedAt := time.Date(2024, 3, 1, 12, 34, 56, 789012000, time.FixedZone("JST", 9*60*60))
+	organization := &model.Organization{ID: 1, Name: "Organization", Type: "government", UpdatedAt: updatedAt}
+
+	mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(1)).Return(organization, nil).Times(2)
+	mockUseCase.EXPECT().
+		UpdateOrganization(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, o *model.Organization, expected time.Time) (*model.Organization, error) {
+			// GETで返したETagが、条件付き更新に使う更新日時へ正確に戻ること
+			assert.True(t, updatedAt.Equal(expected))
+			return o, nil
+		})
+
+	// Get the current version
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/organizations/1", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	assert.Equal(t, etagFor(updatedAt), etag)
+
+	// Update with the ETag that was returned
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPut, "/organizations/1", bytes.NewBufferString(`{"name":"Updated","type":"ngo"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", etag)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+}
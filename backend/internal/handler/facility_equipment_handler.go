@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 )
@@ -76,25 +77,18 @@ type UpdateFacilityEquipmentRequest struct {
 	Notes               *string    `json:"notes"`
 }
 
-// ListFacilityEquipments @title 施設設備一覧取得
-// @id ListFacilityEquipments
-// @tags facility_equipment
-// @accept json
-// @produce json
-// @Summary 施設設備一覧取得
-// @Success 200 {array} FacilityEquipmentResponse
-// @Router /facility-equipment [get]
+// ListFacilityEquipments 施設設備一覧取得
 func (h *facilityEquipmentHandler) ListFacilityEquipments(c *gin.Context) {
 	ctx := c.Request.Context()
 	facilityEquipments, err := h.facilityEquipmentUseCase.ListFacilityEquipments(ctx)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to list facility equipment")
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var response []*FacilityEquipmentResponse
+	response := make([]*FacilityEquipmentResponse, 0, len(facilityEquipments))
 
 	for _, facilityEquipment := range facilityEquipments {
 		resp := &FacilityEquipmentResponse{
@@ -118,16 +112,7 @@ func (h *facilityEquipmentHandler) ListFacilityEquipments(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// GetFacilityEquipment @title 施設設備詳細取得
-// @id GetFacilityEquipment
-// @tags facility_equipment
-// @accept json
-// @produce json
-// @Param id path int true "施設設備ID"
-// @Summary 施設設備詳細取得
-// @Success 200 {object} FacilityEquipmentResponse
-// @Failure 404 {object} map[string]string
-// @Router /facility-equipment/{id} [get]
+// GetFacilityEquipment 施設設備詳細取得
 func (h *facilityEquipmentHandler) GetFacilityEquipment(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -135,7 +120,7 @@ func (h *facilityEquipmentHandler) GetFacilityEquipment(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid facility equipment ID", "facility_equipment_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid facility equipment ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
@@ -143,7 +128,7 @@ func (h *facilityEquipmentHandler) GetFacilityEquipment(c *gin.Context) {
 	facilityEquipment, err := h.facilityEquipmentUseCase.GetFacilityEquipmentByID(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Facility equipment not found", "facility_equipment_id", id)
-		c.JSON(404, gin.H{"error": "Facility equipment not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -163,23 +148,15 @@ func (h *facilityEquipmentHandler) GetFacilityEquipment(c *gin.Context) {
 	}
 
 	h.l.InfoContext(ctx, "Successfully retrieved facility equipment", "facility_equipment_id", id)
+	setETag(c, facilityEquipment.UpdatedAt)
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateFacilityEquipment @title 施設設備作成
-// @id CreateFacilityEquipment
-// @tags facility_equipment
-// @accept json
-// @produce json
-// @Param request body CreateFacilityEquipmentRequest true "施設設備作成リクエスト"
-// @Summary 施設設備作成
-// @Success 201 {object} FacilityEquipmentResponse
-// @Failure 400 {object} map[string]string
-// @Router /facility-equipment [post]
+// CreateFacilityEquipment 施設設備作成
 func (h *facilityEquipmentHandler) CreateFacilityEquipment(c *gin.Context) {
 	var req CreateFacilityEquipmentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
@@ -199,7 +176,7 @@ func (h *facilityEquipmentHandler) CreateFacilityEquipment(c *gin.Context) {
 	err := h.facilityEquipmentUseCase.CreateFacilityEquipment(c.Request.Context(), facilityEquipment)
 	if err != nil {
 		h.l.ErrorContext(c.Request.Context(), err, "Failed to create facility equipment")
-		c.JSON(500, gin.H{"error": "Failed to create facility equipment"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -222,18 +199,7 @@ func (h *facilityEquipmentHandler) CreateFacilityEquipment(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
-// UpdateFacilityEquipment @title 施設設備更新
-// @id UpdateFacilityEquipment
-// @tags facility_equipment
-// @accept json
-// @produce json
-// @Param id path int true "施設設備ID"
-// @Param request body UpdateFacilityEquipmentRequest true "施設設備更新リクエスト"
-// @Summary 施設設備更新
-// @Success 200 {object} FacilityEquipmentResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /facility-equipment/{id} [put]
+// UpdateFacilityEquipment 施設設備更新
 func (h *facilityEquipmentHandler) UpdateFacilityEquipment(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -241,15 +207,21 @@ func (h *facilityEquipmentHandler) UpdateFacilityEquipment(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid facility equipment ID", "facility_equipment_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid facility equipment ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
 
+	expectedUpdatedAt, err := parseIfMatch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
 	var req UpdateFacilityEquipmentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid request body")
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 
 		return
 	}
@@ -258,7 +230,7 @@ func (h *facilityEquipmentHandler) UpdateFacilityEquipment(c *gin.Context) {
 	existingFacilityEquipment, err := h.facilityEquipmentUseCase.GetFacilityEquipmentByID(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Facility equipment not found", "facility_equipment_id", id)
-		c.JSON(404, gin.H{"error": "Facility equipment not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -275,43 +247,34 @@ func (h *facilityEquipmentHandler) UpdateFacilityEquipment(c *gin.Context) {
 	existingFacilityEquipment.LocationLongitude = req.LocationLongitude
 	existingFacilityEquipment.Notes = req.Notes
 
-	err = h.facilityEquipmentUseCase.UpdateFacilityEquipment(ctx, existingFacilityEquipment)
+	updatedFacilityEquipment, err := h.facilityEquipmentUseCase.UpdateFacilityEquipment(ctx, existingFacilityEquipment, expectedUpdatedAt)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to update facility equipment", "facility_equipment_id", id)
-		c.JSON(500, gin.H{"error": "Failed to update facility equipment"})
+		_ = c.Error(err)
 
 		return
 	}
 
 	response := &FacilityEquipmentResponse{
-		ID:                  existingFacilityEquipment.ID,
-		Name:                existingFacilityEquipment.Name,
-		FacilityTypeID:      existingFacilityEquipment.FacilityTypeID,
-		ModelNumber:         existingFacilityEquipment.ModelNumber,
-		Manufacturer:        existingFacilityEquipment.Manufacturer,
-		InstallationDate:    existingFacilityEquipment.InstallationDate,
-		Status:              existingFacilityEquipment.Status,
-		LocationDescription: existingFacilityEquipment.LocationDescription,
-		LocationLatitude:    existingFacilityEquipment.LocationLatitude,
-		LocationLongitude:   existingFacilityEquipment.LocationLongitude,
-		Notes:               existingFacilityEquipment.Notes,
+		ID:                  updatedFacilityEquipment.ID,
+		Name:                updatedFacilityEquipment.Name,
+		FacilityTypeID:      updatedFacilityEquipment.FacilityTypeID,
+		ModelNumber:         updatedFacilityEquipment.ModelNumber,
+		Manufacturer:        updatedFacilityEquipment.Manufacturer,
+		InstallationDate:    updatedFacilityEquipment.InstallationDate,
+		Status:              updatedFacilityEquipment.Status,
+		LocationDescription: updatedFacilityEquipment.LocationDescription,
+		LocationLatitude:    updatedFacilityEquipment.LocationLatitude,
+		LocationLongitude:   updatedFacilityEquipment.LocationLongitude,
+		Notes:               updatedFacilityEquipment.Notes,
 	}
 
 	h.l.InfoContext(ctx, "Successfully updated facility equipment", "facility_equipment_id", id)
+	setETag(c, updatedFacilityEquipment.UpdatedAt)
 	c.JSON(http.StatusOK, response)
 }
 
-// DeleteFacilityEquipment @title 施設設備削除
-// @id DeleteFacilityEquipment
-// @tags facility_equipment
-// @accept json
-// @produce json
-// @Param id path int true "施設設備ID"
-// @Summary 施設設備削除
-// @Success 204 "No Content"
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /facility-equipment/{id} [delete]
+// DeleteFacilityEquipment 施設設備削除
 func (h *facilityEquipmentHandler) DeleteFacilityEquipment(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -319,7 +282,7 @@ func (h *facilityEquipmentHandler) DeleteFacilityEquipment(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid facility equipment ID", "facility_equipment_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid facility equipment ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
@@ -328,7 +291,7 @@ func (h *facilityEquipmentHandler) DeleteFacilityEquipment(c *gin.Context) {
 	_, err = h.facilityEquipmentUseCase.GetFacilityEquipmentByID(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Facility equipment not found", "facility_equipment_id", id)
-		c.JSON(404, gin.H{"error": "Facility equipment not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -336,7 +299,7 @@ func (h *facilityEquipmentHandler) DeleteFacilityEquipment(c *gin.Context) {
 	err = h.facilityEquipmentUseCase.DeleteFacilityEquipment(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to delete facility equipment", "facility_equipment_id", id)
-		c.JSON(500, gin.H{"error": "Failed to delete facility equipment"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -12,16 +12,19 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
 	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
 func setupFacilityEquipmentTest(t *testing.T) (*gin.Engine, *mockusecase.MockFacilityEquipmentUseCase, handler.FacilityEquipment) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
 	ctrl := gomock.NewController(t)
 	mockUseCase := mockusecase.NewMockFacilityEquipmentUseCase(ctrl)
 	l := logger.New(logger.DefaultConfig())
@@ -195,6 +198,7 @@ func TestFacilityEquipmentHandler_GetFacilityEquipment(t *testing.T) {
 					LocationLatitude:    &latitude,
 					LocationLongitude:   &longitude,
 					Notes:               &notes,
+					UpdatedAt:           time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
 				}
 				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(1)).Return(equipment, nil)
 			},
@@ -223,7 +227,7 @@ func TestFacilityEquipmentHandler_GetFacilityEquipment(t *testing.T) {
 			name:        "Not Found",
 			equipmentID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockFacilityEquipmentUseCase) {
-				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -245,6 +249,8 @@ func TestFacilityEquipmentHandler_GetFacilityEquipment(t *testing.T) {
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
 			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, etagFor(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)), w.Header().Get("ETag"))
+
 				var response handler.FacilityEquipmentResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
@@ -397,11 +403,13 @@ func TestFacilityEquipmentHandler_UpdateFacilityEquipment(t *testing.T) {
 
 	// Helper function to create a time pointer
 	now := time.Now()
+	version := time.Date(2024, 1, 1, 9, 0, 0, 123456000, time.UTC)
 
 	// Test cases
 	tests := []struct {
 		name           string
 		equipmentID    string
+		ifMatch        string
 		requestBody    handler.UpdateFacilityEquipmentRequest
 		mockSetup      func(mockUseCase *mockusecase.MockFacilityEquipmentUseCase)
 		expectedStatus int
@@ -410,6 +418,7 @@ func TestFacilityEquipmentHandler_UpdateFacilityEquipment(t *testing.T) {
 		{
 			name:        "Success",
 			equipmentID: "1",
+			ifMatch:     etagFor(version),
 			requestBody: handler.UpdateFacilityEquipmentRequest{
 				Name:             "Updated Equipment",
 				FacilityTypeID:   2,
@@ -439,17 +448,20 @@ func TestFacilityEquipmentHandler_UpdateFacilityEquipment(t *testing.T) {
 					LocationLatitude:    &oldLatitude,
 					LocationLongitude:   &oldLongitude,
 					Notes:               &oldNotes,
+					UpdatedAt:           version,
 				}
 				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(1)).Return(equipment, nil)
 				mockUseCase.EXPECT().
-					UpdateFacilityEquipment(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, e *model.FacilityEquipment) error {
+					UpdateFacilityEquipment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, e *model.FacilityEquipment, expected time.Time) (*model.FacilityEquipment, error) {
+						assert.True(t, version.Equal(expected))
 						assert.Equal(t, "Updated Equipment", e.Name)
 						assert.Equal(t, int32(2), e.FacilityTypeID)
 						assert.Equal(t, "Updated-Model", *e.ModelNumber)
 						assert.Equal(t, "Updated Manufacturer", *e.Manufacturer)
 						assert.Equal(t, "メンテナンス中", e.Status)
-						return nil
+						e.UpdatedAt = version.Add(time.Second)
+						return e, nil
 					})
 			},
 			expectedStatus: http.StatusOK,
@@ -466,6 +478,7 @@ func TestFacilityEquipmentHandler_UpdateFacilityEquipment(t *testing.T) {
 		{
 			name:        "Invalid ID",
 			equipmentID: "invalid",
+			ifMatch:     etagFor(version),
 			requestBody: handler.UpdateFacilityEquipmentRequest{
 				Name:           "Updated Equipment",
 				FacilityTypeID: 2,
@@ -478,20 +491,57 @@ func TestFacilityEquipmentHandler_UpdateFacilityEquipment(t *testing.T) {
 		{
 			name:        "Not Found",
 			equipmentID: "999",
+			ifMatch:     etagFor(version),
 			requestBody: handler.UpdateFacilityEquipmentRequest{
 				Name:           "Updated Equipment",
 				FacilityTypeID: 2,
 				Status:         "メンテナンス中",
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockFacilityEquipmentUseCase) {
-				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
 		},
+		{
+			name:        "Missing If-Match",
+			equipmentID: "1",
+			requestBody: handler.UpdateFacilityEquipmentRequest{
+				Name:           "Updated Equipment",
+				FacilityTypeID: 2,
+				Status:         "メンテナンス中",
+			},
+			mockSetup:      func(mockUseCase *mockusecase.MockFacilityEquipmentUseCase) {},
+			expectedStatus: http.StatusPreconditionRequired,
+			expectedBody:   nil,
+		},
+		{
+			name:        "Version Conflict",
+			equipmentID: "1",
+			ifMatch:     etagFor(version),
+			requestBody: handler.UpdateFacilityEquipmentRequest{
+				Name:           "Updated Equipment",
+				FacilityTypeID: 2,
+				Status:         "メンテナンス中",
+			},
+			mockSetup: func(mockUseCase *mockusecase.MockFacilityEquipmentUseCase) {
+				equipment := &model.FacilityEquipment{
+					ID:             1,
+					Name:           "Old Equipment",
+					FacilityTypeID: 1,
+					Status:         "稼働中",
+					UpdatedAt:      version.Add(time.Minute),
+				}
+				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(1)).Return(equipment, nil)
+				mockUseCase.EXPECT().UpdateFacilityEquipment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, newPreconditionFailedError())
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedBody:   nil,
+		},
 		{
 			name:        "Database Error",
 			equipmentID: "1",
+			ifMatch:     etagFor(version),
 			requestBody: handler.UpdateFacilityEquipmentRequest{
 				Name:           "Updated Equipment",
 				FacilityTypeID: 2,
@@ -505,7 +555,7 @@ func TestFacilityEquipmentHandler_UpdateFacilityEquipment(t *testing.T) {
 					Status:         "稼働中",
 				}
 				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(1)).Return(equipment, nil)
-				mockUseCase.EXPECT().UpdateFacilityEquipment(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+				mockUseCase.EXPECT().UpdateFacilityEquipment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   nil,
@@ -525,12 +575,17 @@ func TestFacilityEquipmentHandler_UpdateFacilityEquipment(t *testing.T) {
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest(http.MethodPut, "/facility-equipment/"+tt.equipmentID, bytes.NewBuffer(jsonBody))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			r.ServeHTTP(w, req)
 
 			// Check response
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
 			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, etagFor(version.Add(time.Second)), w.Header().Get("ETag"))
+
 				var response handler.FacilityEquipmentResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
@@ -587,7 +642,7 @@ func TestFacilityEquipmentHandler_DeleteFacilityEquipment(t *testing.T) {
 			name:        "Not Found",
 			equipmentID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockFacilityEquipmentUseCase) {
-				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetFacilityEquipmentByID(gomock.Any(), int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
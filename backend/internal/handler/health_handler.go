@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/health"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+type Health interface {
+	Livez(c *gin.Context)
+	Readyz(c *gin.Context)
+}
+
+type healthHandler struct {
+	l        *logger.Logger
+	checker  *health.Checker
+	draining context.Context
+}
+
+// NewHealthHandler returns the probe handlers. Readiness fails once draining is done.
+func NewHealthHandler(
+	l *logger.Logger,
+	checker *health.Checker,
+	draining context.Context,
+) Health {
+	return &healthHandler{
+		l:        l,
+		checker:  checker,
+		draining: draining,
+	}
+}
+
+type LivenessResponse struct {
+	Status string `json:"status"`
+}
+
+// Livez 生存確認
+func (h *healthHandler) Livez(c *gin.Context) {
+	c.JSON(http.StatusOK, LivenessResponse{Status: health.StatusUp})
+}
+
+// Readyz 準備状態確認
+func (h *healthHandler) Readyz(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if h.draining.Err() != nil {
+		c.JSON(http.StatusServiceUnavailable, health.Report{
+			Status:     health.StatusDraining,
+			Components: map[string]health.ComponentStatus{},
+		})
+
+		return
+	}
+
+	report := h.checker.Check(ctx)
+	if report.Status != health.StatusUp {
+		// エラーの詳細は公開されるレスポンスには含めず、ログにだけ出す
+		failures := make(map[string]string)
+		for name, component := range report.Components {
+			if component.Status != health.StatusUp {
+				failures[name] = component.Error
+			}
+		}
+		h.l.WarnContext(ctx, "Readiness check failed", "status", report.Status, "failures", failures)
+	}
+	if !report.Ready() {
+		c.JSON(http.StatusServiceUnavailable, report)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
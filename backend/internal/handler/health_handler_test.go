@@ -0,0 +1,121 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/handler"
+	"github.com/AI1411/fullstack-react-go/internal/infra/health"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+func TestHealthHandler_Readyz(t *testing.T) {
+	up := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("connection refused") }
+
+	// Test cases
+	tests := []struct {
+		name           string
+		checks         []health.Check
+		draining       bool
+		expectedStatus int
+		expectedReport string
+	}{
+		{
+			name: "Ready",
+			checks: []health.Check{
+				{Name: "database", Run: up},
+			},
+			expectedStatus: http.StatusOK,
+			expectedReport: health.StatusUp,
+		},
+		{
+			name: "Degraded",
+			checks: []health.Check{
+				{Name: "database", Run: up},
+				{Name: "mail", Optional: true, Run: down},
+			},
+			expectedStatus: http.StatusOK,
+			expectedReport: health.StatusDegraded,
+		},
+		{
+			name: "Not Ready",
+			checks: []health.Check{
+				{Name: "database", Run: down},
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedReport: health.StatusDown,
+		},
+		{
+			name: "Draining",
+			checks: []health.Check{
+				{Name: "database", Run: up},
+			},
+			draining:       true,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedReport: health.StatusDraining,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+
+			draining, startDraining := context.WithCancel(context.Background())
+			defer startDraining()
+			if tt.draining {
+				startDraining()
+			}
+
+			h := handler.NewHealthHandler(logger.New(logger.DefaultConfig()), health.NewChecker(time.Second, tt.checks...), draining)
+			r.GET("/readyz", h.Readyz)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/readyz", nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response health.Report
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedReport, response.Status)
+			if !tt.draining {
+				assert.Len(t, response.Components, len(tt.checks))
+			}
+			// 依存先のエラーの詳細は公開しない
+			assert.NotContains(t, w.Body.String(), "connection refused")
+		})
+	}
+}
+
+func TestHealthHandler_Livez(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	// 依存先が落ちていても、停止処理中でも生存確認は成功する
+	draining, startDraining := context.WithCancel(context.Background())
+	startDraining()
+	checker := health.NewChecker(time.Second, health.Check{Name: "database", Run: func(context.Context) error {
+		return errors.New("connection refused")
+	}})
+	h := handler.NewHealthHandler(logger.New(logger.DefaultConfig()), checker, draining)
+	r.GET("/livez", h.Livez)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/livez", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"up"}`, w.Body.String())
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+)
+
+type Job interface {
+	ListJobs(c *gin.Context)
+	RetryJob(c *gin.Context)
+}
+
+type jobHandler struct {
+	l          *logger.Logger
+	jobUseCase usecase.JobUseCase
+}
+
+func NewJobHandler(
+	l *logger.Logger,
+	jobUseCase usecase.JobUseCase,
+) Job {
+	return &jobHandler{
+		l:          l,
+		jobUseCase: jobUseCase,
+	}
+}
+
+type ListJobsRequest struct {
+	Status   string `form:"status" json:"status" binding:"omitempty,oneof=pending running succeeded dead"`
+	JobType  string `form:"job_type" json:"job_type" binding:"omitempty,max=100"`
+	Limit    int    `form:"limit" json:"limit" binding:"omitempty,min=1,max=200"`
+	BeforeID int64  `form:"before_id" json:"before_id" binding:"omitempty,min=1"`
+}
+
+type JobResponse struct {
+	ID          int64           `json:"id"`
+	JobType     string          `json:"job_type"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      string          `json:"status"`
+	Attempts    int32           `json:"attempts"`
+	MaxAttempts int32           `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"`
+	LockedBy    *string         `json:"locked_by,omitempty"`
+	LockedAt    *time.Time      `json:"locked_at,omitempty"`
+	LastError   *string         `json:"last_error,omitempty"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type ListJobsResponse struct {
+	Jobs []*JobResponse `json:"jobs"`
+}
+
+// ListJobs ジョブ一覧取得
+func (h *jobHandler) ListJobs(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req ListJobsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
+		return
+	}
+
+	jobs, err := h.jobUseCase.ListJobs(ctx, model.JobFilter{
+		Status:   req.Status,
+		JobType:  req.JobType,
+		Limit:    req.Limit,
+		BeforeID: req.BeforeID,
+	})
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to list jobs")
+		_ = c.Error(err)
+
+		return
+	}
+
+	res := &ListJobsResponse{Jobs: make([]*JobResponse, 0, len(jobs))}
+	for _, job := range jobs {
+		res.Jobs = append(res.Jobs, toJobResponse(job))
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RetryJob ジョブ再実行
+func (h *jobHandler) RetryJob(c *gin.Context) {
+	ctx := c.Request.Context()
+	idStr := c.Param("id")
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
+		return
+	}
+
+	job, err := h.jobUseCase.RetryJob(ctx, id)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to retry job", "job_id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully requeued job", "job_id", id, "job_type", job.JobType)
+	c.JSON(http.StatusOK, toJobResponse(job))
+}
+
+func toJobResponse(job *model.Job) *JobResponse {
+	return &JobResponse{
+		ID:          job.ID,
+		JobType:     job.JobType,
+		Payload:     json.RawMessage(job.Payload),
+		Status:      job.Status,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		LockedBy:    job.LockedBy,
+		LockedAt:    job.LockedAt,
+		LastError:   job.LastError,
+		FinishedAt:  job.FinishedAt,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+}
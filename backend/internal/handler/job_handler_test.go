@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/handler"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
+)
+
+func setupJobTest(t *testing.T) (*gin.Engine, *mockusecase.MockJobUseCase, handler.Job) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
+	ctrl := gomock.NewController(t)
+	mockUseCase := mockusecase.NewMockJobUseCase(ctrl)
+	l := logger.New(logger.DefaultConfig())
+	h := handler.NewJobHandler(l, mockUseCase)
+	return r, mockUseCase, h
+}
+
+func TestJobHandler_ListJobs(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupJobTest(t)
+	r.GET("/admin/jobs", h.ListJobs)
+
+	runAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	lastError := "smtp unavailable"
+
+	// Test cases
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(mockUseCase *mockusecase.MockJobUseCase)
+		expectedStatus int
+		expectedJobs   int
+	}{
+		{
+			name:  "Success",
+			query: "?status=dead&job_type=welcome_email&limit=10",
+			mockSetup: func(mockUseCase *mockusecase.MockJobUseCase) {
+				mockUseCase.EXPECT().ListJobs(gomock.Any(), model.JobFilter{Status: "dead", JobType: "welcome_email", Limit: 10}).
+					Return([]*model.Job{
+						{ID: 3, JobType: "welcome_email", Payload: `{"user_id":"1"}`, Status: "dead", Attempts: 5, MaxAttempts: 5, RunAt: runAt, LastError: &lastError},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJobs:   1,
+		},
+		{
+			name:           "Invalid Status",
+			query:          "?status=failed",
+			mockSetup:      func(mockUseCase *mockusecase.MockJobUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Limit",
+			query:          "?limit=500",
+			mockSetup:      func(mockUseCase *mockusecase.MockJobUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockUseCase *mockusecase.MockJobUseCase) {
+				mockUseCase.EXPECT().ListJobs(gomock.Any(), model.JobFilter{}).Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/admin/jobs"+tt.query, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response handler.ListJobsResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				if assert.Len(t, response.Jobs, tt.expectedJobs) {
+					assert.JSONEq(t, `{"user_id":"1"}`, string(response.Jobs[0].Payload))
+					assert.Equal(t, &lastError, response.Jobs[0].LastError)
+				}
+			}
+		})
+	}
+}
+
+func TestJobHandler_RetryJob(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupJobTest(t)
+	r.POST("/admin/jobs/:id/retry", h.RetryJob)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		id             string
+		mockSetup      func(mockUseCase *mockusecase.MockJobUseCase)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			id:   "3",
+			mockSetup: func(mockUseCase *mockusecase.MockJobUseCase) {
+				mockUseCase.EXPECT().RetryJob(gomock.Any(), int64(3)).
+					Return(&model.Job{ID: 3, JobType: "welcome_email", Payload: `{}`, Status: "pending", MaxAttempts: 5}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid ID",
+			id:             "abc",
+			mockSetup:      func(mockUseCase *mockusecase.MockJobUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Retryable",
+			id:   "4",
+			mockSetup: func(mockUseCase *mockusecase.MockJobUseCase) {
+				mockUseCase.EXPECT().RetryJob(gomock.Any(), int64(4)).
+					Return(nil, myerrors.NewAPIError(myerrors.JobNotRetryableError, myerrors.JobNotRetryableErrorMessage, gorm.ErrRecordNotFound, "dead job not found"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/admin/jobs/"+tt.id+"/retry", nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -8,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 )
@@ -15,13 +19,21 @@ import (
 type Notification interface {
 	ListNotifications(c *gin.Context)
 	GetNotification(c *gin.Context)
-	GetNotificationsByUserID(c *gin.Context)
+	ListMyNotifications(c *gin.Context)
 	CreateNotification(c *gin.Context)
 	UpdateNotification(c *gin.Context)
 	DeleteNotification(c *gin.Context)
 	MarkAsRead(c *gin.Context)
+	StreamMyNotifications(c *gin.Context)
+	BroadcastNotification(c *gin.Context)
+	MarkAllMyNotificationsAsRead(c *gin.Context)
+	DeleteMyNotifications(c *gin.Context)
+	CountMyUnreadNotifications(c *gin.Context)
 }
 
+// notificationStreamHeartbeatInterval keeps idle SSE connections from being closed by proxies
+const notificationStreamHeartbeatInterval = 30 * time.Second
+
 type notificationHandler struct {
 	l                   *logger.Logger
 	notificationUseCase usecase.NotificationUseCase
@@ -49,6 +61,31 @@ type NotificationResponse struct {
 	ReadAt            *time.Time `json:"read_at,omitempty"`
 	CreatedAt         time.Time  `json:"created_at"`
 	UpdatedAt         time.Time  `json:"updated_at"`
+	// RelatedEntity is set when the related entity type is registered
+	RelatedEntity *RelatedEntityResponse `json:"related_entity,omitempty"`
+}
+
+// RelatedEntityResponse is the resolved target of a notification's related entity. Path is omitted when the
+// record was deleted.
+type RelatedEntityResponse struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Path    string `json:"path,omitempty"`
+	Label   string `json:"label,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Deleted bool   `json:"deleted"`
+}
+
+// NotificationDeliveryResponse reports the result per channel: sent, queued, failed or disabled
+type NotificationDeliveryResponse struct {
+	InApp   string `json:"in_app"`
+	Email   string `json:"email"`
+	Webhook string `json:"webhook"`
+}
+
+type CreateNotificationResponse struct {
+	*NotificationResponse
+	Delivery *NotificationDeliveryResponse `json:"delivery"`
 }
 
 type CreateNotificationRequest struct {
@@ -68,164 +105,132 @@ type UpdateNotificationRequest struct {
 	RelatedEntityID   *string `json:"related_entity_id,omitempty" binding:"omitempty,max=100"`
 }
 
-// ListNotifications @title 通知一覧取得
-// @id ListNotifications
-// @tags notification
-// @accept json
-// @produce json
-// @Summary 通知一覧取得
-// @Success 200 {array} NotificationResponse
-// @Router /notifications [get]
+type BroadcastNotificationRequest struct {
+	Title             string  `json:"title" binding:"required,max=200"`
+	Message           string  `json:"message" binding:"required"`
+	NotificationType  string  `json:"notification_type" binding:"required,max=50"`
+	RelatedEntityType *string `json:"related_entity_type,omitempty" binding:"omitempty,max=50"`
+	RelatedEntityID   *string `json:"related_entity_id,omitempty" binding:"omitempty,max=100"`
+	TargetType        string  `json:"target_type" binding:"required,oneof=all role organization prefecture region"`
+	TargetID          *string `json:"target_id,omitempty" binding:"omitempty,max=20"`
+}
+
+type BroadcastNotificationResponse struct {
+	ID                int32   `json:"id"`
+	Title             string  `json:"title"`
+	Message           string  `json:"message"`
+	NotificationType  string  `json:"notification_type"`
+	RelatedEntityType *string `json:"related_entity_type,omitempty"`
+	RelatedEntityID   *string `json:"related_entity_id,omitempty"`
+	TargetType        string  `json:"target_type"`
+	TargetID          *string `json:"target_id,omitempty"`
+	// EstimatedRecipientCount is the number of users in the target when the broadcast was accepted. The recipients
+	// are expanded later in the background, so users added or deactivated in between make the actual number differ.
+	EstimatedRecipientCount int32     `json:"estimated_recipient_count"`
+	CreatedBy               *string   `json:"created_by,omitempty"`
+	CreatedAt               time.Time `json:"created_at"`
+}
+
+type MarkAllNotificationsAsReadRequest struct {
+	NotificationType *string    `json:"notification_type,omitempty" binding:"omitempty,max=50"`
+	Before           *time.Time `json:"before,omitempty"`
+}
+
+type MarkAllNotificationsAsReadResponse struct {
+	UpdatedCount int64 `json:"updated_count"`
+}
+
+type DeleteNotificationsRequest struct {
+	IDs              []int32    `json:"ids,omitempty" binding:"omitempty,max=1000,dive,min=1"`
+	NotificationType *string    `json:"notification_type,omitempty" binding:"omitempty,max=50"`
+	Before           *time.Time `json:"before,omitempty"`
+	// All deletes every notification of the user when no other condition is given
+	All bool `json:"all,omitempty"`
+}
+
+type DeleteNotificationsResponse struct {
+	DeletedCount int64 `json:"deleted_count"`
+}
+
+type UnreadNotificationCountResponse struct {
+	Total  int64            `json:"total"`
+	ByType map[string]int64 `json:"by_type"`
+}
+
+// ListNotifications 通知一覧取得
 func (h *notificationHandler) ListNotifications(c *gin.Context) {
 	ctx := c.Request.Context()
 	notifications, err := h.notificationUseCase.ListNotifications(ctx)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to list notifications")
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var response []*NotificationResponse
-
-	for _, notification := range notifications {
-		resp := &NotificationResponse{
-			ID:                notification.ID,
-			UserID:            notification.UserID,
-			Title:             notification.Title,
-			Message:           notification.Message,
-			NotificationType:  notification.NotificationType,
-			RelatedEntityType: notification.RelatedEntityType,
-			RelatedEntityID:   notification.RelatedEntityID,
-			IsRead:            notification.IsRead,
-			ReadAt:            notification.ReadAt,
-			CreatedAt:         notification.CreatedAt,
-			UpdatedAt:         notification.UpdatedAt,
-		}
-
-		response = append(response, resp)
-	}
+	response := h.toNotificationResponses(ctx, notifications...)
 
 	h.l.InfoContext(ctx, "Successfully listed notifications", "count", len(response))
 	c.JSON(http.StatusOK, response)
 }
 
-// GetNotification @title 通知詳細取得
-// @id GetNotification
-// @tags notification
-// @accept json
-// @produce json
-// @Param id path int true "通知ID"
-// @Summary 通知詳細取得
-// @Success 200 {object} NotificationResponse
-// @Failure 404 {object} map[string]string
-// @Router /notifications/{id} [get]
+// GetNotification 通知詳細取得
 func (h *notificationHandler) GetNotification(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
+	userID := c.GetString("user_id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid notification ID", "notification_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid notification ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
 
-	notification, err := h.notificationUseCase.GetNotificationByID(ctx, int32(id))
+	notification, err := h.notificationUseCase.GetNotificationByID(ctx, userID, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Notification not found", "notification_id", id)
-		c.JSON(404, gin.H{"error": "Notification not found"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	response := &NotificationResponse{
-		ID:                notification.ID,
-		UserID:            notification.UserID,
-		Title:             notification.Title,
-		Message:           notification.Message,
-		NotificationType:  notification.NotificationType,
-		RelatedEntityType: notification.RelatedEntityType,
-		RelatedEntityID:   notification.RelatedEntityID,
-		IsRead:            notification.IsRead,
-		ReadAt:            notification.ReadAt,
-		CreatedAt:         notification.CreatedAt,
-		UpdatedAt:         notification.UpdatedAt,
-	}
+	response := h.toNotificationResponses(ctx, notification)[0]
 
 	h.l.InfoContext(ctx, "Successfully retrieved notification", "notification_id", id)
 	c.JSON(http.StatusOK, response)
 }
 
-// GetNotificationsByUserID @title ユーザーIDによる通知一覧取得
-// @id GetNotificationsByUserID
-// @tags notification
-// @accept json
-// @produce json
-// @Param user_id path int true "ユーザーID"
-// @Summary ユーザーIDによる通知一覧取得
-// @Success 200 {array} NotificationResponse
-// @Failure 404 {object} map[string]string
-// @Router /notifications/user/{user_id} [get]
-func (h *notificationHandler) GetNotificationsByUserID(c *gin.Context) {
-	userIDStr := c.Param("user_id")
+// ListMyNotifications ログインユーザーの通知一覧取得
+func (h *notificationHandler) ListMyNotifications(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	userID, err := strconv.ParseInt(userIDStr, 10, 32)
-	if err != nil {
-		h.l.ErrorContext(ctx, err, "Invalid user ID", "user_id_str", userIDStr)
-		c.JSON(400, gin.H{"error": "Invalid user ID"})
-
+	userID := c.GetString("user_id")
+	if userID == "" {
+		_ = c.Error(newUnauthorizedError())
 		return
 	}
 
-	notifications, err := h.notificationUseCase.GetNotificationsByUserID(ctx, int32(userID))
+	notifications, err := h.notificationUseCase.GetNotificationsByUserID(ctx, userID)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to get notifications for user", "user_id", userID)
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var response []*NotificationResponse
-
-	for _, notification := range notifications {
-		resp := &NotificationResponse{
-			ID:                notification.ID,
-			UserID:            notification.UserID,
-			Title:             notification.Title,
-			Message:           notification.Message,
-			NotificationType:  notification.NotificationType,
-			RelatedEntityType: notification.RelatedEntityType,
-			RelatedEntityID:   notification.RelatedEntityID,
-			IsRead:            notification.IsRead,
-			ReadAt:            notification.ReadAt,
-			CreatedAt:         notification.CreatedAt,
-			UpdatedAt:         notification.UpdatedAt,
-		}
-
-		response = append(response, resp)
-	}
+	response := h.toNotificationResponses(ctx, notifications...)
 
 	h.l.InfoContext(ctx, "Successfully retrieved notifications for user", "user_id", userID, "count", len(response))
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateNotification @title 通知作成
-// @id CreateNotification
-// @tags notification
-// @accept json
-// @produce json
-// @Param request body CreateNotificationRequest true "通知作成リクエスト"
-// @Summary 通知作成
-// @Success 201 {object} NotificationResponse
-// @Failure 400 {object} map[string]string
-// @Router /notifications [post]
+// CreateNotification 通知作成
 func (h *notificationHandler) CreateNotification(c *gin.Context) {
 	var req CreateNotificationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
@@ -240,67 +245,61 @@ func (h *notificationHandler) CreateNotification(c *gin.Context) {
 	}
 
 	ctx := c.Request.Context()
-	err := h.notificationUseCase.CreateNotification(ctx, notification)
+	delivery, err := h.notificationUseCase.CreateNotification(ctx, notification)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to create notification")
-		c.JSON(500, gin.H{"error": "Failed to create notification"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	response := &NotificationResponse{
-		ID:                notification.ID,
-		UserID:            notification.UserID,
-		Title:             notification.Title,
-		Message:           notification.Message,
-		NotificationType:  notification.NotificationType,
-		RelatedEntityType: notification.RelatedEntityType,
-		RelatedEntityID:   notification.RelatedEntityID,
-		IsRead:            notification.IsRead,
-		ReadAt:            notification.ReadAt,
-		CreatedAt:         notification.CreatedAt,
-		UpdatedAt:         notification.UpdatedAt,
+	for _, failure := range delivery.Failures {
+		h.l.ErrorContext(ctx, failure, "Failed to deliver notification", "user_id", notification.UserID)
+	}
+
+	response := &CreateNotificationResponse{
+		NotificationResponse: h.toNotificationResponses(ctx, notification)[0],
+		Delivery: &NotificationDeliveryResponse{
+			InApp:   delivery.InApp,
+			Email:   delivery.Email,
+			Webhook: delivery.Webhook,
+		},
 	}
 
-	h.l.InfoContext(ctx, "Successfully created notification", "notification_id", notification.ID)
+	h.l.InfoContext(ctx, "Successfully created notification",
+		"notification_id", notification.ID,
+		"in_app", delivery.InApp,
+		"email", delivery.Email,
+		"webhook", delivery.Webhook,
+	)
 	c.JSON(http.StatusCreated, response)
 }
 
-// UpdateNotification @title 通知更新
-// @id UpdateNotification
-// @tags notification
-// @accept json
-// @produce json
-// @Param id path int true "通知ID"
-// @Param request body UpdateNotificationRequest true "通知更新リクエスト"
-// @Summary 通知更新
-// @Success 200 {object} NotificationResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /notifications/{id} [put]
+// UpdateNotification 通知更新
 func (h *notificationHandler) UpdateNotification(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
+	userID := c.GetString("user_id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid notification ID", "notification_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid notification ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
 
 	var req UpdateNotificationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
 	// Check if the notification exists
-	existingNotification, err := h.notificationUseCase.GetNotificationByID(ctx, int32(id))
+	existingNotification, err := h.notificationUseCase.GetNotificationByID(ctx, userID, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Notification not found", "notification_id", id)
-		c.JSON(404, gin.H{"error": "Notification not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -315,57 +314,36 @@ func (h *notificationHandler) UpdateNotification(c *gin.Context) {
 	err = h.notificationUseCase.UpdateNotification(ctx, existingNotification)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to update notification", "notification_id", id)
-		c.JSON(500, gin.H{"error": "Failed to update notification"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	response := &NotificationResponse{
-		ID:                existingNotification.ID,
-		UserID:            existingNotification.UserID,
-		Title:             existingNotification.Title,
-		Message:           existingNotification.Message,
-		NotificationType:  existingNotification.NotificationType,
-		RelatedEntityType: existingNotification.RelatedEntityType,
-		RelatedEntityID:   existingNotification.RelatedEntityID,
-		IsRead:            existingNotification.IsRead,
-		ReadAt:            existingNotification.ReadAt,
-		CreatedAt:         existingNotification.CreatedAt,
-		UpdatedAt:         existingNotification.UpdatedAt,
-	}
+	response := h.toNotificationResponses(ctx, existingNotification)[0]
 
 	h.l.InfoContext(ctx, "Successfully updated notification", "notification_id", id)
 	c.JSON(http.StatusOK, response)
 }
 
-// DeleteNotification @title 通知削除
-// @id DeleteNotification
-// @tags notification
-// @accept json
-// @produce json
-// @Param id path int true "通知ID"
-// @Summary 通知削除
-// @Success 204 "No Content"
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /notifications/{id} [delete]
+// DeleteNotification 通知削除
 func (h *notificationHandler) DeleteNotification(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
+	userID := c.GetString("user_id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid notification ID", "notification_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid notification ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
 
 	// Check if the notification exists
-	_, err = h.notificationUseCase.GetNotificationByID(ctx, int32(id))
+	_, err = h.notificationUseCase.GetNotificationByID(ctx, userID, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Notification not found", "notification_id", id)
-		c.JSON(404, gin.H{"error": "Notification not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -373,7 +351,7 @@ func (h *notificationHandler) DeleteNotification(c *gin.Context) {
 	err = h.notificationUseCase.DeleteNotification(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to delete notification", "notification_id", id)
-		c.JSON(500, gin.H{"error": "Failed to delete notification"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -382,53 +360,32 @@ func (h *notificationHandler) DeleteNotification(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
-// MarkAsRead @title 通知を既読にする
-// @id MarkAsRead
-// @tags notification
-// @accept json
-// @produce json
-// @Param id path int true "通知ID"
-// @Summary 通知を既読にする
-// @Success 200 {object} NotificationResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /notifications/{id}/read [put]
+// MarkAsRead 通知を既読にする
 func (h *notificationHandler) MarkAsRead(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
+	userID := c.GetString("user_id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid notification ID", "notification_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid notification ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
 
 	// Check if the notification exists
-	notification, err := h.notificationUseCase.GetNotificationByID(ctx, int32(id))
+	notification, err := h.notificationUseCase.GetNotificationByID(ctx, userID, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Notification not found", "notification_id", id)
-		c.JSON(404, gin.H{"error": "Notification not found"})
+		_ = c.Error(err)
 
 		return
 	}
 
 	// If already read, return the notification as is
 	if notification.IsRead {
-		response := &NotificationResponse{
-			ID:                notification.ID,
-			UserID:            notification.UserID,
-			Title:             notification.Title,
-			Message:           notification.Message,
-			NotificationType:  notification.NotificationType,
-			RelatedEntityType: notification.RelatedEntityType,
-			RelatedEntityID:   notification.RelatedEntityID,
-			IsRead:            notification.IsRead,
-			ReadAt:            notification.ReadAt,
-			CreatedAt:         notification.CreatedAt,
-			UpdatedAt:         notification.UpdatedAt,
-		}
+		response := h.toNotificationResponses(ctx, notification)[0]
 
 		h.l.InfoContext(ctx, "Notification already marked as read", "notification_id", id)
 		c.JSON(http.StatusOK, response)
@@ -439,34 +396,316 @@ func (h *notificationHandler) MarkAsRead(c *gin.Context) {
 	err = h.notificationUseCase.MarkAsRead(ctx, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to mark notification as read", "notification_id", id)
-		c.JSON(500, gin.H{"error": "Failed to mark notification as read"})
+		_ = c.Error(err)
 
 		return
 	}
 
 	// Get the updated notification
-	updatedNotification, err := h.notificationUseCase.GetNotificationByID(ctx, int32(id))
+	updatedNotification, err := h.notificationUseCase.GetNotificationByID(ctx, userID, int32(id))
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to get updated notification", "notification_id", id)
-		c.JSON(500, gin.H{"error": "Failed to get updated notification"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	response := &NotificationResponse{
-		ID:                updatedNotification.ID,
-		UserID:            updatedNotification.UserID,
-		Title:             updatedNotification.Title,
-		Message:           updatedNotification.Message,
-		NotificationType:  updatedNotification.NotificationType,
-		RelatedEntityType: updatedNotification.RelatedEntityType,
-		RelatedEntityID:   updatedNotification.RelatedEntityID,
-		IsRead:            updatedNotification.IsRead,
-		ReadAt:            updatedNotification.ReadAt,
-		CreatedAt:         updatedNotification.CreatedAt,
-		UpdatedAt:         updatedNotification.UpdatedAt,
-	}
+	response := h.toNotificationResponses(ctx, updatedNotification)[0]
 
 	h.l.InfoContext(ctx, "Successfully marked notification as read", "notification_id", id)
 	c.JSON(http.StatusOK, response)
 }
+
+// StreamMyNotifications 通知のリアルタイム配信
+func (h *notificationHandler) StreamMyNotifications(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		_ = c.Error(newUnauthorizedError())
+		return
+	}
+
+	// 既存通知の取得との間に作成された通知を取りこぼさないよう、先に購読しておく
+	signals, unsubscribe := h.notificationUseCase.SubscribeNotifications(userID)
+	defer unsubscribe()
+
+	var lastID int32
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		id, err := strconv.ParseInt(lastEventID, 10, 32)
+		if err != nil || id < 0 {
+			_ = c.Error(newInvalidParamError("Last-Event-ID", myerrors.FieldMin, 0))
+			return
+		}
+
+		lastID = int32(id)
+	} else {
+		id, err := h.notificationUseCase.LatestNotificationID(ctx, userID)
+		if err != nil {
+			h.l.ErrorContext(ctx, err, "Failed to get latest notification ID", "user_id", userID)
+			_ = c.Error(err)
+
+			return
+		}
+
+		lastID = id
+	}
+
+	// ストリームはサーバーの書き込みタイムアウトを超えて続くため、この接続では期限を外す
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	h.l.InfoContext(ctx, "Notification stream opened", "user_id", userID, "last_event_id", lastID)
+
+	// 再接続時はLast-Event-ID以降の通知をまず送る
+	if !h.sendNotificationsAfter(ctx, c, userID, &lastID) {
+		return
+	}
+
+	heartbeat := time.NewTicker(notificationStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.l.InfoContext(ctx, "Notification stream closed", "user_id", userID, "last_event_id", lastID)
+			return
+		case <-signals:
+			if !h.sendNotificationsAfter(ctx, c, userID, &lastID) {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// sendNotificationsAfter writes the user's notifications newer than lastID as SSE events and advances lastID.
+// It returns false when the stream should be closed.
+func (h *notificationHandler) sendNotificationsAfter(ctx context.Context, c *gin.Context, userID string, lastID *int32) bool {
+	notifications, err := h.notificationUseCase.ListNotificationsAfter(ctx, userID, *lastID)
+	if err != nil {
+		// 接続を閉じれば、クライアントはLast-Event-IDを付けて再接続してくる
+		h.l.ErrorContext(ctx, err, "Failed to list notifications for stream", "user_id", userID, "last_event_id", *lastID)
+		return false
+	}
+
+	for i, response := range h.toNotificationResponses(ctx, notifications...) {
+		notification := notifications[i]
+		data, err := json.Marshal(response)
+		if err != nil {
+			h.l.ErrorContext(ctx, err, "Failed to encode notification", "notification_id", notification.ID)
+			return false
+		}
+
+		if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: notification\ndata: %s\n\n", notification.ID, data); err != nil {
+			return false
+		}
+
+		*lastID = notification.ID
+	}
+
+	if len(notifications) > 0 {
+		c.Writer.Flush()
+	}
+
+	return true
+}
+
+// toNotificationResponses converts the notifications and attaches their resolved related entities.
+// A failed lookup only leaves out the related entities so the notifications themselves stay readable.
+func (h *notificationHandler) toNotificationResponses(ctx context.Context, notifications ...*model.Notification) []*NotificationResponse {
+	links, err := h.notificationUseCase.ResolveRelatedEntities(ctx, notifications)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to resolve related entities of notifications")
+	}
+
+	response := make([]*NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		resp := toNotificationResponse(notification)
+		if ref := model.NewRelatedEntityRef(notification.RelatedEntityType, notification.RelatedEntityID); ref != nil {
+			if link, ok := links[*ref]; ok {
+				resp.RelatedEntity = &RelatedEntityResponse{
+					Type:    string(link.Type),
+					ID:      link.ID,
+					Path:    link.Path,
+					Label:   link.Label,
+					Status:  link.Status,
+					Deleted: link.Deleted,
+				}
+			}
+		}
+
+		response = append(response, resp)
+	}
+
+	return response
+}
+
+func toNotificationResponse(notification *model.Notification) *NotificationResponse {
+	return &NotificationResponse{
+		ID:                notification.ID,
+		UserID:            notification.UserID,
+		Title:             notification.Title,
+		Message:           notification.Message,
+		NotificationType:  notification.NotificationType,
+		RelatedEntityType: notification.RelatedEntityType,
+		RelatedEntityID:   notification.RelatedEntityID,
+		IsRead:            notification.IsRead,
+		ReadAt:            notification.ReadAt,
+		CreatedAt:         notification.CreatedAt,
+		UpdatedAt:         notification.UpdatedAt,
+	}
+}
+
+// BroadcastNotification 一斉通知
+func (h *notificationHandler) BroadcastNotification(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req BroadcastNotificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
+		return
+	}
+
+	broadcast := &model.BroadcastNotification{
+		Title:             req.Title,
+		Message:           req.Message,
+		NotificationType:  req.NotificationType,
+		RelatedEntityType: req.RelatedEntityType,
+		RelatedEntityID:   req.RelatedEntityID,
+		TargetType:        req.TargetType,
+		TargetID:          req.TargetID,
+	}
+	if userID := c.GetString("user_id"); userID != "" {
+		broadcast.CreatedBy = &userID
+	}
+
+	if err := h.notificationUseCase.BroadcastNotification(ctx, broadcast); err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to broadcast notification", "target_type", req.TargetType)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully broadcast notification",
+		"broadcast_id", broadcast.ID,
+		"target_type", broadcast.TargetType,
+		"estimated_recipient_count", broadcast.RecipientCount,
+	)
+	c.JSON(http.StatusCreated, &BroadcastNotificationResponse{
+		ID:                      broadcast.ID,
+		Title:                   broadcast.Title,
+		Message:                 broadcast.Message,
+		NotificationType:        broadcast.NotificationType,
+		RelatedEntityType:       broadcast.RelatedEntityType,
+		RelatedEntityID:         broadcast.RelatedEntityID,
+		TargetType:              broadcast.TargetType,
+		TargetID:                broadcast.TargetID,
+		EstimatedRecipientCount: broadcast.RecipientCount,
+		CreatedBy:               broadcast.CreatedBy,
+		CreatedAt:               broadcast.CreatedAt,
+	})
+}
+
+// MarkAllMyNotificationsAsRead ログインユーザーの通知一括既読
+func (h *notificationHandler) MarkAllMyNotificationsAsRead(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		_ = c.Error(newUnauthorizedError())
+		return
+	}
+
+	// 条件なしで全件既読にできるよう、ボディは省略可能
+	var req MarkAllNotificationsAsReadRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(newBindingError(&req, err))
+			return
+		}
+	}
+
+	updated, err := h.notificationUseCase.MarkAllAsRead(ctx, userID, &model.NotificationFilter{
+		NotificationType: req.NotificationType,
+		Before:           req.Before,
+	})
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to mark notifications as read", "user_id", userID)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully marked notifications as read", "user_id", userID, "updated_count", updated)
+	c.JSON(http.StatusOK, &MarkAllNotificationsAsReadResponse{UpdatedCount: updated})
+}
+
+// DeleteMyNotifications ログインユーザーの通知一括削除
+func (h *notificationHandler) DeleteMyNotifications(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		_ = c.Error(newUnauthorizedError())
+		return
+	}
+
+	var req DeleteNotificationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
+		return
+	}
+
+	deleted, err := h.notificationUseCase.DeleteNotifications(ctx, userID, &model.NotificationFilter{
+		IDs:              req.IDs,
+		NotificationType: req.NotificationType,
+		Before:           req.Before,
+		All:              req.All,
+	})
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to delete notifications", "user_id", userID)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully deleted notifications", "user_id", userID, "deleted_count", deleted)
+	c.JSON(http.StatusOK, &DeleteNotificationsResponse{DeletedCount: deleted})
+}
+
+// CountMyUnreadNotifications ログインユーザーの未読通知件数取得
+func (h *notificationHandler) CountMyUnreadNotifications(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		_ = c.Error(newUnauthorizedError())
+		return
+	}
+
+	counts, err := h.notificationUseCase.CountUnread(ctx, userID)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to count unread notifications", "user_id", userID)
+		_ = c.Error(err)
+
+		return
+	}
+
+	response := &UnreadNotificationCountResponse{ByType: counts}
+	for _, count := range counts {
+		response.Total += count
+	}
+
+	c.JSON(http.StatusOK, response)
+}
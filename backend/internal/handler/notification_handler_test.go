@@ -2,28 +2,37 @@ package handler_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
 	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
 func setupNotificationTest(t *testing.T) (*gin.Engine, *mockusecase.MockNotificationUseCase, handler.Notification) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
 	ctrl := gomock.NewController(t)
 	mockUseCase := mockusecase.NewMockNotificationUseCase(ctrl)
+	// 関連エンティティの解決はTestNotificationHandler_RelatedEntityで確認する
+	mockUseCase.EXPECT().ResolveRelatedEntities(gomock.Any(), gomock.Any()).
+		Return(map[model.RelatedEntityRef]*model.RelatedEntityLink{}, nil).AnyTimes()
 	l := logger.New(logger.DefaultConfig())
 	h := handler.NewNotificationHandler(l, mockUseCase)
 	return r, mockUseCase, h
@@ -51,7 +60,7 @@ func TestNotificationHandler_ListNotifications(t *testing.T) {
 				notifications := []*model.Notification{
 					{
 						ID:                1,
-						UserID:            1,
+						UserID:            "1",
 						Title:             "Test Notification 1",
 						Message:           "This is a test notification",
 						NotificationType:  "alert",
@@ -64,7 +73,7 @@ func TestNotificationHandler_ListNotifications(t *testing.T) {
 					},
 					{
 						ID:               2,
-						UserID:           2,
+						UserID:           "2",
 						Title:            "Test Notification 2",
 						Message:          "This is another test notification",
 						NotificationType: "info",
@@ -79,7 +88,7 @@ func TestNotificationHandler_ListNotifications(t *testing.T) {
 			expectedBody: []*handler.NotificationResponse{
 				{
 					ID:                1,
-					UserID:            1,
+					UserID:            "1",
 					Title:             "Test Notification 1",
 					Message:           "This is a test notification",
 					NotificationType:  "alert",
@@ -89,7 +98,7 @@ func TestNotificationHandler_ListNotifications(t *testing.T) {
 				},
 				{
 					ID:               2,
-					UserID:           2,
+					UserID:           "2",
 					Title:            "Test Notification 2",
 					Message:          "This is another test notification",
 					NotificationType: "info",
@@ -151,7 +160,10 @@ func TestNotificationHandler_ListNotifications(t *testing.T) {
 func TestNotificationHandler_GetNotification(t *testing.T) {
 	// Setup
 	r, mockUseCase, h := setupNotificationTest(t)
-	r.GET("/notifications/:id", h.GetNotification)
+	r.GET("/notifications/:id", func(c *gin.Context) {
+		c.Set("user_id", preferenceUserID)
+		c.Next()
+	}, h.GetNotification)
 
 	// Test cases
 	tests := []struct {
@@ -171,7 +183,7 @@ func TestNotificationHandler_GetNotification(t *testing.T) {
 
 				notification := &model.Notification{
 					ID:                1,
-					UserID:            1,
+					UserID:            "1",
 					Title:             "Test Notification",
 					Message:           "This is a test notification",
 					NotificationType:  "alert",
@@ -182,12 +194,12 @@ func TestNotificationHandler_GetNotification(t *testing.T) {
 					CreatedAt:         time.Now(),
 					UpdatedAt:         time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(notification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(notification, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: &handler.NotificationResponse{
 				ID:                1,
-				UserID:            1,
+				UserID:            "1",
 				Title:             "Test Notification",
 				Message:           "This is a test notification",
 				NotificationType:  "alert",
@@ -207,7 +219,7 @@ func TestNotificationHandler_GetNotification(t *testing.T) {
 			name:           "Not Found",
 			notificationID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -251,10 +263,15 @@ func TestNotificationHandler_GetNotification(t *testing.T) {
 	}
 }
 
-func TestNotificationHandler_GetNotificationsByUserID(t *testing.T) {
+func TestNotificationHandler_ListMyNotifications(t *testing.T) {
 	// Setup
 	r, mockUseCase, h := setupNotificationTest(t)
-	r.GET("/notifications/user/:user_id", h.GetNotificationsByUserID)
+	r.GET("/me/notifications", func(c *gin.Context) {
+		if userID := c.GetHeader("X-Test-User-ID"); userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	}, h.ListMyNotifications)
 
 	// Test cases
 	tests := []struct {
@@ -275,7 +292,7 @@ func TestNotificationHandler_GetNotificationsByUserID(t *testing.T) {
 				notifications := []*model.Notification{
 					{
 						ID:                1,
-						UserID:            1,
+						UserID:            "1",
 						Title:             "Test Notification 1",
 						Message:           "This is a test notification",
 						NotificationType:  "alert",
@@ -288,7 +305,7 @@ func TestNotificationHandler_GetNotificationsByUserID(t *testing.T) {
 					},
 					{
 						ID:               2,
-						UserID:           1,
+						UserID:           "1",
 						Title:            "Test Notification 2",
 						Message:          "This is another test notification",
 						NotificationType: "info",
@@ -297,13 +314,13 @@ func TestNotificationHandler_GetNotificationsByUserID(t *testing.T) {
 						UpdatedAt:        time.Now(),
 					},
 				}
-				mockUseCase.EXPECT().GetNotificationsByUserID(gomock.Any(), int32(1)).Return(notifications, nil)
+				mockUseCase.EXPECT().GetNotificationsByUserID(gomock.Any(), "1").Return(notifications, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: []*handler.NotificationResponse{
 				{
 					ID:                1,
-					UserID:            1,
+					UserID:            "1",
 					Title:             "Test Notification 1",
 					Message:           "This is a test notification",
 					NotificationType:  "alert",
@@ -313,7 +330,7 @@ func TestNotificationHandler_GetNotificationsByUserID(t *testing.T) {
 				},
 				{
 					ID:               2,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "Test Notification 2",
 					Message:          "This is another test notification",
 					NotificationType: "info",
@@ -322,17 +339,17 @@ func TestNotificationHandler_GetNotificationsByUserID(t *testing.T) {
 			},
 		},
 		{
-			name:           "Invalid User ID",
-			userID:         "invalid",
+			name:           "Unauthorized",
+			userID:         "",
 			mockSetup:      func(mockUseCase *mockusecase.MockNotificationUseCase) {},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusUnauthorized,
 			expectedBody:   nil,
 		},
 		{
 			name:   "Error",
 			userID: "1",
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
-				mockUseCase.EXPECT().GetNotificationsByUserID(gomock.Any(), int32(1)).Return(nil, errors.New("database error"))
+				mockUseCase.EXPECT().GetNotificationsByUserID(gomock.Any(), "1").Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   nil,
@@ -347,7 +364,10 @@ func TestNotificationHandler_GetNotificationsByUserID(t *testing.T) {
 
 			// Make request
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequest(http.MethodGet, "/notifications/user/"+tt.userID, nil)
+			req, _ := http.NewRequest(http.MethodGet, "/me/notifications", nil)
+			if tt.userID != "" {
+				req.Header.Set("X-Test-User-ID", tt.userID)
+			}
 			r.ServeHTTP(w, req)
 
 			// Check response
@@ -396,7 +416,7 @@ func TestNotificationHandler_CreateNotification(t *testing.T) {
 		{
 			name: "Success",
 			requestBody: handler.CreateNotificationRequest{
-				UserID:            1,
+				UserID:            "1",
 				Title:             "New Notification",
 				Message:           "This is a new notification",
 				NotificationType:  "alert",
@@ -406,17 +426,22 @@ func TestNotificationHandler_CreateNotification(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
 				mockUseCase.EXPECT().
 					CreateNotification(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, notification *model.Notification) error {
+					DoAndReturn(func(_ interface{}, notification *model.Notification) (*model.NotificationDelivery, error) {
 						notification.ID = 1 // Simulate ID generation
 						notification.CreatedAt = time.Now()
 						notification.UpdatedAt = time.Now()
-						return nil
+						return &model.NotificationDelivery{
+							InApp:    model.DeliveryStatusSent,
+							Email:    model.DeliveryStatusFailed,
+							Webhook:  model.DeliveryStatusDisabled,
+							Failures: []error{errors.New("smtp unavailable")},
+						}, nil
 					})
 			},
 			expectedStatus: http.StatusCreated,
 			expectedBody: &handler.NotificationResponse{
 				ID:                1,
-				UserID:            1,
+				UserID:            "1",
 				Title:             "New Notification",
 				Message:           "This is a new notification",
 				NotificationType:  "alert",
@@ -429,7 +454,7 @@ func TestNotificationHandler_CreateNotification(t *testing.T) {
 			name: "Invalid Request - Missing Required Field",
 			requestBody: handler.CreateNotificationRequest{
 				// Missing Title
-				UserID:           1,
+				UserID:           "1",
 				Message:          "This is a new notification",
 				NotificationType: "alert",
 			},
@@ -440,7 +465,7 @@ func TestNotificationHandler_CreateNotification(t *testing.T) {
 		{
 			name: "Database Error",
 			requestBody: handler.CreateNotificationRequest{
-				UserID:           1,
+				UserID:           "1",
 				Title:            "New Notification",
 				Message:          "This is a new notification",
 				NotificationType: "alert",
@@ -448,7 +473,7 @@ func TestNotificationHandler_CreateNotification(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
 				mockUseCase.EXPECT().
 					CreateNotification(gomock.Any(), gomock.Any()).
-					Return(errors.New("database error"))
+					Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   nil,
@@ -474,10 +499,17 @@ func TestNotificationHandler_CreateNotification(t *testing.T) {
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
 			if tt.expectedStatus == http.StatusCreated {
-				var response handler.NotificationResponse
+				var response handler.CreateNotificationResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 
+				// A failed email does not fail the request
+				assert.Equal(t, &handler.NotificationDeliveryResponse{
+					InApp:   model.DeliveryStatusSent,
+					Email:   model.DeliveryStatusFailed,
+					Webhook: model.DeliveryStatusDisabled,
+				}, response.Delivery)
+
 				// Check fields
 				assert.Equal(t, tt.requestBody.UserID, response.UserID)
 				assert.Equal(t, tt.requestBody.Title, response.Title)
@@ -500,7 +532,10 @@ func TestNotificationHandler_CreateNotification(t *testing.T) {
 func TestNotificationHandler_UpdateNotification(t *testing.T) {
 	// Setup
 	r, mockUseCase, h := setupNotificationTest(t)
-	r.PUT("/notifications/:id", h.UpdateNotification)
+	r.PUT("/notifications/:id", func(c *gin.Context) {
+		c.Set("user_id", preferenceUserID)
+		c.Next()
+	}, h.UpdateNotification)
 
 	// Test cases
 	tests := []struct {
@@ -528,7 +563,7 @@ func TestNotificationHandler_UpdateNotification(t *testing.T) {
 
 				notification := &model.Notification{
 					ID:                1,
-					UserID:            1,
+					UserID:            "1",
 					Title:             "Old Notification",
 					Message:           "This is an old notification",
 					NotificationType:  "alert",
@@ -539,7 +574,7 @@ func TestNotificationHandler_UpdateNotification(t *testing.T) {
 					CreatedAt:         time.Now(),
 					UpdatedAt:         time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(notification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(notification, nil)
 				mockUseCase.EXPECT().
 					UpdateNotification(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ interface{}, n *model.Notification) error {
@@ -554,7 +589,7 @@ func TestNotificationHandler_UpdateNotification(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedBody: &handler.NotificationResponse{
 				ID:                1,
-				UserID:            1,
+				UserID:            "1",
 				Title:             "Updated Notification",
 				Message:           "This is an updated notification",
 				NotificationType:  "info",
@@ -584,7 +619,7 @@ func TestNotificationHandler_UpdateNotification(t *testing.T) {
 				NotificationType: "info",
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -600,7 +635,7 @@ func TestNotificationHandler_UpdateNotification(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
 				notification := &model.Notification{
 					ID:               1,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "Old Notification",
 					Message:          "This is an old notification",
 					NotificationType: "alert",
@@ -608,7 +643,7 @@ func TestNotificationHandler_UpdateNotification(t *testing.T) {
 					CreatedAt:        time.Now(),
 					UpdatedAt:        time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(notification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(notification, nil)
 				mockUseCase.EXPECT().UpdateNotification(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -657,7 +692,10 @@ func TestNotificationHandler_UpdateNotification(t *testing.T) {
 func TestNotificationHandler_DeleteNotification(t *testing.T) {
 	// Setup
 	r, mockUseCase, h := setupNotificationTest(t)
-	r.DELETE("/notifications/:id", h.DeleteNotification)
+	r.DELETE("/notifications/:id", func(c *gin.Context) {
+		c.Set("user_id", preferenceUserID)
+		c.Next()
+	}, h.DeleteNotification)
 
 	// Test cases
 	tests := []struct {
@@ -672,7 +710,7 @@ func TestNotificationHandler_DeleteNotification(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
 				notification := &model.Notification{
 					ID:               1,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "Test Notification",
 					Message:          "This is a test notification",
 					NotificationType: "alert",
@@ -680,7 +718,7 @@ func TestNotificationHandler_DeleteNotification(t *testing.T) {
 					CreatedAt:        time.Now(),
 					UpdatedAt:        time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(notification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(notification, nil)
 				mockUseCase.EXPECT().DeleteNotification(gomock.Any(), int32(1)).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
@@ -695,7 +733,7 @@ func TestNotificationHandler_DeleteNotification(t *testing.T) {
 			name:           "Not Found",
 			notificationID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -705,7 +743,7 @@ func TestNotificationHandler_DeleteNotification(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
 				notification := &model.Notification{
 					ID:               1,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "Test Notification",
 					Message:          "This is a test notification",
 					NotificationType: "alert",
@@ -713,7 +751,7 @@ func TestNotificationHandler_DeleteNotification(t *testing.T) {
 					CreatedAt:        time.Now(),
 					UpdatedAt:        time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(notification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(notification, nil)
 				mockUseCase.EXPECT().DeleteNotification(gomock.Any(), int32(1)).Return(errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -740,7 +778,10 @@ func TestNotificationHandler_DeleteNotification(t *testing.T) {
 func TestNotificationHandler_MarkAsRead(t *testing.T) {
 	// Setup
 	r, mockUseCase, h := setupNotificationTest(t)
-	r.PUT("/notifications/:id/read", h.MarkAsRead)
+	r.PUT("/notifications/:id/read", func(c *gin.Context) {
+		c.Set("user_id", preferenceUserID)
+		c.Next()
+	}, h.MarkAsRead)
 
 	// Test cases
 	tests := []struct {
@@ -757,7 +798,7 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 				// Initial notification (not read)
 				notification := &model.Notification{
 					ID:               1,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "Test Notification",
 					Message:          "This is a test notification",
 					NotificationType: "alert",
@@ -765,14 +806,14 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 					CreatedAt:        time.Now(),
 					UpdatedAt:        time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(notification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(notification, nil)
 				mockUseCase.EXPECT().MarkAsRead(gomock.Any(), int32(1)).Return(nil)
 
 				// Updated notification (read)
 				readAt := time.Now()
 				updatedNotification := &model.Notification{
 					ID:               1,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "Test Notification",
 					Message:          "This is a test notification",
 					NotificationType: "alert",
@@ -781,12 +822,12 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 					CreatedAt:        notification.CreatedAt,
 					UpdatedAt:        time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(updatedNotification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(updatedNotification, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: &handler.NotificationResponse{
 				ID:               1,
-				UserID:           1,
+				UserID:           "1",
 				Title:            "Test Notification",
 				Message:          "This is a test notification",
 				NotificationType: "alert",
@@ -800,7 +841,7 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 				readAt := time.Now()
 				notification := &model.Notification{
 					ID:               1,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "Test Notification",
 					Message:          "This is a test notification",
 					NotificationType: "alert",
@@ -809,12 +850,12 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 					CreatedAt:        time.Now(),
 					UpdatedAt:        time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(notification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(notification, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: &handler.NotificationResponse{
 				ID:               1,
-				UserID:           1,
+				UserID:           "1",
 				Title:            "Test Notification",
 				Message:          "This is a test notification",
 				NotificationType: "alert",
@@ -832,7 +873,7 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 			name:           "Not Found",
 			notificationID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -843,7 +884,7 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
 				notification := &model.Notification{
 					ID:               1,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "Test Notification",
 					Message:          "This is a test notification",
 					NotificationType: "alert",
@@ -851,7 +892,7 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 					CreatedAt:        time.Now(),
 					UpdatedAt:        time.Now(),
 				}
-				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), int32(1)).Return(notification, nil)
+				mockUseCase.EXPECT().GetNotificationByID(gomock.Any(), preferenceUserID, int32(1)).Return(notification, nil)
 				mockUseCase.EXPECT().MarkAsRead(gomock.Any(), int32(1)).Return(errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -889,3 +930,484 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 }
 
 // strPtr is defined in another test file in the same package
+
+func TestNotificationHandler_StreamMyNotifications(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupNotificationTest(t)
+	r.GET("/me/notifications/stream", func(c *gin.Context) {
+		if userID := c.GetHeader("X-Test-User-ID"); userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	}, h.StreamMyNotifications)
+
+	const userID = "3f2a7c1e-9b4d-4e8a-a6f0-2c5d8e1b7a90"
+
+	// Test cases
+	tests := []struct {
+		name             string
+		userID           string
+		lastEventID      string
+		mockSetup        func(mockUseCase *mockusecase.MockNotificationUseCase, cancel context.CancelFunc)
+		expectedStatus   int
+		expectedEventIDs []string
+	}{
+		{
+			name:           "Unauthorized",
+			mockSetup:      func(mockUseCase *mockusecase.MockNotificationUseCase, cancel context.CancelFunc) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "Invalid Last-Event-ID",
+			userID:      userID,
+			lastEventID: "abc",
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase, cancel context.CancelFunc) {
+				mockUseCase.EXPECT().SubscribeNotifications(userID).Return(make(<-chan struct{}), func() {})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Resume From Last-Event-ID Then Push",
+			userID:      userID,
+			lastEventID: "10",
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase, cancel context.CancelFunc) {
+				signals := make(chan struct{}, 1)
+				signals <- struct{}{}
+				mockUseCase.EXPECT().SubscribeNotifications(userID).Return((<-chan struct{})(signals), func() {})
+				gomock.InOrder(
+					mockUseCase.EXPECT().ListNotificationsAfter(gomock.Any(), userID, int32(10)).Return([]*model.Notification{
+						{ID: 11, UserID: userID, Title: "査定完了", NotificationType: "assessment"},
+						{ID: 12, UserID: userID, Title: "申請承認", NotificationType: "application"},
+					}, nil),
+					mockUseCase.EXPECT().ListNotificationsAfter(gomock.Any(), userID, int32(12)).
+						DoAndReturn(func(context.Context, string, int32) ([]*model.Notification, error) {
+							cancel()
+							return []*model.Notification{
+								{ID: 13, UserID: userID, Title: "災害情報更新", NotificationType: "disaster_update"},
+							}, nil
+						}),
+				)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedEventIDs: []string{"11", "12", "13"},
+		},
+		{
+			name:   "Start From Latest Without Last-Event-ID",
+			userID: userID,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase, cancel context.CancelFunc) {
+				mockUseCase.EXPECT().SubscribeNotifications(userID).Return(make(<-chan struct{}), func() {})
+				mockUseCase.EXPECT().LatestNotificationID(gomock.Any(), userID).Return(int32(20), nil)
+				mockUseCase.EXPECT().ListNotificationsAfter(gomock.Any(), userID, int32(20)).
+					DoAndReturn(func(context.Context, string, int32) ([]*model.Notification, error) {
+						cancel()
+						return nil, nil
+					})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedEventIDs: []string{},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			tt.mockSetup(mockUseCase, cancel)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/me/notifications/stream", nil)
+			if tt.userID != "" {
+				req.Header.Set("X-Test-User-ID", tt.userID)
+			}
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedEventIDs != nil {
+				assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+
+				eventIDs := []string{}
+				for _, line := range strings.Split(w.Body.String(), "\n") {
+					if id, ok := strings.CutPrefix(line, "id: "); ok {
+						eventIDs = append(eventIDs, id)
+					}
+				}
+				assert.Equal(t, tt.expectedEventIDs, eventIDs)
+			}
+		})
+	}
+}
+
+func TestNotificationHandler_BroadcastNotification(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupNotificationTest(t)
+	r.POST("/notifications/broadcast", func(c *gin.Context) {
+		c.Set("user_id", preferenceUserID)
+		c.Next()
+	}, h.BroadcastNotification)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockSetup      func(mockUseCase *mockusecase.MockNotificationUseCase)
+		expectedStatus int
+		expectedBody   *handler.BroadcastNotificationResponse
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"title":"大雨警報","message":"被害状況を報告してください","notification_type":"災害情報","target_type":"organization","target_id":"3"}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().BroadcastNotification(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, b *model.BroadcastNotification) error {
+						assert.Equal(t, "organization", b.TargetType)
+						assert.Equal(t, "3", *b.TargetID)
+						assert.Equal(t, preferenceUserID, *b.CreatedBy)
+						b.ID = 7
+						b.RecipientCount = 42
+						return nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: &handler.BroadcastNotificationResponse{
+				ID:                      7,
+				Title:                   "大雨警報",
+				Message:                 "被害状況を報告してください",
+				NotificationType:        "災害情報",
+				TargetType:              "organization",
+				TargetID:                strPtr("3"),
+				EstimatedRecipientCount: 42,
+				CreatedBy:               strPtr(preferenceUserID),
+			},
+		},
+		{
+			name:           "Invalid Target Type",
+			requestBody:    `{"title":"お知らせ","message":"本文","notification_type":"システム","target_type":"city"}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockNotificationUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Target Not Found",
+			requestBody: `{"title":"お知らせ","message":"本文","notification_type":"システム","target_type":"role","target_id":"99"}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().BroadcastNotification(gomock.Any(), gomock.Any()).
+					Return(myerrors.NewValidationError(myerrors.FieldError{Field: "target_id", Message: "not found"}))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Error",
+			requestBody: `{"title":"お知らせ","message":"本文","notification_type":"システム","target_type":"all"}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().BroadcastNotification(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/notifications/broadcast", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response handler.BroadcastNotificationResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, &response)
+			}
+		})
+	}
+}
+
+func TestNotificationHandler_MarkAllMyNotificationsAsRead(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupNotificationTest(t)
+	r.POST("/me/notifications/read-all", func(c *gin.Context) {
+		c.Set("user_id", preferenceUserID)
+		c.Next()
+	}, h.MarkAllMyNotificationsAsRead)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockSetup      func(mockUseCase *mockusecase.MockNotificationUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success Without Body",
+			requestBody: "",
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().MarkAllAsRead(gomock.Any(), preferenceUserID, &model.NotificationFilter{}).Return(int64(4), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"updated_count":4}`,
+		},
+		{
+			name:        "Success With Filter",
+			requestBody: `{"notification_type":"査定","before":"2026-04-01T00:00:00Z"}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().MarkAllAsRead(gomock.Any(), preferenceUserID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, filter *model.NotificationFilter) (int64, error) {
+						assert.Equal(t, "査定", *filter.NotificationType)
+						assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), *filter.Before)
+						return 1, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"updated_count":1}`,
+		},
+		{
+			name:           "Invalid Before",
+			requestBody:    `{"before":"yesterday"}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockNotificationUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Error",
+			requestBody: `{}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().MarkAllAsRead(gomock.Any(), preferenceUserID, gomock.Any()).Return(int64(0), errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/me/notifications/read-all", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNotificationHandler_DeleteMyNotifications(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupNotificationTest(t)
+	r.POST("/me/notifications/bulk-delete", func(c *gin.Context) {
+		c.Set("user_id", preferenceUserID)
+		c.Next()
+	}, h.DeleteMyNotifications)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockSetup      func(mockUseCase *mockusecase.MockNotificationUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"ids":[1,2,3]}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().DeleteNotifications(gomock.Any(), preferenceUserID, &model.NotificationFilter{IDs: []int32{1, 2, 3}}).Return(int64(3), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"deleted_count":3}`,
+		},
+		{
+			name:        "All",
+			requestBody: `{"all":true}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().DeleteNotifications(gomock.Any(), preferenceUserID, &model.NotificationFilter{All: true}).Return(int64(5), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"deleted_count":5}`,
+		},
+		{
+			name:        "No Condition",
+			requestBody: `{}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().DeleteNotifications(gomock.Any(), preferenceUserID, gomock.Any()).
+					Return(int64(0), myerrors.NewValidationError(myerrors.FieldError{Field: "ids", Message: "required"}))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid ID",
+			requestBody:    `{"ids":[0]}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockNotificationUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/me/notifications/bulk-delete", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNotificationHandler_CountMyUnreadNotifications(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupNotificationTest(t)
+	r.GET("/me/notifications/unread-count", func(c *gin.Context) {
+		if userID := c.GetHeader("X-Test-User-ID"); userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	}, h.CountMyUnreadNotifications)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		userID         string
+		mockSetup      func(mockUseCase *mockusecase.MockNotificationUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "Success",
+			userID: preferenceUserID,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().CountUnread(gomock.Any(), preferenceUserID).Return(map[string]int64{"災害情報": 3, "査定": 2, "システム": 0}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"total":5,"by_type":{"災害情報":3,"査定":2,"システム":0}}`,
+		},
+		{
+			name:           "Unauthorized",
+			mockSetup:      func(mockUseCase *mockusecase.MockNotificationUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Error",
+			userID: preferenceUserID,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().CountUnread(gomock.Any(), preferenceUserID).Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/me/notifications/unread-count", nil)
+			if tt.userID != "" {
+				req.Header.Set("X-Test-User-ID", tt.userID)
+			}
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNotificationHandler_RelatedEntity(t *testing.T) {
+	// Setup
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
+	ctrl := gomock.NewController(t)
+	mockUseCase := mockusecase.NewMockNotificationUseCase(ctrl)
+	h := handler.NewNotificationHandler(logger.New(logger.DefaultConfig()), mockUseCase)
+	r.GET("/notifications", h.ListNotifications)
+
+	disasterID := "6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"
+	disasterRef := model.RelatedEntityRef{Type: model.RelatedEntityDisaster, ID: disasterID}
+	applicationRef := model.RelatedEntityRef{Type: model.RelatedEntitySupportApplication, ID: "A999"}
+	notifications := []*model.Notification{
+		{ID: 1, Title: "災害ステータス更新", RelatedEntityType: strPtr("災害"), RelatedEntityID: strPtr(disasterID)},
+		{ID: 2, Title: "申請が承認されました", RelatedEntityType: strPtr("支援申請"), RelatedEntityID: strPtr("A999")},
+		{ID: 3, Title: "研修会のお知らせ", RelatedEntityType: strPtr("イベント"), RelatedEntityID: strPtr("training-2025-06")},
+	}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mockUseCase *mockusecase.MockNotificationUseCase)
+		expectedLinks []*handler.RelatedEntityResponse
+	}{
+		{
+			name: "Resolved And Deleted",
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().ListNotifications(gomock.Any()).Return(notifications, nil)
+				mockUseCase.EXPECT().ResolveRelatedEntities(gomock.Any(), notifications).Return(map[model.RelatedEntityRef]*model.RelatedEntityLink{
+					disasterRef: {
+						Type: disasterRef.Type, ID: disasterID, Path: "/api/v1/disasters/" + disasterID,
+						Label: "2025年関東地方大雨被害", Status: "対応中",
+					},
+					applicationRef: {Type: applicationRef.Type, ID: "A999", Deleted: true},
+				}, nil)
+			},
+			expectedLinks: []*handler.RelatedEntityResponse{
+				{Type: "災害", ID: disasterID, Path: "/api/v1/disasters/" + disasterID, Label: "2025年関東地方大雨被害", Status: "対応中"},
+				{Type: "支援申請", ID: "A999", Deleted: true},
+				nil,
+			},
+		},
+		{
+			name: "Resolve Error Keeps Notifications",
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationUseCase) {
+				mockUseCase.EXPECT().ListNotifications(gomock.Any()).Return(notifications, nil)
+				mockUseCase.EXPECT().ResolveRelatedEntities(gomock.Any(), notifications).Return(nil, errors.New("database error"))
+			},
+			expectedLinks: []*handler.RelatedEntityResponse{nil, nil, nil},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/notifications", nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response []*handler.NotificationResponse
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			if assert.Len(t, response, len(tt.expectedLinks)) {
+				for i, expected := range tt.expectedLinks {
+					assert.Equal(t, expected, response[i].RelatedEntity)
+				}
+			}
+		})
+	}
+}
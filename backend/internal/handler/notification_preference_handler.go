@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+)
+
+type NotificationPreference interface {
+	ListMyNotificationPreferences(c *gin.Context)
+	UpdateMyNotificationPreferences(c *gin.Context)
+}
+
+type notificationPreferenceHandler struct {
+	l                             *logger.Logger
+	notificationPreferenceUseCase usecase.NotificationPreferenceUseCase
+}
+
+func NewNotificationPreferenceHandler(
+	l *logger.Logger,
+	notificationPreferenceUseCase usecase.NotificationPreferenceUseCase,
+) NotificationPreference {
+	return &notificationPreferenceHandler{
+		l:                             l,
+		notificationPreferenceUseCase: notificationPreferenceUseCase,
+	}
+}
+
+type NotificationPreferenceResponse struct {
+	NotificationType string  `json:"notification_type"`
+	InApp            bool    `json:"in_app"`
+	Email            bool    `json:"email"`
+	EmailFrequency   string  `json:"email_frequency"`
+	Webhook          bool    `json:"webhook"`
+	WebhookURL       *string `json:"webhook_url,omitempty"`
+}
+
+type ListNotificationPreferencesResponse struct {
+	Preferences []*NotificationPreferenceResponse `json:"preferences"`
+}
+
+type NotificationPreferenceRequest struct {
+	NotificationType string  `json:"notification_type" binding:"required"`
+	InApp            bool    `json:"in_app"`
+	Email            bool    `json:"email"`
+	EmailFrequency   string  `json:"email_frequency" binding:"omitempty,oneof=immediate daily_digest"`
+	Webhook          bool    `json:"webhook"`
+	WebhookURL       *string `json:"webhook_url,omitempty" binding:"omitempty,max=2048"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []*NotificationPreferenceRequest `json:"preferences" binding:"required,min=1,dive"`
+}
+
+// ListMyNotificationPreferences 通知配信設定取得
+func (h *notificationPreferenceHandler) ListMyNotificationPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		_ = c.Error(newUnauthorizedError())
+		return
+	}
+
+	preferences, err := h.notificationPreferenceUseCase.ListPreferences(ctx, userID)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to list notification preferences", "user_id", userID)
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toListNotificationPreferencesResponse(preferences))
+}
+
+// UpdateMyNotificationPreferences 通知配信設定更新
+func (h *notificationPreferenceHandler) UpdateMyNotificationPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		_ = c.Error(newUnauthorizedError())
+		return
+	}
+
+	var req UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
+		return
+	}
+
+	preferences := make([]*model.NotificationPreference, 0, len(req.Preferences))
+	for _, p := range req.Preferences {
+		preferences = append(preferences, &model.NotificationPreference{
+			NotificationType: p.NotificationType,
+			InApp:            p.InApp,
+			Email:            p.Email,
+			EmailFrequency:   p.EmailFrequency,
+			Webhook:          p.Webhook,
+			WebhookURL:       p.WebhookURL,
+		})
+	}
+
+	updated, err := h.notificationPreferenceUseCase.UpdatePreferences(ctx, userID, preferences)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to update notification preferences", "user_id", userID)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully updated notification preferences", "user_id", userID, "count", len(preferences))
+	c.JSON(http.StatusOK, toListNotificationPreferencesResponse(updated))
+}
+
+func toListNotificationPreferencesResponse(preferences []*model.NotificationPreference) *ListNotificationPreferencesResponse {
+	res := &ListNotificationPreferencesResponse{
+		Preferences: make([]*NotificationPreferenceResponse, 0, len(preferences)),
+	}
+	for _, p := range preferences {
+		res.Preferences = append(res.Preferences, &NotificationPreferenceResponse{
+			NotificationType: p.NotificationType,
+			InApp:            p.InApp,
+			Email:            p.Email,
+			EmailFrequency:   p.EmailFrequency,
+			Webhook:          p.Webhook,
+			WebhookURL:       p.WebhookURL,
+		})
+	}
+
+	return res
+}
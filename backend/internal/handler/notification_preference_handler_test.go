@@ -0,0 +1,174 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/handler"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
+)
+
+const preferenceUserID = "5d1e8a3c-2b7f-4c9e-8a61-0f3b2d4c6e81"
+
+func setupNotificationPreferenceTest(t *testing.T) (*gin.Engine, *mockusecase.MockNotificationPreferenceUseCase, handler.NotificationPreference) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
+	r.Use(func(c *gin.Context) {
+		if userID := c.GetHeader("X-Test-User-ID"); userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	})
+	ctrl := gomock.NewController(t)
+	mockUseCase := mockusecase.NewMockNotificationPreferenceUseCase(ctrl)
+	l := logger.New(logger.DefaultConfig())
+	h := handler.NewNotificationPreferenceHandler(l, mockUseCase)
+	return r, mockUseCase, h
+}
+
+func TestNotificationPreferenceHandler_ListMyNotificationPreferences(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupNotificationPreferenceTest(t)
+	r.GET("/me/notification-preferences", h.ListMyNotificationPreferences)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		userID         string
+		mockSetup      func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase)
+		expectedStatus int
+		expectedBody   *handler.ListNotificationPreferencesResponse
+	}{
+		{
+			name:   "Success",
+			userID: preferenceUserID,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase) {
+				mockUseCase.EXPECT().ListPreferences(gomock.Any(), preferenceUserID).Return([]*model.NotificationPreference{
+					{NotificationType: model.NotificationTypeSystem, InApp: true, EmailFrequency: model.EmailFrequencyImmediate},
+					{NotificationType: model.NotificationTypeAssessment, Email: true, EmailFrequency: model.EmailFrequencyDailyDigest},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &handler.ListNotificationPreferencesResponse{
+				Preferences: []*handler.NotificationPreferenceResponse{
+					{NotificationType: "システム", InApp: true, EmailFrequency: "immediate"},
+					{NotificationType: "査定", Email: true, EmailFrequency: "daily_digest"},
+				},
+			},
+		},
+		{
+			name:           "Unauthorized",
+			mockSetup:      func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Error",
+			userID: preferenceUserID,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase) {
+				mockUseCase.EXPECT().ListPreferences(gomock.Any(), preferenceUserID).Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/me/notification-preferences", nil)
+			if tt.userID != "" {
+				req.Header.Set("X-Test-User-ID", tt.userID)
+			}
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response handler.ListNotificationPreferencesResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, &response)
+			}
+		})
+	}
+}
+
+func TestNotificationPreferenceHandler_UpdateMyNotificationPreferences(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupNotificationPreferenceTest(t)
+	r.PUT("/me/notification-preferences", h.UpdateMyNotificationPreferences)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockSetup      func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase)
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"preferences":[{"notification_type":"査定","in_app":true,"email":true,"email_frequency":"daily_digest"}]}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase) {
+				mockUseCase.EXPECT().UpdatePreferences(gomock.Any(), preferenceUserID, gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ string, preferences []*model.NotificationPreference) ([]*model.NotificationPreference, error) {
+						assert.Equal(t, []*model.NotificationPreference{
+							{NotificationType: "査定", InApp: true, Email: true, EmailFrequency: "daily_digest"},
+						}, preferences)
+						return preferences, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Frequency",
+			requestBody:    `{"preferences":[{"notification_type":"査定","email":true,"email_frequency":"weekly"}]}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Empty Preferences",
+			requestBody:    `{"preferences":[]}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Validation Error From Use Case",
+			requestBody: `{"preferences":[{"notification_type":"イベント","in_app":true}]}`,
+			mockSetup: func(mockUseCase *mockusecase.MockNotificationPreferenceUseCase) {
+				mockUseCase.EXPECT().UpdatePreferences(gomock.Any(), preferenceUserID, gomock.Any()).
+					Return(nil, myerrors.NewValidationError(myerrors.FieldError{Field: "preferences[0].notification_type", Message: "invalid"}))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPut, "/me/notification-preferences", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Test-User-ID", preferenceUserID)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
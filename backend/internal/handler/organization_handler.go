@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 )
@@ -63,25 +64,18 @@ type UpdateOrganizationRequest struct {
 	Description  *string `json:"description"`
 }
 
-// ListOrganizations @title 組織一覧取得
-// @id ListOrganizations
-// @tags organization
-// @accept json
-// @produce json
-// @Summary 組織一覧取得
-// @Success 200 {array} OrganizationResponse
-// @Router /organizations [get]
+// ListOrganizations 組織一覧取得
 func (h *organizationHandler) ListOrganizations(c *gin.Context) {
 	ctx := c.Request.Context()
 	organizations, err := h.organizationUseCase.ListOrganizations(ctx)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to list organizations")
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var response []*OrganizationResponse
+	response := make([]*OrganizationResponse, 0, len(organizations))
 
 	for _, organization := range organizations {
 		resp := &OrganizationResponse{
@@ -100,16 +94,7 @@ func (h *organizationHandler) ListOrganizations(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// GetOrganization @title 組織詳細取得
-// @id GetOrganization
-// @tags organization
-// @accept json
-// @produce json
-// @Param id path int true "組織ID"
-// @Summary 組織詳細取得
-// @Success 200 {object} OrganizationResponse
-// @Failure 404 {object} map[string]string
-// @Router /organizations/{id} [get]
+// GetOrganization 組織詳細取得
 func (h *organizationHandler) GetOrganization(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -117,7 +102,7 @@ func (h *organizationHandler) GetOrganization(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid organization ID", "organization_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid organization ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
@@ -125,13 +110,13 @@ func (h *organizationHandler) GetOrganization(c *gin.Context) {
 	organization, err := h.organizationUseCase.GetOrganizationByID(ctx, id)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Organization not found", "organization_id", id)
-		c.JSON(404, gin.H{"error": "Organization not found"})
+		_ = c.Error(err)
 
 		return
 	}
 
 	// Map users to UserResponse
-	var users []UserResponse
+	users := make([]UserResponse, 0, len(organization.Users))
 	for _, user := range organization.Users {
 		users = append(users, UserResponse{
 			ID:        user.ID,
@@ -153,23 +138,15 @@ func (h *organizationHandler) GetOrganization(c *gin.Context) {
 	}
 
 	h.l.InfoContext(ctx, "Successfully retrieved organization", "organization_id", id)
+	setETag(c, organization.UpdatedAt)
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateOrganization @title 組織作成
-// @id CreateOrganization
-// @tags organization
-// @accept json
-// @produce json
-// @Param request body CreateOrganizationRequest true "組織作成リクエスト"
-// @Summary 組織作成
-// @Success 201 {object} OrganizationResponse
-// @Failure 400 {object} map[string]string
-// @Router /organizations [post]
+// CreateOrganization 組織作成
 func (h *organizationHandler) CreateOrganization(c *gin.Context) {
 	var req CreateOrganizationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
@@ -182,7 +159,7 @@ func (h *organizationHandler) CreateOrganization(c *gin.Context) {
 	err := h.organizationUseCase.CreateOrganization(c.Request.Context(), organization)
 	if err != nil {
 		h.l.ErrorContext(c.Request.Context(), err, "Failed to create organization")
-		c.JSON(500, gin.H{"error": "Failed to create organization"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -200,18 +177,7 @@ func (h *organizationHandler) CreateOrganization(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
-// UpdateOrganization @title 組織更新
-// @id UpdateOrganization
-// @tags organization
-// @accept json
-// @produce json
-// @Param id path int true "組織ID"
-// @Param request body UpdateOrganizationRequest true "組織更新リクエスト"
-// @Summary 組織更新
-// @Success 200 {object} OrganizationResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /organizations/{id} [put]
+// UpdateOrganization 組織更新
 func (h *organizationHandler) UpdateOrganization(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -219,15 +185,21 @@ func (h *organizationHandler) UpdateOrganization(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid organization ID", "organization_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid organization ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
 
+	expectedUpdatedAt, err := parseIfMatch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
 	var req UpdateOrganizationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid request body")
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 
 		return
 	}
@@ -236,7 +208,7 @@ func (h *organizationHandler) UpdateOrganization(c *gin.Context) {
 	existingOrganization, err := h.organizationUseCase.GetOrganizationByID(ctx, id)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Organization not found", "organization_id", id)
-		c.JSON(404, gin.H{"error": "Organization not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -246,38 +218,29 @@ func (h *organizationHandler) UpdateOrganization(c *gin.Context) {
 	existingOrganization.Type = req.Type
 	existingOrganization.ParentID = req.ParentID
 
-	err = h.organizationUseCase.UpdateOrganization(ctx, existingOrganization)
+	updatedOrganization, err := h.organizationUseCase.UpdateOrganization(ctx, existingOrganization, expectedUpdatedAt)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to update organization", "organization_id", id)
-		c.JSON(500, gin.H{"error": "Failed to update organization"})
+		_ = c.Error(err)
 
 		return
 	}
 
 	response := &OrganizationResponse{
-		ID:        existingOrganization.ID,
-		Name:      existingOrganization.Name,
-		Type:      existingOrganization.Type,
-		ParentID:  existingOrganization.ParentID,
-		CreatedAt: existingOrganization.CreatedAt,
-		UpdatedAt: existingOrganization.UpdatedAt,
+		ID:        updatedOrganization.ID,
+		Name:      updatedOrganization.Name,
+		Type:      updatedOrganization.Type,
+		ParentID:  updatedOrganization.ParentID,
+		CreatedAt: updatedOrganization.CreatedAt,
+		UpdatedAt: updatedOrganization.UpdatedAt,
 	}
 
 	h.l.InfoContext(ctx, "Successfully updated organization", "organization_id", id)
+	setETag(c, updatedOrganization.UpdatedAt)
 	c.JSON(http.StatusOK, response)
 }
 
-// DeleteOrganization @title 組織削除
-// @id DeleteOrganization
-// @tags organization
-// @accept json
-// @produce json
-// @Param id path int true "組織ID"
-// @Summary 組織削除
-// @Success 204 "No Content"
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /organizations/{id} [delete]
+// DeleteOrganization 組織削除
 func (h *organizationHandler) DeleteOrganization(c *gin.Context) {
 	idStr := c.Param("id")
 	ctx := c.Request.Context()
@@ -285,7 +248,7 @@ func (h *organizationHandler) DeleteOrganization(c *gin.Context) {
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Invalid organization ID", "organization_id_str", idStr)
-		c.JSON(400, gin.H{"error": "Invalid organization ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
@@ -294,7 +257,7 @@ func (h *organizationHandler) DeleteOrganization(c *gin.Context) {
 	_, err = h.organizationUseCase.GetOrganizationByID(ctx, id)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Organization not found", "organization_id", id)
-		c.JSON(404, gin.H{"error": "Organization not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -302,7 +265,7 @@ func (h *organizationHandler) DeleteOrganization(c *gin.Context) {
 	err = h.organizationUseCase.DeleteOrganization(ctx, id)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to delete organization", "organization_id", id)
-		c.JSON(500, gin.H{"error": "Failed to delete organization"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -12,16 +12,19 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
 	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
 func setupOrganizationTest(t *testing.T) (*gin.Engine, *mockusecase.MockOrganizationUseCase, handler.Organization) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
 	ctrl := gomock.NewController(t)
 	mockUseCase := mockusecase.NewMockOrganizationUseCase(ctrl)
 	l := logger.New(logger.DefaultConfig())
@@ -44,20 +47,16 @@ func TestOrganizationHandler_ListOrganizations(t *testing.T) {
 		{
 			name: "Success",
 			mockSetup: func(mockUseCase *mockusecase.MockOrganizationUseCase) {
-				prefectureID := int32(1)
 				parentID := int32(2)
-				description := "Test Organization Description"
 
 				organizations := []*model.Organization{
 					{
-						ID:           1,
-						Name:         "Test Organization 1",
-						Type:         "government",
-						PrefectureID: &prefectureID,
-						ParentID:     &parentID,
-						Description:  &description,
-						CreatedAt:    time.Now(),
-						UpdatedAt:    time.Now(),
+						ID:        1,
+						Name:      "Test Organization 1",
+						Type:      "government",
+						ParentID:  &parentID,
+						CreatedAt: time.Now(),
+						UpdatedAt: time.Now(),
 					},
 					{
 						ID:        2,
@@ -72,12 +71,10 @@ func TestOrganizationHandler_ListOrganizations(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedBody: []*handler.OrganizationResponse{
 				{
-					ID:           1,
-					Name:         "Test Organization 1",
-					Type:         "government",
-					PrefectureID: int32Ptr(1),
-					ParentID:     int32Ptr(2),
-					Description:  strPtr("Test Organization Description"),
+					ID:       1,
+					Name:     "Test Organization 1",
+					Type:     "government",
+					ParentID: int32Ptr(2),
 				},
 				{
 					ID:   2,
@@ -122,15 +119,9 @@ func TestOrganizationHandler_ListOrganizations(t *testing.T) {
 					assert.Equal(t, expected.Type, response[i].Type)
 
 					// Check optional fields only if they exist in the expected response
-					if expected.PrefectureID != nil {
-						assert.Equal(t, *expected.PrefectureID, *response[i].PrefectureID)
-					}
 					if expected.ParentID != nil {
 						assert.Equal(t, *expected.ParentID, *response[i].ParentID)
 					}
-					if expected.Description != nil {
-						assert.Equal(t, *expected.Description, *response[i].Description)
-					}
 				}
 			}
 		})
@@ -154,22 +145,18 @@ func TestOrganizationHandler_GetOrganization(t *testing.T) {
 			name:           "Success",
 			organizationID: "1",
 			mockSetup: func(mockUseCase *mockusecase.MockOrganizationUseCase) {
-				prefectureID := int32(1)
 				parentID := int32(2)
-				description := "Test Organization Description"
 
 				organization := &model.Organization{
-					ID:           1,
-					Name:         "Test Organization",
-					Type:         "government",
-					PrefectureID: &prefectureID,
-					ParentID:     &parentID,
-					Description:  &description,
-					CreatedAt:    time.Now(),
-					UpdatedAt:    time.Now(),
+					ID:        1,
+					Name:      "Test Organization",
+					Type:      "government",
+					ParentID:  &parentID,
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
 					Users: []model.User{
 						{
-							ID:        1,
+							ID:        "1",
 							Name:      "Test User",
 							Email:     "test@example.com",
 							CreatedAt: timePtr(time.Now()),
@@ -177,19 +164,17 @@ func TestOrganizationHandler_GetOrganization(t *testing.T) {
 						},
 					},
 				}
-				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int32(1)).Return(organization, nil)
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(1)).Return(organization, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: &handler.OrganizationResponse{
-				ID:           1,
-				Name:         "Test Organization",
-				Type:         "government",
-				PrefectureID: int32Ptr(1),
-				ParentID:     int32Ptr(2),
-				Description:  strPtr("Test Organization Description"),
+				ID:       1,
+				Name:     "Test Organization",
+				Type:     "government",
+				ParentID: int32Ptr(2),
 				Users: []handler.UserResponse{
 					{
-						ID:        1,
+						ID:        "1",
 						Name:      "Test User",
 						Email:     "test@example.com",
 						CreatedAt: nil,
@@ -209,7 +194,7 @@ func TestOrganizationHandler_GetOrganization(t *testing.T) {
 			name:           "Not Found",
 			organizationID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockOrganizationUseCase) {
-				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -239,15 +224,9 @@ func TestOrganizationHandler_GetOrganization(t *testing.T) {
 				assert.Equal(t, tt.expectedBody.Type, response.Type)
 
 				// Check optional fields only if they exist in the expected response
-				if tt.expectedBody.PrefectureID != nil {
-					assert.Equal(t, *tt.expectedBody.PrefectureID, *response.PrefectureID)
-				}
 				if tt.expectedBody.ParentID != nil {
 					assert.Equal(t, *tt.expectedBody.ParentID, *response.ParentID)
 				}
-				if tt.expectedBody.Description != nil {
-					assert.Equal(t, *tt.expectedBody.Description, *response.Description)
-				}
 
 				// Check users
 				if len(tt.expectedBody.Users) > 0 {
@@ -279,11 +258,9 @@ func TestOrganizationHandler_CreateOrganization(t *testing.T) {
 		{
 			name: "Success",
 			requestBody: handler.CreateOrganizationRequest{
-				Name:         "New Organization",
-				Type:         "government",
-				PrefectureID: int32Ptr(1),
-				ParentID:     int32Ptr(2),
-				Description:  strPtr("New Organization Description"),
+				Name:     "New Organization",
+				Type:     "government",
+				ParentID: int32Ptr(2),
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockOrganizationUseCase) {
 				mockUseCase.EXPECT().
@@ -297,12 +274,10 @@ func TestOrganizationHandler_CreateOrganization(t *testing.T) {
 			},
 			expectedStatus: http.StatusCreated,
 			expectedBody: &handler.OrganizationResponse{
-				ID:           1,
-				Name:         "New Organization",
-				Type:         "government",
-				PrefectureID: int32Ptr(1),
-				ParentID:     int32Ptr(2),
-				Description:  strPtr("New Organization Description"),
+				ID:       1,
+				Name:     "New Organization",
+				Type:     "government",
+				ParentID: int32Ptr(2),
 			},
 		},
 		{
@@ -359,15 +334,9 @@ func TestOrganizationHandler_CreateOrganization(t *testing.T) {
 				assert.Equal(t, tt.requestBody.Type, response.Type)
 
 				// Check optional fields only if they exist in the expected response
-				if tt.requestBody.PrefectureID != nil {
-					assert.Equal(t, *tt.requestBody.PrefectureID, *response.PrefectureID)
-				}
 				if tt.requestBody.ParentID != nil {
 					assert.Equal(t, *tt.requestBody.ParentID, *response.ParentID)
 				}
-				if tt.requestBody.Description != nil {
-					assert.Equal(t, *tt.requestBody.Description, *response.Description)
-				}
 			}
 		})
 	}
@@ -378,10 +347,13 @@ func TestOrganizationHandler_UpdateOrganization(t *testing.T) {
 	r, mockUseCase, h := setupOrganizationTest(t)
 	r.PUT("/organizations/:id", h.UpdateOrganization)
 
+	version := time.Date(2024, 1, 1, 9, 0, 0, 123456000, time.UTC)
+
 	// Test cases
 	tests := []struct {
 		name           string
 		organizationID string
+		ifMatch        string
 		requestBody    handler.UpdateOrganizationRequest
 		mockSetup      func(mockUseCase *mockusecase.MockOrganizationUseCase)
 		expectedStatus int
@@ -390,53 +362,47 @@ func TestOrganizationHandler_UpdateOrganization(t *testing.T) {
 		{
 			name:           "Success",
 			organizationID: "1",
+			ifMatch:        etagFor(version),
 			requestBody: handler.UpdateOrganizationRequest{
-				Name:         "Updated Organization",
-				Type:         "ngo",
-				PrefectureID: int32Ptr(3),
-				ParentID:     int32Ptr(4),
-				Description:  strPtr("Updated Organization Description"),
+				Name:     "Updated Organization",
+				Type:     "ngo",
+				ParentID: int32Ptr(4),
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockOrganizationUseCase) {
-				oldPrefectureID := int32(1)
 				oldParentID := int32(2)
-				oldDescription := "Old Organization Description"
 
 				organization := &model.Organization{
-					ID:           1,
-					Name:         "Old Organization",
-					Type:         "government",
-					PrefectureID: &oldPrefectureID,
-					ParentID:     &oldParentID,
-					Description:  &oldDescription,
-					CreatedAt:    time.Now(),
-					UpdatedAt:    time.Now(),
+					ID:        1,
+					Name:      "Old Organization",
+					Type:      "government",
+					ParentID:  &oldParentID,
+					CreatedAt: time.Now(),
+					UpdatedAt: version,
 				}
-				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int32(1)).Return(organization, nil)
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(1)).Return(organization, nil)
 				mockUseCase.EXPECT().
-					UpdateOrganization(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, o *model.Organization) error {
+					UpdateOrganization(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, o *model.Organization, expected time.Time) (*model.Organization, error) {
+						assert.True(t, version.Equal(expected))
 						assert.Equal(t, "Updated Organization", o.Name)
 						assert.Equal(t, "ngo", o.Type)
-						assert.Equal(t, int32(3), *o.PrefectureID)
 						assert.Equal(t, int32(4), *o.ParentID)
-						assert.Equal(t, "Updated Organization Description", *o.Description)
-						return nil
+						o.UpdatedAt = version.Add(time.Second)
+						return o, nil
 					})
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: &handler.OrganizationResponse{
-				ID:           1,
-				Name:         "Updated Organization",
-				Type:         "ngo",
-				PrefectureID: int32Ptr(3),
-				ParentID:     int32Ptr(4),
-				Description:  strPtr("Updated Organization Description"),
+				ID:       1,
+				Name:     "Updated Organization",
+				Type:     "ngo",
+				ParentID: int32Ptr(4),
 			},
 		},
 		{
 			name:           "Invalid ID",
 			organizationID: "invalid",
+			ifMatch:        etagFor(version),
 			requestBody: handler.UpdateOrganizationRequest{
 				Name: "Updated Organization",
 				Type: "ngo",
@@ -448,19 +414,66 @@ func TestOrganizationHandler_UpdateOrganization(t *testing.T) {
 		{
 			name:           "Not Found",
 			organizationID: "999",
+			ifMatch:        etagFor(version),
 			requestBody: handler.UpdateOrganizationRequest{
 				Name: "Updated Organization",
 				Type: "ngo",
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockOrganizationUseCase) {
-				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
 		},
+		{
+			name:           "Missing If-Match",
+			organizationID: "1",
+			requestBody: handler.UpdateOrganizationRequest{
+				Name: "Updated Organization",
+				Type: "ngo",
+			},
+			mockSetup:      func(mockUseCase *mockusecase.MockOrganizationUseCase) {},
+			expectedStatus: http.StatusPreconditionRequired,
+			expectedBody:   nil,
+		},
+		{
+			name:           "Malformed If-Match",
+			organizationID: "1",
+			ifMatch:        `W/"abc"`,
+			requestBody: handler.UpdateOrganizationRequest{
+				Name: "Updated Organization",
+				Type: "ngo",
+			},
+			mockSetup:      func(mockUseCase *mockusecase.MockOrganizationUseCase) {},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedBody:   nil,
+		},
+		{
+			name:           "Version Conflict",
+			organizationID: "1",
+			ifMatch:        etagFor(version),
+			requestBody: handler.UpdateOrganizationRequest{
+				Name: "Updated Organization",
+				Type: "ngo",
+			},
+			mockSetup: func(mockUseCase *mockusecase.MockOrganizationUseCase) {
+				organization := &model.Organization{
+					ID:        1,
+					Name:      "Old Organization",
+					Type:      "government",
+					CreatedAt: time.Now(),
+					UpdatedAt: version.Add(time.Minute),
+				}
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(1)).Return(organization, nil)
+				mockUseCase.EXPECT().UpdateOrganization(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, newPreconditionFailedError())
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedBody:   nil,
+		},
 		{
 			name:           "Database Error",
 			organizationID: "1",
+			ifMatch:        etagFor(version),
 			requestBody: handler.UpdateOrganizationRequest{
 				Name: "Updated Organization",
 				Type: "ngo",
@@ -473,8 +486,8 @@ func TestOrganizationHandler_UpdateOrganization(t *testing.T) {
 					CreatedAt: time.Now(),
 					UpdatedAt: time.Now(),
 				}
-				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int32(1)).Return(organization, nil)
-				mockUseCase.EXPECT().UpdateOrganization(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(1)).Return(organization, nil)
+				mockUseCase.EXPECT().UpdateOrganization(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   nil,
@@ -494,12 +507,17 @@ func TestOrganizationHandler_UpdateOrganization(t *testing.T) {
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest(http.MethodPut, "/organizations/"+tt.organizationID, bytes.NewBuffer(jsonBody))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			r.ServeHTTP(w, req)
 
 			// Check response
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
 			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, etagFor(version.Add(time.Second)), w.Header().Get("ETag"))
+
 				var response handler.OrganizationResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
@@ -507,15 +525,9 @@ func TestOrganizationHandler_UpdateOrganization(t *testing.T) {
 				assert.Equal(t, tt.requestBody.Name, response.Name)
 				assert.Equal(t, tt.requestBody.Type, response.Type)
 
-				if tt.requestBody.PrefectureID != nil {
-					assert.Equal(t, *tt.requestBody.PrefectureID, *response.PrefectureID)
-				}
 				if tt.requestBody.ParentID != nil {
 					assert.Equal(t, *tt.requestBody.ParentID, *response.ParentID)
 				}
-				if tt.requestBody.Description != nil {
-					assert.Equal(t, *tt.requestBody.Description, *response.Description)
-				}
 			}
 		})
 	}
@@ -544,8 +556,8 @@ func TestOrganizationHandler_DeleteOrganization(t *testing.T) {
 					CreatedAt: time.Now(),
 					UpdatedAt: time.Now(),
 				}
-				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int32(1)).Return(organization, nil)
-				mockUseCase.EXPECT().DeleteOrganization(gomock.Any(), int32(1)).Return(nil)
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(1)).Return(organization, nil)
+				mockUseCase.EXPECT().DeleteOrganization(gomock.Any(), int64(1)).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
 		},
@@ -559,7 +571,7 @@ func TestOrganizationHandler_DeleteOrganization(t *testing.T) {
 			name:           "Not Found",
 			organizationID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockOrganizationUseCase) {
-				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(999)).Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -574,8 +586,8 @@ func TestOrganizationHandler_DeleteOrganization(t *testing.T) {
 					CreatedAt: time.Now(),
 					UpdatedAt: time.Now(),
 				}
-				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int32(1)).Return(organization, nil)
-				mockUseCase.EXPECT().DeleteOrganization(gomock.Any(), int32(1)).Return(errors.New("database error"))
+				mockUseCase.EXPECT().GetOrganizationByID(gomock.Any(), int64(1)).Return(organization, nil)
+				mockUseCase.EXPECT().DeleteOrganization(gomock.Any(), int64(1)).Return(errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -34,25 +34,18 @@ type PrefectureResponse struct {
 	Name string `json:"name"`
 }
 
-// ListPrefectures @title 都道府県一覧取得
-// @id ListPrefectures
-// @tags prefectures
-// @accept json
-// @produce json
-// @Summary 都道府県一覧取得
-// @Success 200 {array} PrefectureResponse
-// @Router /prefectures [get]
+// ListPrefectures 都道府県一覧取得
 func (h *prefectureHandler) ListPrefectures(c *gin.Context) {
 	ctx := c.Request.Context()
 	prefectures, err := h.prefectureUseCase.ListPrefectures(ctx)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to list prefectures")
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var response []*PrefectureResponse
+	response := make([]*PrefectureResponse, 0, len(prefectures))
 	for _, prefecture := range prefectures {
 		response = append(response, &PrefectureResponse{
 			ID:   prefecture.ID,
@@ -81,16 +74,7 @@ type Municipality struct {
 	IsActive              bool   `json:"is_active"`
 }
 
-// GetPrefecture @title 都道府県詳細取得
-// @id GetPrefecture
-// @tags prefectures
-// @accept json
-// @produce json
-// @Param id path int true "都道府県ID"
-// @Summary 都道府県詳細取得
-// @Success 200 {object} PrefectureResponse
-// @Failure 404 {object} map[string]string
-// @Router /prefectures/{id} [get]
+// GetPrefecture 都道府県詳細取得
 func (h *prefectureHandler) GetPrefecture(c *gin.Context) {
 	ctx := c.Request.Context()
 	code := c.Param("code")
@@ -98,7 +82,7 @@ func (h *prefectureHandler) GetPrefecture(c *gin.Context) {
 	prefecture, err := h.prefectureUseCase.GetPrefectureByID(ctx, code)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Prefecture not found", "prefecture_code", code)
-		c.JSON(404, gin.H{"error": "Prefecture not found"})
+		_ = c.Error(err)
 
 		return
 	}
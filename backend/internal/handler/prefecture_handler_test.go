@@ -10,16 +10,19 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
 	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
 func setupPrefectureTest(t *testing.T) (*gin.Engine, *mockusecase.MockPrefectureUseCase, handler.Prefecture) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
 	ctrl := gomock.NewController(t)
 	mockUseCase := mockusecase.NewMockPrefectureUseCase(ctrl)
 	l := logger.New(logger.DefaultConfig())
@@ -133,7 +136,7 @@ func TestPrefectureHandler_GetPrefecture(t *testing.T) {
 			name:         "Not Found",
 			prefectureID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
-				mockUseCase.EXPECT().GetPrefectureByID(gomock.Any(), "999").Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetPrefectureByID(gomock.Any(), "999").Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 )
@@ -15,6 +16,7 @@ type SupportApplication interface {
 	ListSupportApplications(c *gin.Context)
 	GetSupportApplication(c *gin.Context)
 	CreateSupportApplication(c *gin.Context)
+	UpdateSupportApplicationStatus(c *gin.Context)
 }
 
 type supportApplicationHandler struct {
@@ -37,6 +39,7 @@ type SupportApplicationResponse struct {
 	ApplicationDate string  `json:"application_date"`
 	ApplicantName   string  `json:"applicant_name"`
 	DisasterName    string  `json:"disaster_name"`
+	DisasterID      *string `json:"disaster_id,omitempty"`
 	RequestedAmount int64   `json:"requested_amount"`
 	Status          string  `json:"status"`
 	ReviewedAt      *string `json:"reviewed_at,omitempty"`
@@ -53,36 +56,35 @@ type ListSupportApplicationsResponse struct {
 }
 
 type CreateSupportApplicationRequest struct {
-	ApplicationID   string  `json:"application_id" binding:"required"`
-	ApplicationDate string  `json:"application_date" binding:"required"`
-	ApplicantName   string  `json:"applicant_name" binding:"required"`
-	DisasterName    string  `json:"disaster_name" binding:"required"`
+	ApplicationID   string `json:"application_id" binding:"required"`
+	ApplicationDate string `json:"application_date" binding:"required"`
+	ApplicantName   string `json:"applicant_name" binding:"required"`
+	DisasterName    string `json:"disaster_name" binding:"required"`
+	// DisasterID links the application to the disaster so that deleting the disaster checks it
+	DisasterID      *string `json:"disaster_id" binding:"omitempty,uuid"`
 	RequestedAmount int64   `json:"requested_amount" binding:"required"`
 	Status          string  `json:"status"`
 	Notes           *string `json:"notes"`
+	// ApplicantUserID links the application to the applicant's account so reminders reach them
+	ApplicantUserID *string `json:"applicant_user_id" binding:"omitempty,uuid"`
 }
 
-// ListSupportApplications @title 支援申請一覧取得
-// @id ListSupportApplications
-// @tags support-applications
-// @accept json
-// @produce json
-// @version バージョン(1.0)
-// @description
-// @Summary 支援申請一覧取得
-// @Success 200 {array} ListSupportApplicationsResponse
-// @Router /support-applications [get]
+type UpdateSupportApplicationStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// ListSupportApplications 支援申請一覧取得
 func (h *supportApplicationHandler) ListSupportApplications(c *gin.Context) {
 	ctx := c.Request.Context()
 	supportApplications, err := h.supportApplicationUseCase.ListSupportApplications(ctx)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to list support applications")
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var sas []*SupportApplicationResponse
+	sas := make([]*SupportApplicationResponse, 0, len(supportApplications))
 
 	for _, sa := range supportApplications {
 		var reviewedAt, approvedAt, completedAt *string
@@ -107,6 +109,7 @@ func (h *supportApplicationHandler) ListSupportApplications(c *gin.Context) {
 			ApplicationDate: sa.ApplicationDate.Format("2006-01-02"),
 			ApplicantName:   sa.ApplicantName,
 			DisasterName:    sa.DisasterName,
+			DisasterID:      sa.DisasterID,
 			RequestedAmount: sa.RequestedAmount,
 			Status:          sa.Status,
 			ReviewedAt:      reviewedAt,
@@ -126,16 +129,7 @@ func (h *supportApplicationHandler) ListSupportApplications(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
-// GetSupportApplication @title 支援申請詳細取得
-// @id GetSupportApplication
-// @tags support-applications
-// @accept json
-// @produce json
-// @Param id path string true "申請ID"
-// @Summary 支援申請詳細取得
-// @Success 200 {object} SupportApplicationResponse
-// @Failure 404 {object} map[string]string
-// @Router /support-applications/{id} [get]
+// GetSupportApplication 支援申請詳細取得
 func (h *supportApplicationHandler) GetSupportApplication(c *gin.Context) {
 	id := c.Param("id")
 	ctx := c.Request.Context()
@@ -143,7 +137,7 @@ func (h *supportApplicationHandler) GetSupportApplication(c *gin.Context) {
 	supportApplication, err := h.supportApplicationUseCase.GetSupportApplicationByID(ctx, id)
 	if err != nil {
 		h.l.ErrorContext(ctx, err, "Failed to get support application", "application_id", id)
-		c.JSON(404, gin.H{"error": "Support application not found"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -170,6 +164,7 @@ func (h *supportApplicationHandler) GetSupportApplication(c *gin.Context) {
 		ApplicationDate: supportApplication.ApplicationDate.Format("2006-01-02"),
 		ApplicantName:   supportApplication.ApplicantName,
 		DisasterName:    supportApplication.DisasterName,
+		DisasterID:      supportApplication.DisasterID,
 		RequestedAmount: supportApplication.RequestedAmount,
 		Status:          supportApplication.Status,
 		ReviewedAt:      reviewedAt,
@@ -181,30 +176,22 @@ func (h *supportApplicationHandler) GetSupportApplication(c *gin.Context) {
 	}
 
 	h.l.InfoContext(ctx, "Successfully retrieved support application", "application_id", id)
+	setETag(c, supportApplication.UpdatedAt)
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateSupportApplication @title 支援申請作成
-// @id CreateSupportApplication
-// @tags support-applications
-// @accept json
-// @produce json
-// @Param request body CreateSupportApplicationRequest true "支援申請作成リクエスト"
-// @Summary 支援申請作成
-// @Success 201 {object} SupportApplicationResponse
-// @Failure 400 {object} map[string]string
-// @Router /support-applications [post]
+// CreateSupportApplication 支援申請作成
 func (h *supportApplicationHandler) CreateSupportApplication(c *gin.Context) {
 	var req CreateSupportApplicationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 		return
 	}
 
 	// Parse application_date
 	applicationDate, err := time.Parse("2006-01-02", req.ApplicationDate)
 	if err != nil {
-		c.JSON(400, gin.H{"error": "Invalid application_date format. Use YYYY-MM-DD format"})
+		_ = c.Error(newInvalidParamError("application_date", myerrors.FieldDate))
 		return
 	}
 
@@ -219,16 +206,18 @@ func (h *supportApplicationHandler) CreateSupportApplication(c *gin.Context) {
 		ApplicationDate: applicationDate,
 		ApplicantName:   req.ApplicantName,
 		DisasterName:    req.DisasterName,
+		DisasterID:      req.DisasterID,
 		RequestedAmount: req.RequestedAmount,
 		Status:          status,
 		Notes:           req.Notes,
+		ApplicantUserID: req.ApplicantUserID,
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 	}
 
 	err = h.supportApplicationUseCase.CreateSupportApplication(c.Request.Context(), supportApplication)
 	if err != nil {
-		c.JSON(500, gin.H{"error": "Failed to create support application"})
+		_ = c.Error(err)
 		return
 	}
 
@@ -237,6 +226,7 @@ func (h *supportApplicationHandler) CreateSupportApplication(c *gin.Context) {
 		ApplicationDate: supportApplication.ApplicationDate.Format("2006-01-02"),
 		ApplicantName:   supportApplication.ApplicantName,
 		DisasterName:    supportApplication.DisasterName,
+		DisasterID:      supportApplication.DisasterID,
 		RequestedAmount: supportApplication.RequestedAmount,
 		Status:          supportApplication.Status,
 		Notes:           supportApplication.Notes,
@@ -246,3 +236,44 @@ func (h *supportApplicationHandler) CreateSupportApplication(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, response)
 }
+
+// UpdateSupportApplicationStatus 支援申請ステータス更新
+func (h *supportApplicationHandler) UpdateSupportApplicationStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	expectedUpdatedAt, err := parseIfMatch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req UpdateSupportApplicationStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
+		return
+	}
+
+	supportApplication, err := h.supportApplicationUseCase.UpdateSupportApplicationStatus(ctx, id, req.Status, expectedUpdatedAt)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to update support application status", "application_id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully updated support application status", "application_id", id, "status", supportApplication.Status)
+	setETag(c, supportApplication.UpdatedAt)
+	c.JSON(http.StatusOK, &SupportApplicationResponse{
+		ApplicationID:   supportApplication.ApplicationID,
+		ApplicationDate: supportApplication.ApplicationDate.Format("2006-01-02"),
+		ApplicantName:   supportApplication.ApplicantName,
+		DisasterName:    supportApplication.DisasterName,
+		DisasterID:      supportApplication.DisasterID,
+		RequestedAmount: supportApplication.RequestedAmount,
+		Status:          supportApplication.Status,
+		Notes:           supportApplication.Notes,
+		CreatedAt:       supportApplication.CreatedAt.Format(time.DateTime),
+		UpdatedAt:       supportApplication.UpdatedAt.Format(time.DateTime),
+	})
+}
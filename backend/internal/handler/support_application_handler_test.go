@@ -12,16 +12,20 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
 	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
 func setupSupportApplicationTest(t *testing.T) (*gin.Engine, *mockusecase.MockSupportApplicationUseCase, handler.SupportApplication) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
 	ctrl := gomock.NewController(t)
 	mockUseCase := mockusecase.NewMockSupportApplicationUseCase(ctrl)
 	l := logger.New(logger.DefaultConfig())
@@ -218,7 +222,7 @@ func TestSupportApplicationHandler_GetSupportApplication(t *testing.T) {
 			name:          "Not Found",
 			applicationID: "APP-999",
 			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
-				mockUseCase.EXPECT().GetSupportApplicationByID(gomock.Any(), "APP-999").Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetSupportApplicationByID(gomock.Any(), "APP-999").Return(nil, gorm.ErrRecordNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   nil,
@@ -402,3 +406,98 @@ func TestSupportApplicationHandler_CreateSupportApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportApplicationHandler_UpdateSupportApplicationStatus(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupSupportApplicationTest(t)
+	r.PUT("/support-applications/:id/status", h.UpdateSupportApplicationStatus)
+
+	version := time.Date(2024, 6, 1, 9, 0, 0, 123456000, time.UTC)
+	updated := &model.SupportApplication{
+		ApplicationID:   "A001",
+		ApplicationDate: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		ApplicantName:   "山田太郎",
+		DisasterName:    "東京地震",
+		RequestedAmount: 500000,
+		Status:          "承認済",
+		UpdatedAt:       version.Add(time.Second),
+	}
+
+	// Test cases
+	tests := []struct {
+		name           string
+		ifMatch        string
+		mockSetup      func(mockUseCase *mockusecase.MockSupportApplicationUseCase)
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			ifMatch: etagFor(version),
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					UpdateSupportApplicationStatus(gomock.Any(), "A001", "承認済", gomock.Any()).
+					DoAndReturn(func(_ interface{}, _, _ string, expectedUpdatedAt time.Time) (*model.SupportApplication, error) {
+						assert.True(t, version.Equal(expectedUpdatedAt))
+						return updated, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing If-Match",
+			mockSetup:      func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {},
+			expectedStatus: http.StatusPreconditionRequired,
+		},
+		{
+			name:    "Version Conflict",
+			ifMatch: etagFor(version),
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().UpdateSupportApplicationStatus(gomock.Any(), "A001", "承認済", gomock.Any()).
+					Return(nil, newPreconditionFailedError())
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "Invalid Transition",
+			ifMatch: etagFor(version),
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().UpdateSupportApplicationStatus(gomock.Any(), "A001", "承認済", gomock.Any()).
+					Return(nil, myerrors.NewAPIError(
+						myerrors.InvalidStatusTransitionError,
+						myerrors.InvalidStatusTransitionErrorMessage,
+						errors.New("invalid status transition"),
+						"invalid status transition",
+					))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockUseCase)
+
+			// Make request
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPut, "/support-applications/A001/status", bytes.NewBufferString(`{"status":"承認済"}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			r.ServeHTTP(w, req)
+
+			// Check response
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, etagFor(updated.UpdatedAt), w.Header().Get("ETag"))
+
+				var response handler.SupportApplicationResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, "承認済", response.Status)
+			}
+		})
+	}
+}
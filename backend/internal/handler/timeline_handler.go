@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 )
@@ -42,21 +43,11 @@ type ListTimelinesResponse struct {
 	Total     int32               `json:"total,omitempty"`
 }
 
-// GetTimelinesByDisasterID godoc
-// @Summary Get timelines by disaster ID
-// @Description Get timelines by disaster ID
-// @Tags timelines
-// @Accept json
-// @Produce json
-// @Param id path string true "Disaster ID"
-// @Success 200 {object} ListTimelinesResponse
-// @Router /disasters/{id}/timelines [get]
+// GetTimelinesByDisasterID 災害のタイムライン取得
 func (h *timelineHandler) GetTimelinesByDisasterID(c *gin.Context) {
 	disasterID := c.Param("id")
 	if disasterID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "disaster_id is required",
-		})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldRequired))
 
 		return
 	}
@@ -64,9 +55,7 @@ func (h *timelineHandler) GetTimelinesByDisasterID(c *gin.Context) {
 	timelines, err := h.timelineUseCase.GetTimelinesByDisasterID(c.Request.Context(), disasterID)
 	if err != nil {
 		h.logger.Error("failed to get timelines", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "failed to get timelines",
-		})
+		_ = c.Error(err)
 
 		return
 	}
@@ -79,7 +68,7 @@ func (h *timelineHandler) GetTimelinesByDisasterID(c *gin.Context) {
 		return
 	}
 
-	var response []*TimelineResponse
+	response := make([]*TimelineResponse, 0, len(timelines))
 
 	for _, timeline := range timelines {
 		var severity string
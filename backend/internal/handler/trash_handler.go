@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+)
+
+type Trash interface {
+	ListTrash(c *gin.Context)
+	RestoreDisaster(c *gin.Context)
+	RestoreTimeline(c *gin.Context)
+	RestoreFacilityEquipment(c *gin.Context)
+	RestoreGisData(c *gin.Context)
+	RestoreAssessment(c *gin.Context)
+	RestoreUser(c *gin.Context)
+	PurgeTrash(c *gin.Context)
+}
+
+type trashHandler struct {
+	l            *logger.Logger
+	trashUseCase usecase.TrashUseCase
+}
+
+func NewTrashHandler(
+	l *logger.Logger,
+	trashUseCase usecase.TrashUseCase,
+) Trash {
+	return &trashHandler{
+		l:            l,
+		trashUseCase: trashUseCase,
+	}
+}
+
+type TrashItemResponse struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"`
+	Label     string    `json:"label"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+type ListTrashResponse struct {
+	Items []*TrashItemResponse `json:"items"`
+	Total int64                `json:"total"`
+}
+
+type RestoreTrashResponse struct {
+	Type             string `json:"type"`
+	ID               string `json:"id"`
+	RestoredChildren int64  `json:"restored_children"`
+}
+
+// ListTrash ゴミ箱一覧取得
+func (h *trashHandler) ListTrash(c *gin.Context) {
+	ctx := c.Request.Context()
+	trashType := model.TrashType(c.Query("type"))
+
+	items, err := h.trashUseCase.ListTrash(ctx, trashType)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to list trash", "type", trashType)
+		_ = c.Error(err)
+
+		return
+	}
+
+	res := &ListTrashResponse{
+		Items: make([]*TrashItemResponse, 0, len(items)),
+		Total: int64(len(items)),
+	}
+	for _, item := range items {
+		res.Items = append(res.Items, &TrashItemResponse{
+			Type:      string(item.Type),
+			ID:        item.ID,
+			Label:     item.Label,
+			DeletedAt: item.DeletedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RestoreDisaster 災害復元
+func (h *trashHandler) RestoreDisaster(c *gin.Context) {
+	h.restore(c, model.TrashTypeDisaster)
+}
+
+// RestoreTimeline タイムライン復元
+func (h *trashHandler) RestoreTimeline(c *gin.Context) {
+	h.restore(c, model.TrashTypeTimeline)
+}
+
+// RestoreFacilityEquipment 施設設備復元
+func (h *trashHandler) RestoreFacilityEquipment(c *gin.Context) {
+	h.restore(c, model.TrashTypeFacilityEquipment)
+}
+
+// RestoreGisData GISデータ復元
+func (h *trashHandler) RestoreGisData(c *gin.Context) {
+	h.restore(c, model.TrashTypeGisData)
+}
+
+// RestoreAssessment 査定復元
+func (h *trashHandler) RestoreAssessment(c *gin.Context) {
+	h.restore(c, model.TrashTypeAssessment)
+}
+
+// RestoreUser ユーザー復元
+func (h *trashHandler) RestoreUser(c *gin.Context) {
+	h.restore(c, model.TrashTypeUser)
+}
+
+// PurgeTrash ゴミ箱から完全削除
+func (h *trashHandler) PurgeTrash(c *gin.Context) {
+	ctx := c.Request.Context()
+	trashType := model.TrashType(c.Param("type"))
+	id := c.Param("id")
+
+	if err := h.trashUseCase.Purge(ctx, trashType, id); err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to purge trash item", "type", trashType, "id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully purged trash item", "type", trashType, "id", id)
+	c.Status(http.StatusNoContent)
+}
+
+func (h *trashHandler) restore(c *gin.Context, trashType model.TrashType) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	restored, err := h.trashUseCase.Restore(ctx, trashType, id)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to restore trash item", "type", trashType, "id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully restored trash item", "type", trashType, "id", id, "restored_children", restored)
+	c.JSON(http.StatusOK, &RestoreTrashResponse{
+		Type:             string(trashType),
+		ID:               id,
+		RestoredChildren: restored,
+	})
+}
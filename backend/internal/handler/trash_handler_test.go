@@ -0,0 +1,222 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/handler"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
+)
+
+const trashDisasterID = "0b3c4c8e-5a7f-4a55-9d7c-3c1f2d5e6a70"
+
+func setupTrashTest(t *testing.T) (*gin.Engine, *mockusecase.MockTrashUseCase, handler.Trash) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
+	ctrl := gomock.NewController(t)
+	mockUseCase := mockusecase.NewMockTrashUseCase(ctrl)
+	l := logger.New(logger.DefaultConfig())
+	h := handler.NewTrashHandler(l, mockUseCase)
+	return r, mockUseCase, h
+}
+
+func newTrashError(code myerrors.ErrorCode, msg myerrors.ErrorMessage) error {
+	return myerrors.NewAPIError(code, msg, errors.New(string(msg)), "trash error")
+}
+
+func TestTrashHandler_ListTrash(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupTrashTest(t)
+	r.GET("/trash", h.ListTrash)
+
+	deletedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(mockUseCase *mockusecase.MockTrashUseCase)
+		expectedStatus int
+		expectedBody   *handler.ListTrashResponse
+	}{
+		{
+			name:  "Success",
+			query: "?type=disaster",
+			mockSetup: func(mockUseCase *mockusecase.MockTrashUseCase) {
+				mockUseCase.EXPECT().ListTrash(gomock.Any(), model.TrashTypeDisaster).Return([]*model.TrashItem{
+					{Type: model.TrashTypeDisaster, ID: trashDisasterID, Label: "令和6年豪雨", DeletedAt: deletedAt},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &handler.ListTrashResponse{
+				Items: []*handler.TrashItemResponse{
+					{Type: "disaster", ID: trashDisasterID, Label: "令和6年豪雨", DeletedAt: deletedAt},
+				},
+				Total: 1,
+			},
+		},
+		{
+			name:  "Unknown Type",
+			query: "?type=prefecture",
+			mockSetup: func(mockUseCase *mockusecase.MockTrashUseCase) {
+				mockUseCase.EXPECT().ListTrash(gomock.Any(), model.TrashType("prefecture")).
+					Return(nil, myerrors.NewValidationError(myerrors.FieldError{Field: "type", Message: "invalid"}))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Error",
+			query: "?type=user",
+			mockSetup: func(mockUseCase *mockusecase.MockTrashUseCase) {
+				mockUseCase.EXPECT().ListTrash(gomock.Any(), model.TrashTypeUser).Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/trash"+tt.query, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response handler.ListTrashResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, &response)
+			}
+		})
+	}
+}
+
+func TestTrashHandler_Restore(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupTrashTest(t)
+	r.POST("/disasters/:id/restore", h.RestoreDisaster)
+	r.POST("/timelines/:id/restore", h.RestoreTimeline)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		path           string
+		mockSetup      func(mockUseCase *mockusecase.MockTrashUseCase)
+		expectedStatus int
+		expectedBody   *handler.RestoreTrashResponse
+	}{
+		{
+			name: "Restore Disaster With Children",
+			path: "/disasters/" + trashDisasterID + "/restore",
+			mockSetup: func(mockUseCase *mockusecase.MockTrashUseCase) {
+				mockUseCase.EXPECT().Restore(gomock.Any(), model.TrashTypeDisaster, trashDisasterID).Return(int64(4), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &handler.RestoreTrashResponse{
+				Type:             "disaster",
+				ID:               trashDisasterID,
+				RestoredChildren: 4,
+			},
+		},
+		{
+			name: "Not In Trash",
+			path: "/disasters/" + trashDisasterID + "/restore",
+			mockSetup: func(mockUseCase *mockusecase.MockTrashUseCase) {
+				mockUseCase.EXPECT().Restore(gomock.Any(), model.TrashTypeDisaster, trashDisasterID).
+					Return(int64(0), newTrashError(myerrors.TrashItemNotFoundError, myerrors.TrashItemNotFoundErrorMessage))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "Parent Still Deleted",
+			path: "/timelines/10/restore",
+			mockSetup: func(mockUseCase *mockusecase.MockTrashUseCase) {
+				mockUseCase.EXPECT().Restore(gomock.Any(), model.TrashTypeTimeline, "10").
+					Return(int64(0), newTrashError(myerrors.TrashParentDeletedError, myerrors.TrashParentDeletedErrorMessage))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response handler.RestoreTrashResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, &response)
+			}
+		})
+	}
+}
+
+func TestTrashHandler_PurgeTrash(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupTrashTest(t)
+	r.DELETE("/trash/:type/:id", h.PurgeTrash)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		path           string
+		mockSetup      func(mockUseCase *mockusecase.MockTrashUseCase)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			path: "/trash/facility_equipment/1",
+			mockSetup: func(mockUseCase *mockusecase.MockTrashUseCase) {
+				mockUseCase.EXPECT().Purge(gomock.Any(), model.TrashTypeFacilityEquipment, "1").Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "Referenced",
+			path: "/trash/user/" + trashDisasterID,
+			mockSetup: func(mockUseCase *mockusecase.MockTrashUseCase) {
+				mockUseCase.EXPECT().Purge(gomock.Any(), model.TrashTypeUser, trashDisasterID).
+					Return(newTrashError(myerrors.TrashItemReferencedError, myerrors.TrashItemReferencedErrorMessage))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodDelete, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
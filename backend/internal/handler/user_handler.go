@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 )
@@ -54,29 +55,19 @@ type UpdateUserRequest struct {
 	Password string `json:"password" binding:"omitempty,min=6"`
 }
 
-// ListUsers @title ユーザー一覧取得
-// @id ListUsers
-// @tags users
-// @accept json
-// @produce json
-// @version バージョン(1.0)
-// @description ユーザー一覧を取得します
-// @Summary ユーザー一覧取得
-// @Success 200 {array} UserResponse
-// @Failure 500 {object} map[string]string
-// @Router /users [get]
+// ListUsers ユーザー一覧取得
 func (h *userHandler) ListUsers(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	users, err := h.userUseCase.ListUsers(ctx)
 	if err != nil {
 		h.logger.Error("Failed to get users", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get users"})
+		_ = c.Error(err)
 
 		return
 	}
 
-	var response []UserResponse
+	response := make([]UserResponse, 0, len(users))
 	for _, user := range users {
 		response = append(response, UserResponse{
 			ID:        user.ID,
@@ -90,24 +81,14 @@ func (h *userHandler) ListUsers(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// GetUser @title ユーザー詳細取得
-// @id GetUser
-// @tags users
-// @accept json
-// @produce json
-// @Param id path integer true "ユーザーID"
-// @Summary 特定のユーザー情報を取得
-// @Success 200 {object} UserResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /users/{id} [get]
+// GetUser ユーザー詳細取得
 func (h *userHandler) GetUser(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	user, err := h.userUseCase.GetUserByID(ctx, c.Param("id"))
 	if err != nil {
 		h.logger.Error("Failed to get user", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -134,24 +115,14 @@ func (h *userHandler) GetUser(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateUser @title ユーザー作成
-// @id CreateUser
-// @tags users
-// @accept json
-// @produce json
-// @Param request body CreateUserRequest true "ユーザー作成リクエスト"
-// @Summary 新規ユーザーを作成
-// @Success 201 {object} UserResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /users [post]
+// CreateUser ユーザー作成
 func (h *userHandler) CreateUser(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	var req CreateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error("Invalid request body", "error", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 
 		return
 	}
@@ -167,7 +138,7 @@ func (h *userHandler) CreateUser(c *gin.Context) {
 
 	if err := h.userUseCase.CreateUser(ctx, user); err != nil {
 		h.logger.Error("Failed to create user", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -183,25 +154,14 @@ func (h *userHandler) CreateUser(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
-// UpdateUser @title ユーザー更新
-// @id UpdateUser
-// @tags users
-// @accept json
-// @produce json
-// @Param id path integer true "ユーザーID"
-// @Param request body UpdateUserRequest true "ユーザー更新リクエスト"
-// @Summary ユーザー情報を更新
-// @Success 200 {object} UserResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /users/{id} [put]
+// UpdateUser ユーザー更新
 func (h *userHandler) UpdateUser(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	var req UpdateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error("Invalid request body", "error", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = c.Error(newBindingError(&req, err))
 
 		return
 	}
@@ -209,7 +169,7 @@ func (h *userHandler) UpdateUser(c *gin.Context) {
 	user, err := h.userUseCase.GetUserByID(ctx, c.Param("id"))
 	if err != nil {
 		h.logger.Error("Failed to get user", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -226,7 +186,7 @@ func (h *userHandler) UpdateUser(c *gin.Context) {
 
 	if err := h.userUseCase.UpdateUser(ctx, user); err != nil {
 		h.logger.Error("Failed to update user", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -242,31 +202,21 @@ func (h *userHandler) UpdateUser(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// DeleteUser @title ユーザー削除
-// @id DeleteUser
-// @tags users
-// @accept json
-// @produce json
-// @Param id path integer true "ユーザーID"
-// @Summary 指定されたユーザーを削除
-// @Success 204 "No Content"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /users/{id} [delete]
+// DeleteUser ユーザー削除
 func (h *userHandler) DeleteUser(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		h.logger.Error("Invalid user ID", "error", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		_ = c.Error(newInvalidParamError("id", myerrors.FieldNumeric))
 
 		return
 	}
 
 	if err := h.userUseCase.DeleteUser(ctx, int32(id)); err != nil {
 		h.logger.Error("Failed to delete user", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete user"})
+		_ = c.Error(err)
 
 		return
 	}
@@ -16,12 +16,14 @@ import (
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
 	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
 func setupUserTest(t *testing.T) (*gin.Engine, *mockusecase.MockUserUseCase, handler.User) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
 	ctrl := gomock.NewController(t)
 	mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 	l := logger.New(logger.DefaultConfig())
@@ -47,14 +49,14 @@ func TestUserHandler_ListUsers(t *testing.T) {
 				now := time.Now()
 				users := []*model.User{
 					{
-						ID:        1,
+						ID:        "1",
 						Name:      "User 1",
 						Email:     "user1@example.com",
 						CreatedAt: &now,
 						UpdatedAt: &now,
 					},
 					{
-						ID:        2,
+						ID:        "2",
 						Name:      "User 2",
 						Email:     "user2@example.com",
 						CreatedAt: &now,
@@ -66,12 +68,12 @@ func TestUserHandler_ListUsers(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedBody: []handler.UserResponse{
 				{
-					ID:    1,
+					ID:    "1",
 					Name:  "User 1",
 					Email: "user1@example.com",
 				},
 				{
-					ID:    2,
+					ID:    "2",
 					Name:  "User 2",
 					Email: "user2@example.com",
 				},
@@ -136,33 +138,35 @@ func TestUserHandler_GetUser(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockUserUseCase) {
 				now := time.Now()
 				user := &model.User{
-					ID:        1,
+					ID:        "1",
 					Name:      "User 1",
 					Email:     "user1@example.com",
 					CreatedAt: &now,
 					UpdatedAt: &now,
 				}
-				mockUseCase.EXPECT().GetUserByID(gomock.Any(), int32(1)).Return(user, nil)
+				mockUseCase.EXPECT().GetUserByID(gomock.Any(), "1").Return(user, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: &handler.UserResponse{
-				ID:    1,
+				ID:    "1",
 				Name:  "User 1",
 				Email: "user1@example.com",
 			},
 		},
 		{
-			name:           "Invalid ID",
-			userID:         "invalid",
-			mockSetup:      func(mockUseCase *mockusecase.MockUserUseCase) {},
-			expectedStatus: http.StatusBadRequest,
+			name:   "Invalid ID",
+			userID: "invalid",
+			mockSetup: func(mockUseCase *mockusecase.MockUserUseCase) {
+				mockUseCase.EXPECT().GetUserByID(gomock.Any(), "invalid").Return(nil, errors.New("invalid input syntax for type uuid"))
+			},
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   nil,
 		},
 		{
 			name:   "User Not Found",
 			userID: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockUserUseCase) {
-				mockUseCase.EXPECT().GetUserByID(gomock.Any(), int32(999)).Return(nil, errors.New("user not found"))
+				mockUseCase.EXPECT().GetUserByID(gomock.Any(), "999").Return(nil, errors.New("user not found"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   nil,
@@ -227,13 +231,13 @@ func TestUserHandler_CreateUser(t *testing.T) {
 								user.Name, user.Email, user.Password)
 						}
 						// Set ID to simulate database insertion
-						user.ID = 1
+						user.ID = "1"
 						return nil
 					})
 			},
 			expectedStatus: http.StatusCreated,
 			expectedBody: &handler.UserResponse{
-				ID:    1,
+				ID:    "1",
 				Name:  "New User",
 				Email: "newuser@example.com",
 			},
@@ -342,7 +346,7 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockUserUseCase) {
 				now := time.Now()
 				existingUser := &model.User{
-					ID:        1,
+					ID:        "1",
 					Name:      "Original User",
 					Email:     "original@example.com",
 					Password:  "oldpassword",
@@ -350,14 +354,14 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 					UpdatedAt: &now,
 				}
 
-				mockUseCase.EXPECT().GetUserByID(gomock.Any(), int32(1)).Return(existingUser, nil)
+				mockUseCase.EXPECT().GetUserByID(gomock.Any(), "1").Return(existingUser, nil)
 
 				mockUseCase.EXPECT().
 					UpdateUser(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ interface{}, user *model.User) error {
 						// Verify user properties
-						if user.ID != 1 || user.Name != "Updated User" || user.Email != "updated@example.com" || user.Password != "newpassword123" {
-							t.Errorf("Expected user with ID 1, name 'Updated User', email 'updated@example.com', and password 'newpassword123', got ID %d, name '%s', email '%s', and password '%s'",
+						if user.ID != "1" || user.Name != "Updated User" || user.Email != "updated@example.com" || user.Password != "newpassword123" {
+							t.Errorf("Expected user with ID 1, name 'Updated User', email 'updated@example.com', and password 'newpassword123', got ID %s, name '%s', email '%s', and password '%s'",
 								user.ID, user.Name, user.Email, user.Password)
 						}
 						return nil
@@ -365,7 +369,7 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: &handler.UserResponse{
-				ID:    1,
+				ID:    "1",
 				Name:  "Updated User",
 				Email: "updated@example.com",
 			},
@@ -398,7 +402,7 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 				Password: "newpassword123",
 			},
 			mockSetup: func(mockUseCase *mockusecase.MockUserUseCase) {
-				mockUseCase.EXPECT().GetUserByID(gomock.Any(), int32(999)).Return(nil, errors.New("user not found"))
+				mockUseCase.EXPECT().GetUserByID(gomock.Any(), "999").Return(nil, errors.New("user not found"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   nil,
@@ -414,7 +418,7 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 			mockSetup: func(mockUseCase *mockusecase.MockUserUseCase) {
 				now := time.Now()
 				existingUser := &model.User{
-					ID:        1,
+					ID:        "1",
 					Name:      "Original User",
 					Email:     "original@example.com",
 					Password:  "oldpassword",
@@ -422,7 +426,7 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 					UpdatedAt: &now,
 				}
 
-				mockUseCase.EXPECT().GetUserByID(gomock.Any(), int32(1)).Return(existingUser, nil)
+				mockUseCase.EXPECT().GetUserByID(gomock.Any(), "1").Return(existingUser, nil)
 
 				mockUseCase.EXPECT().
 					UpdateUser(gomock.Any(), gomock.Any()).
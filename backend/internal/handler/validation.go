@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+// newBindingError converts a gin binding error into a validation APIError with per-field messages.
+// req must be a pointer to the request struct that was bound so that JSON field names can be resolved.
+func newBindingError(req any, err error) *myerrors.APIError {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make([]myerrors.FieldError, 0, len(validationErrs))
+		for _, fe := range validationErrs {
+			key, params := validationMessage(fe)
+			fields = append(fields, myerrors.NewFieldError(jsonFieldName(req, fe.StructField()), key, params...))
+		}
+
+		return myerrors.NewValidationError(fields...)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return myerrors.NewValidationError(myerrors.NewFieldError(typeErr.Field, myerrors.FieldType, typeErr.Type.String()))
+	}
+
+	return myerrors.NewValidationError(myerrors.NewFieldError("body", myerrors.FieldMalformedBody))
+}
+
+// newInvalidParamError returns the validation error for a path, query or header parameter that could not be parsed
+func newInvalidParamError(name string, key myerrors.FieldMessage, params ...any) *myerrors.APIError {
+	return myerrors.NewValidationError(myerrors.NewFieldError(name, key, params...))
+}
+
+func jsonFieldName(req any, structField string) string {
+	t := reflect.TypeOf(req)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return structField
+	}
+
+	f, ok := t.FieldByName(structField)
+	if !ok {
+		return structField
+	}
+
+	// クエリパラメータのリクエストはformタグの名前で返す
+	for _, tag := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return structField
+}
+
+// validationMessage returns the catalog key and parameters of the message for a validator error
+func validationMessage(fe validator.FieldError) (myerrors.FieldMessage, []any) {
+	switch fe.Tag() {
+	case "required":
+		return myerrors.FieldRequired, nil
+	case "max":
+		return boundMessage(fe, myerrors.FieldMax, myerrors.FieldMaxLength, myerrors.FieldMaxItems), []any{fe.Param()}
+	case "min":
+		return boundMessage(fe, myerrors.FieldMin, myerrors.FieldMinLength, myerrors.FieldMinItems), []any{fe.Param()}
+	case "gte":
+		return myerrors.FieldMin, []any{fe.Param()}
+	case "lte":
+		return myerrors.FieldMax, []any{fe.Param()}
+	case "oneof":
+		return myerrors.FieldOneOf, []any{strings.Join(strings.Fields(fe.Param()), ", ")}
+	case "email":
+		return myerrors.FieldEmail, nil
+	case "datetime":
+		return myerrors.FieldDateTime, nil
+	default:
+		return myerrors.FieldInvalid, nil
+	}
+}
+
+// boundMessage picks the message of a min or max tag, which validator applies to the length of strings and
+// the number of items of slices and maps
+func boundMessage(fe validator.FieldError, number, length, items myerrors.FieldMessage) myerrors.FieldMessage {
+	switch fe.Kind() {
+	case reflect.String:
+		return length
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return items
+	default:
+		return number
+	}
+}
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+)
+
+type Webhook interface {
+	ListWebhookSubscriptions(c *gin.Context)
+	GetWebhookSubscription(c *gin.Context)
+	CreateWebhookSubscription(c *gin.Context)
+	UpdateWebhookSubscription(c *gin.Context)
+	DeleteWebhookSubscription(c *gin.Context)
+	ListWebhookDeliveries(c *gin.Context)
+	SendTestWebhookEvent(c *gin.Context)
+}
+
+type webhookHandler struct {
+	l              *logger.Logger
+	webhookUseCase usecase.WebhookUseCase
+}
+
+func NewWebhookHandler(
+	l *logger.Logger,
+	webhookUseCase usecase.WebhookUseCase,
+) Webhook {
+	return &webhookHandler{
+		l:              l,
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	URL         string   `json:"url" binding:"required,url,max=2048"`
+	EventTypes  []string `json:"event_types" binding:"required,min=1,dive,max=100"`
+	Secret      string   `json:"secret" binding:"omitempty,min=16,max=100"`
+	Description *string  `json:"description" binding:"omitempty,max=255"`
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	URL         *string  `json:"url" binding:"omitempty,url,max=2048"`
+	EventTypes  []string `json:"event_types" binding:"omitempty,min=1,dive,max=100"`
+	Description *string  `json:"description" binding:"omitempty,max=255"`
+	Active      *bool    `json:"active"`
+}
+
+type ListWebhookDeliveriesRequest struct {
+	Limit int `form:"limit" json:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+type WebhookSubscriptionResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	EventTypes  []string  `json:"event_types"`
+	Description *string   `json:"description,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedBy   *string   `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateWebhookSubscriptionResponse includes the signing secret, which is only returned once on creation
+type CreateWebhookSubscriptionResponse struct {
+	WebhookSubscriptionResponse
+	Secret string `json:"secret"`
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	Subscriptions []*WebhookSubscriptionResponse `json:"subscriptions"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	ResponseStatus *int32          `json:"response_status,omitempty"`
+	ResponseBody   *string         `json:"response_body,omitempty"`
+	ErrorMessage   *string         `json:"error_message,omitempty"`
+	DurationMs     *int32          `json:"duration_ms,omitempty"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []*WebhookDeliveryResponse `json:"deliveries"`
+}
+
+// ListWebhookSubscriptions Webhook購読一覧取得
+func (h *webhookHandler) ListWebhookSubscriptions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	subscriptions, err := h.webhookUseCase.ListSubscriptions(ctx)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to list webhook subscriptions")
+		_ = c.Error(err)
+
+		return
+	}
+
+	res := &ListWebhookSubscriptionsResponse{Subscriptions: make([]*WebhookSubscriptionResponse, 0, len(subscriptions))}
+	for _, subscription := range subscriptions {
+		res.Subscriptions = append(res.Subscriptions, toWebhookSubscriptionResponse(subscription))
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetWebhookSubscription Webhook購読詳細取得
+func (h *webhookHandler) GetWebhookSubscription(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	subscription, err := h.webhookUseCase.GetSubscription(ctx, id)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to get webhook subscription", "subscription_id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toWebhookSubscriptionResponse(subscription))
+}
+
+// CreateWebhookSubscription Webhook購読作成
+func (h *webhookHandler) CreateWebhookSubscription(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req CreateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
+		return
+	}
+
+	subscription := &model.WebhookSubscription{
+		URL:         req.URL,
+		Secret:      req.Secret,
+		Description: req.Description,
+	}
+	subscription.SetEvents(req.EventTypes)
+	if userID := c.GetString("user_id"); userID != "" {
+		subscription.CreatedBy = &userID
+	}
+
+	if err := h.webhookUseCase.CreateSubscription(ctx, subscription); err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to create webhook subscription")
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully created webhook subscription", "subscription_id", subscription.ID)
+	c.JSON(http.StatusCreated, &CreateWebhookSubscriptionResponse{
+		WebhookSubscriptionResponse: *toWebhookSubscriptionResponse(subscription),
+		Secret:                      subscription.Secret,
+	})
+}
+
+// UpdateWebhookSubscription Webhook購読更新
+func (h *webhookHandler) UpdateWebhookSubscription(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	var req UpdateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
+		return
+	}
+
+	subscription, err := h.webhookUseCase.UpdateSubscription(ctx, id, &usecase.UpdateWebhookSubscriptionParams{
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+		Active:      req.Active,
+	})
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to update webhook subscription", "subscription_id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully updated webhook subscription", "subscription_id", id)
+	c.JSON(http.StatusOK, toWebhookSubscriptionResponse(subscription))
+}
+
+// DeleteWebhookSubscription Webhook購読削除
+func (h *webhookHandler) DeleteWebhookSubscription(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if err := h.webhookUseCase.DeleteSubscription(ctx, id); err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to delete webhook subscription", "subscription_id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Successfully deleted webhook subscription", "subscription_id", id)
+	c.Status(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries Webhook配信ログ取得
+func (h *webhookHandler) ListWebhookDeliveries(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	var req ListWebhookDeliveriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(newBindingError(&req, err))
+		return
+	}
+
+	deliveries, err := h.webhookUseCase.ListDeliveries(ctx, id, req.Limit)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to list webhook deliveries", "subscription_id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	res := &ListWebhookDeliveriesResponse{Deliveries: make([]*WebhookDeliveryResponse, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		res.Deliveries = append(res.Deliveries, toWebhookDeliveryResponse(delivery))
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// SendTestWebhookEvent Webhookテスト送信
+func (h *webhookHandler) SendTestWebhookEvent(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	delivery, err := h.webhookUseCase.SendTestEvent(ctx, id)
+	if err != nil {
+		h.l.ErrorContext(ctx, err, "Failed to send test webhook event", "subscription_id", id)
+		_ = c.Error(err)
+
+		return
+	}
+
+	h.l.InfoContext(ctx, "Sent test webhook event", "subscription_id", id, "status", delivery.Status)
+	c.JSON(http.StatusOK, toWebhookDeliveryResponse(delivery))
+}
+
+func toWebhookSubscriptionResponse(subscription *model.WebhookSubscription) *WebhookSubscriptionResponse {
+	return &WebhookSubscriptionResponse{
+		ID:          subscription.ID,
+		URL:         subscription.URL,
+		EventTypes:  subscription.Events(),
+		Description: subscription.Description,
+		Active:      subscription.Active,
+		CreatedBy:   subscription.CreatedBy,
+		CreatedAt:   subscription.CreatedAt,
+		UpdatedAt:   subscription.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery *model.WebhookDelivery) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		ErrorMessage:   delivery.ErrorMessage,
+		DurationMs:     delivery.DurationMs,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
@@ -0,0 +1,285 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/handler"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
+)
+
+func setupWebhookTest(t *testing.T) (*gin.Engine, *mockusecase.MockWebhookUseCase, handler.Webhook) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
+	ctrl := gomock.NewController(t)
+	mockUseCase := mockusecase.NewMockWebhookUseCase(ctrl)
+	l := logger.New(logger.DefaultConfig())
+	h := handler.NewWebhookHandler(l, mockUseCase)
+	return r, mockUseCase, h
+}
+
+func webhookSubscriptionNotFoundError() error {
+	return myerrors.NewAPIError(
+		myerrors.WebhookSubscriptionNotFound,
+		myerrors.WebhookSubscriptionNotFoundErrorMessage,
+		errors.New("record not found"),
+		"webhook subscription not found",
+	)
+}
+
+func TestWebhookHandler_CreateWebhookSubscription(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupWebhookTest(t)
+	r.POST("/webhook-subscriptions", func(c *gin.Context) {
+		c.Set("user_id", "11111111-1111-1111-1111-111111111111")
+		h.CreateWebhookSubscription(c)
+	})
+
+	// Test cases
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(mockUseCase *mockusecase.MockWebhookUseCase)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"url":"https://example.com/hook","event_types":["disaster.created"]}`,
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().CreateSubscription(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, s *model.WebhookSubscription) error {
+						assert.Equal(t, []string{"disaster.created"}, s.Events())
+						assert.Equal(t, "11111111-1111-1111-1111-111111111111", *s.CreatedBy)
+						s.ID = "sub-1"
+						s.Secret = "whsec_generated"
+						s.Active = true
+						return nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Missing Event Types",
+			body:           `{"url":"https://example.com/hook"}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockWebhookUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid URL",
+			body:           `{"url":"not a url","event_types":["disaster.created"]}`,
+			mockSetup:      func(mockUseCase *mockusecase.MockWebhookUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown Event Type",
+			body: `{"url":"https://example.com/hook","event_types":["disaster.deleted"]}`,
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().CreateSubscription(gomock.Any(), gomock.Any()).
+					Return(myerrors.NewValidationError(myerrors.FieldError{Field: "event_types[0]", Message: "invalid"}))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/webhook-subscriptions", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var response handler.CreateWebhookSubscriptionResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "sub-1", response.ID)
+				assert.Equal(t, "whsec_generated", response.Secret)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_GetWebhookSubscription(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupWebhookTest(t)
+	r.GET("/webhook-subscriptions/:id", h.GetWebhookSubscription)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		mockSetup      func(mockUseCase *mockusecase.MockWebhookUseCase)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().GetSubscription(gomock.Any(), "sub-1").
+					Return(&model.WebhookSubscription{ID: "sub-1", URL: "https://example.com/hook", EventTypes: `["disaster.created"]`, Secret: "whsec_secret"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().GetSubscription(gomock.Any(), "sub-1").Return(nil, webhookSubscriptionNotFoundError())
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/webhook-subscriptions/sub-1", nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				// シークレットは作成時以外は返さない
+				assert.NotContains(t, w.Body.String(), "whsec_secret")
+
+				var response handler.WebhookSubscriptionResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"disaster.created"}, response.EventTypes)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_ListWebhookDeliveries(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupWebhookTest(t)
+	r.GET("/webhook-subscriptions/:id/deliveries", h.ListWebhookDeliveries)
+
+	responseStatus := int32(500)
+
+	// Test cases
+	tests := []struct {
+		name               string
+		query              string
+		mockSetup          func(mockUseCase *mockusecase.MockWebhookUseCase)
+		expectedStatus     int
+		expectedDeliveries int
+	}{
+		{
+			name:  "Success",
+			query: "?limit=10",
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().ListDeliveries(gomock.Any(), "sub-1", 10).
+					Return([]*model.WebhookDelivery{
+						{ID: 2, SubscriptionID: "sub-1", EventType: "disaster.created", Payload: `{"id":"e1"}`, Status: "failed", Attempts: 3, ResponseStatus: &responseStatus},
+					}, nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedDeliveries: 1,
+		},
+		{
+			name:           "Invalid Limit",
+			query:          "?limit=500",
+			mockSetup:      func(mockUseCase *mockusecase.MockWebhookUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().ListDeliveries(gomock.Any(), "sub-1", 0).Return(nil, webhookSubscriptionNotFoundError())
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/webhook-subscriptions/sub-1/deliveries"+tt.query, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response handler.ListWebhookDeliveriesResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				if assert.Len(t, response.Deliveries, tt.expectedDeliveries) {
+					assert.JSONEq(t, `{"id":"e1"}`, string(response.Deliveries[0].Payload))
+					assert.Equal(t, &responseStatus, response.Deliveries[0].ResponseStatus)
+				}
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_SendTestWebhookEvent(t *testing.T) {
+	// Setup
+	r, mockUseCase, h := setupWebhookTest(t)
+	r.POST("/webhook-subscriptions/:id/test", h.SendTestWebhookEvent)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		mockSetup      func(mockUseCase *mockusecase.MockWebhookUseCase)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().SendTestEvent(gomock.Any(), "sub-1").
+					Return(&model.WebhookDelivery{ID: 9, SubscriptionID: "sub-1", EventType: "webhook.test", Payload: `{}`, Status: "succeeded"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().SendTestEvent(gomock.Any(), "sub-1").Return(nil, webhookSubscriptionNotFoundError())
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockUseCase *mockusecase.MockWebhookUseCase) {
+				mockUseCase.EXPECT().SendTestEvent(gomock.Any(), "sub-1").Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/webhook-subscriptions/sub-1/test", nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package i18n
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+)
+
+// Locale is a language the API returns messages in
+type Locale string
+
+const (
+	Japanese Locale = "ja"
+	English  Locale = "en"
+
+	// DefaultLocale is used when the client does not ask for a supported language
+	DefaultLocale = Japanese
+)
+
+// supported are the locales in the order the matcher prefers them; the first one is the default
+var supported = []Locale{Japanese, English}
+
+var matcher = language.NewMatcher([]language.Tag{language.Japanese, language.English})
+
+// Negotiate picks the locale that best matches an Accept-Language header, falling back to DefaultLocale
+func Negotiate(acceptLanguage string) Locale {
+	if acceptLanguage == "" {
+		return DefaultLocale
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLocale
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+
+	return supported[index]
+}
+
+// localeKey is the context key of the locale of the request
+type localeKey struct{}
+
+// WithLocale returns a copy of ctx carrying locale
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// FromContext returns the locale carried by ctx, or DefaultLocale when there is none
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(localeKey{}).(Locale); ok {
+		return locale
+	}
+
+	return DefaultLocale
+}
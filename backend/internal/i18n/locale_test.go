@@ -0,0 +1,62 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/i18n"
+)
+
+func TestNegotiate(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       i18n.Locale
+	}{
+		{
+			name:           "Empty",
+			acceptLanguage: "",
+			expected:       i18n.Japanese,
+		},
+		{
+			name:           "Japanese",
+			acceptLanguage: "ja-JP",
+			expected:       i18n.Japanese,
+		},
+		{
+			name:           "English Region",
+			acceptLanguage: "en-GB",
+			expected:       i18n.English,
+		},
+		{
+			name:           "Quality",
+			acceptLanguage: "ja;q=0.5, en;q=0.9",
+			expected:       i18n.English,
+		},
+		{
+			name:           "Unsupported Before Supported",
+			acceptLanguage: "fr-FR, en;q=0.8",
+			expected:       i18n.English,
+		},
+		{
+			name:           "Unsupported",
+			acceptLanguage: "fr-FR",
+			expected:       i18n.Japanese,
+		},
+		{
+			name:           "Malformed",
+			acceptLanguage: "en;q=abc",
+			expected:       i18n.Japanese,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Check results
+			assert.Equal(t, tt.expected, i18n.Negotiate(tt.acceptLanguage))
+		})
+	}
+}
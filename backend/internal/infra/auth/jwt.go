@@ -33,6 +33,7 @@ func (j *jwtClient) GenerateToken(ctx context.Context, user *model.User) (string
 		"user_id": user.ID,
 		"email":   user.Email,
 		"role":    user.Role,
+		"role_id": user.RoleID,
 		"iss":     j.config.Issuer,
 		"iat":     now.Unix(),
 		"exp":     now.Add(j.config.Expiration).Unix(),
@@ -0,0 +1,64 @@
+package datastore
+
+import (
+	"context"
+	"database/sql/driver"
+
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+// jobLockNamespace is the first key of the two-key advisory locks taken for jobs, so they cannot collide
+// with advisory locks taken for other purposes
+const jobLockNamespace = 0x6a6f62 // "job"
+
+type advisoryLocker struct {
+	client db.Client
+	l      *logger.Logger
+}
+
+// NewAdvisoryLocker returns a JobLocker backed by session-level Postgres advisory locks. The lock is held on a
+// dedicated connection, so it is released by Postgres as well if the instance dies while holding it.
+func NewAdvisoryLocker(l *logger.Logger, client db.Client) domain.JobLocker {
+	return &advisoryLocker{
+		client: client,
+		l:      l,
+	}
+}
+
+func (a *advisoryLocker) TryLock(ctx context.Context, name string) (func(), bool, error) {
+	sqlDB, err := a.client.Conn(ctx).DB()
+	if err != nil {
+		return nil, false, err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx,
+		"SELECT pg_try_advisory_lock($1, hashtext($2))", jobLockNamespace, name,
+	).Scan(&acquired); err != nil || !acquired {
+		conn.Close()
+		return nil, false, err
+	}
+
+	unlock := func() {
+		// 呼び出し元のコンテキストがキャンセルされていても解放する
+		if _, err := conn.ExecContext(context.Background(),
+			"SELECT pg_advisory_unlock($1, hashtext($2))", jobLockNamespace, name,
+		); err != nil {
+			a.l.ErrorContext(ctx, err, "Failed to release advisory lock", "name", name)
+
+			// ロックを保持したままの接続をプールへ戻さないよう破棄させる
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+
+		conn.Close()
+	}
+
+	return unlock, true, nil
+}
@@ -0,0 +1,50 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/domain/query"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type assessmentRepository struct {
+	client db.Client
+}
+
+func NewAssessmentRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.AssessmentRepository {
+	return &assessmentRepository{
+		client: client,
+	}
+}
+
+func (r *assessmentRepository) CountByDisasterIDForUpdate(
+	ctx context.Context,
+	disasterID string,
+	statuses []string,
+) (int64, error) {
+	var count int64
+
+	// 集計関数とFOR UPDATEは併用できないため、ロックした行を外側で数える
+	err := r.client.Conn(ctx).Raw(`
+		SELECT COUNT(*) FILTER (WHERE status IN ?)
+		FROM (SELECT status FROM assessments WHERE disaster_id = ? AND deleted_at IS NULL FOR UPDATE) AS locked`,
+		statuses, disasterID,
+	).Scan(&count).Error
+
+	return count, err
+}
+
+func (r *assessmentRepository) FindByStatusUpdatedBefore(ctx context.Context, status string, before time.Time) ([]*model.Assessment, error) {
+	q := query.Use(r.client.Conn(ctx))
+
+	return q.WithContext(ctx).Assessment.
+		Where(q.Assessment.Status.Eq(status), q.Assessment.UpdatedAt.Lt(before)).
+		Order(q.Assessment.ID).
+		Find()
+}
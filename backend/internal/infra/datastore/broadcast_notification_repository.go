@@ -0,0 +1,181 @@
+package datastore
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+// broadcastRecipientBatchSize is the number of recipient rows inserted per statement
+const broadcastRecipientBatchSize = 1000
+
+type broadcastNotificationRepository struct {
+	client db.Client
+}
+
+func NewBroadcastNotificationRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.BroadcastNotificationRepository {
+	return &broadcastNotificationRepository{
+		client: client,
+	}
+}
+
+func (r *broadcastNotificationRepository) Create(ctx context.Context, broadcast *model.BroadcastNotification) error {
+	conn := r.client.Conn(ctx)
+
+	condition, args, err := broadcastRecipientCondition(conn, broadcast)
+	if err != nil {
+		return err
+	}
+
+	var count int64
+	if err := conn.Table("users u").
+		Where("u.deleted_at IS NULL AND u.is_active AND "+condition, args...).
+		Count(&count).Error; err != nil {
+		return err
+	}
+
+	broadcast.RecipientCount = int32(count)
+
+	return conn.Create(broadcast).Error
+}
+
+func (r *broadcastNotificationRepository) ExpandRecipients(ctx context.Context, id int32) error {
+	return r.client.Transaction(ctx, func(ctx context.Context) error {
+		conn := r.client.Conn(ctx)
+
+		var broadcast model.BroadcastNotification
+		if err := conn.First(&broadcast, id).Error; err != nil {
+			return err
+		}
+
+		condition, args, err := broadcastRecipientCondition(conn, &broadcast)
+		if err != nil {
+			return err
+		}
+
+		// 受信者をユーザーID順にバッチで展開し、本文を持たない既読管理用の行だけを作る。
+		// 再試行で同じ受信者を展開しても行が重複しないよう、既存の行は読み飛ばす
+		now := time.Now()
+		lastUserID := "00000000-0000-0000-0000-000000000000"
+		for {
+			var batch struct {
+				LastUserID *string
+				Size       int
+			}
+			if err := conn.Raw(
+				`WITH batch AS (
+					SELECT u.id
+					FROM users u
+					WHERE u.deleted_at IS NULL AND u.is_active AND u.id > ? AND `+condition+`
+					ORDER BY u.id
+					LIMIT ?
+				), inserted AS (
+					INSERT INTO notifications (user_id, broadcast_id, notification_type, is_read, created_at, updated_at)
+					SELECT id, ?, ?, FALSE, ?, ?
+					FROM batch
+					ON CONFLICT (broadcast_id, user_id) WHERE broadcast_id IS NOT NULL DO NOTHING
+				)
+				SELECT (SELECT id::text FROM batch ORDER BY id DESC LIMIT 1) AS last_user_id,
+					(SELECT COUNT(*) FROM batch) AS size`,
+				append(append([]any{lastUserID}, args...),
+					broadcastRecipientBatchSize, broadcast.ID, broadcast.NotificationType, now, now)...,
+			).Scan(&batch).Error; err != nil {
+				return err
+			}
+
+			if batch.Size < broadcastRecipientBatchSize || batch.LastUserID == nil {
+				break
+			}
+
+			lastUserID = *batch.LastUserID
+		}
+
+		// 受付時の見込みではなく、実際に展開した受信者数に更新する
+		if err := conn.Exec(
+			"UPDATE broadcast_notifications SET recipient_count = (SELECT COUNT(*) FROM notifications WHERE broadcast_id = ?) WHERE id = ?",
+			broadcast.ID, broadcast.ID,
+		).Error; err != nil {
+			return err
+		}
+
+		// 受信者ごとではなく1回だけ通知し、各インスタンスの購読者全員に再取得させる
+		return conn.Exec("SELECT pg_notify(?, ?)", NotificationCreatedChannel, NotificationBroadcastPayload).Error
+	})
+}
+
+// broadcastRecipientCondition builds the condition on users u that selects the broadcast target
+func broadcastRecipientCondition(conn *gorm.DB, broadcast *model.BroadcastNotification) (string, []any, error) {
+	targetID := ""
+	if broadcast.TargetID != nil {
+		targetID = *broadcast.TargetID
+	}
+
+	switch broadcast.TargetType {
+	case model.BroadcastTargetAll:
+		return "TRUE", nil, nil
+	case model.BroadcastTargetRole:
+		roleID, err := strconv.ParseInt(targetID, 10, 16)
+		if err != nil {
+			return "", nil, err
+		}
+
+		var count int64
+		if err := conn.Table(model.TableNameRole).Where("id = ?", roleID).Count(&count).Error; err != nil {
+			return "", nil, err
+		}
+		if count == 0 {
+			return "", nil, gorm.ErrRecordNotFound
+		}
+
+		return "u.role_id = ?", []any{roleID}, nil
+	case model.BroadcastTargetOrganization, model.BroadcastTargetRegion:
+		organizationID, err := strconv.ParseInt(targetID, 10, 64)
+		if err != nil {
+			return "", nil, err
+		}
+
+		root := "id = ?"
+		args := []any{organizationID}
+		if broadcast.TargetType == model.BroadcastTargetRegion {
+			root = "id = ? AND type = 'regional'"
+		}
+
+		return organizationSubtreeCondition(conn, root, args...)
+	case model.BroadcastTargetPrefecture:
+		// 都道府県の組織は都道府県マスタと同じ名称で登録されている
+		return organizationSubtreeCondition(conn,
+			"type = 'prefecture' AND name = (SELECT name FROM prefectures WHERE code = ?)", targetID)
+	default:
+		return "", nil, gorm.ErrRecordNotFound
+	}
+}
+
+// organizationSubtreeCondition selects users belonging to the organizations matching root or any of their descendants
+func organizationSubtreeCondition(conn *gorm.DB, root string, args ...any) (string, []any, error) {
+	var organizationIDs []int64
+	if err := conn.Raw(
+		`WITH RECURSIVE tree AS (
+			SELECT id FROM organizations WHERE `+root+`
+			UNION
+			SELECT o.id FROM organizations o JOIN tree t ON o.parent_id = t.id
+		)
+		SELECT id FROM tree`,
+		args...,
+	).Scan(&organizationIDs).Error; err != nil {
+		return "", nil, err
+	}
+	if len(organizationIDs) == 0 {
+		return "", nil, gorm.ErrRecordNotFound
+	}
+
+	return "u.organization_id IN ?", []any{organizationIDs}, nil
+}
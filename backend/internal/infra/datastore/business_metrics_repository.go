@@ -0,0 +1,81 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type businessMetricsRepository struct {
+	client db.Client
+}
+
+func NewBusinessMetricsRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.BusinessMetricsRepository {
+	return &businessMetricsRepository{
+		client: client,
+	}
+}
+
+func (r *businessMetricsRepository) CountDisastersByStatus(ctx context.Context) ([]*model.GroupCount, error) {
+	var counts []*model.GroupCount
+	// 論理削除された災害はModel経由で除外される
+	err := r.client.Conn(ctx).Model(&model.Disaster{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r *businessMetricsRepository) CountSupportApplicationsByStatus(
+	ctx context.Context,
+	excludeStatuses []string,
+) ([]*model.GroupCount, error) {
+	var counts []*model.GroupCount
+	err := r.client.Conn(ctx).Model(&model.SupportApplication{}).
+		Select("status, COUNT(*) AS count").
+		Where("status NOT IN ?", excludeStatuses).
+		Group("status").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r *businessMetricsRepository) CountJobsByTypeAndStatus(ctx context.Context, statuses []string) ([]*model.GroupCount, error) {
+	var counts []*model.GroupCount
+	err := r.client.Conn(ctx).Model(&model.Job{}).
+		Select(`job_type AS "group", status, COUNT(*) AS count`).
+		Where("status IN ?", statuses).
+		Group("job_type, status").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r *businessMetricsRepository) CountEmailsByTypeAndStatus(ctx context.Context, status string) ([]*model.GroupCount, error) {
+	var counts []*model.GroupCount
+	err := r.client.Conn(ctx).Model(&model.EmailHistory{}).
+		Select(`email_type AS "group", status, COUNT(*) AS count`).
+		Where("status = ?", status).
+		Group("email_type, status").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
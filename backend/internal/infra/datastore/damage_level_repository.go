@@ -1,18 +1,15 @@
-//go:generate mockgen -source=damage_level_repository.go -destination=../../../tests/mock/datastore/damage_level_repository.mock.go
 package datastore
 
 import (
 	"context"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
-	"github.com/AI1411/fullstack-react-go/internal/domain/query"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
 	"github.com/AI1411/fullstack-react-go/internal/infra/db"
 )
 
 type damageLevelRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewDamageLevelRepository(
@@ -21,7 +18,6 @@ func NewDamageLevelRepository(
 ) domain.DamageLevelRepository {
 	return &damageLevelRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
@@ -5,8 +5,13 @@ import (
 	"context"
 	"time"
 
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/domain/query"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
 	"github.com/AI1411/fullstack-react-go/internal/infra/db"
 )
 
@@ -23,14 +28,21 @@ type DisasterSearchParams struct {
 type DisasterRepository interface {
 	Find(ctx context.Context, params *DisasterSearchParams) ([]*model.Disaster, error)
 	FindByID(ctx context.Context, id string) (*model.Disaster, error)
-	Create(ctx context.Context, disaster *model.Disaster) error
-	Update(ctx context.Context, disaster *model.Disaster) error
-	Delete(ctx context.Context, id string) error
+	// Create stores the disaster and writes the events to the outbox in the same transaction
+	Create(ctx context.Context, disaster *model.Disaster, events ...*model.OutboxEvent) error
+	// Update saves the disaster only if its updated_at still equals expectedUpdatedAt, and writes the events to
+	// the outbox in the same transaction. domain.ErrVersionConflict is returned when the row was modified in the meantime.
+	Update(ctx context.Context, disaster *model.Disaster, expectedUpdatedAt time.Time, events ...*model.OutboxEvent) error
+	// LockByID locks the live disaster until the transaction of ctx ends, which also keeps children from being
+	// added to it. It returns gorm.ErrRecordNotFound when no live disaster has the ID.
+	LockByID(ctx context.Context, id string) error
+	// SoftDelete soft-deletes the disaster together with the given children in one transaction, and returns the
+	// number of soft-deleted children per table.
+	SoftDelete(ctx context.Context, id string, children []model.SoftDeleteRelation) (map[string]int64, error)
 }
 
 type disasterRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewDisasterRepository(
@@ -39,44 +51,45 @@ func NewDisasterRepository(
 ) DisasterRepository {
 	return &disasterRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
 func (r *disasterRepository) Find(ctx context.Context, params *DisasterSearchParams) ([]*model.Disaster, error) {
-	q := r.query.WithContext(ctx).Disaster.
-		Preload(r.query.Disaster.Municipality).
-		Preload(r.query.Disaster.WorkCategory)
+	q := query.Use(r.client.Conn(ctx))
+
+	do := q.WithContext(ctx).Disaster.
+		Preload(q.Disaster.Municipality).
+		Preload(q.Disaster.WorkCategory)
 
 	// Apply filters if provided
 	if params != nil {
 		if params.Name != "" {
-			q = q.Where(r.query.Disaster.Name.Like("%" + params.Name + "%"))
+			do = do.Where(q.Disaster.Name.Like("%" + params.Name + "%"))
 		}
 
 		if params.WorkCategoryID != 0 {
-			q = q.Where(r.query.Disaster.WorkCategoryID.Eq(params.WorkCategoryID))
+			do = do.Where(q.Disaster.WorkCategoryID.Eq(params.WorkCategoryID))
 		}
 
 		if params.Status != "" {
-			q = q.Where(r.query.Disaster.Status.Eq(params.Status))
+			do = do.Where(q.Disaster.Status.Eq(params.Status))
 		}
 
 		if params.MunicipalityID != 0 {
-			q = q.Where(r.query.Disaster.MunicipalityID.Eq(params.MunicipalityID))
+			do = do.Where(q.Disaster.MunicipalityID.Eq(params.MunicipalityID))
 		}
 
 		// Apply date range filter if both start and end dates are provided
 		if !params.StartDate.IsZero() && !params.EndDate.IsZero() {
-			q = q.Where(r.query.Disaster.OccurredAt.Between(params.StartDate, params.EndDate))
+			do = do.Where(q.Disaster.OccurredAt.Between(params.StartDate, params.EndDate))
 		} else if !params.StartDate.IsZero() {
-			q = q.Where(r.query.Disaster.OccurredAt.Gte(params.StartDate))
+			do = do.Where(q.Disaster.OccurredAt.Gte(params.StartDate))
 		} else if !params.EndDate.IsZero() {
-			q = q.Where(r.query.Disaster.OccurredAt.Lte(params.EndDate))
+			do = do.Where(q.Disaster.OccurredAt.Lte(params.EndDate))
 		}
 	}
 
-	disasters, err := q.Find()
+	disasters, err := do.Find()
 	if err != nil {
 		return nil, err
 	}
@@ -85,11 +98,13 @@ func (r *disasterRepository) Find(ctx context.Context, params *DisasterSearchPar
 }
 
 func (r *disasterRepository) FindByID(ctx context.Context, id string) (*model.Disaster, error) {
-	disaster, err := r.query.WithContext(ctx).
+	q := query.Use(r.client.Conn(ctx))
+
+	disaster, err := q.WithContext(ctx).
 		Disaster.
-		Where(r.query.Disaster.ID.Eq(id)).
-		Preload(r.query.Disaster.Municipality).
-		Preload(r.query.Disaster.WorkCategory).
+		Where(q.Disaster.ID.Eq(id)).
+		Preload(q.Disaster.Municipality).
+		Preload(q.Disaster.WorkCategory).
 		First()
 	if err != nil {
 		return nil, err
@@ -98,17 +113,95 @@ func (r *disasterRepository) FindByID(ctx context.Context, id string) (*model.Di
 	return disaster, nil
 }
 
-func (r *disasterRepository) Create(ctx context.Context, disaster *model.Disaster) error {
-	return r.query.WithContext(ctx).Disaster.Create(disaster)
+func (r *disasterRepository) Create(ctx context.Context, disaster *model.Disaster, events ...*model.OutboxEvent) error {
+	return r.client.Transaction(ctx, func(ctx context.Context) error {
+		if err := query.Use(r.client.Conn(ctx)).WithContext(ctx).Disaster.Create(disaster); err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(r.client.Conn(ctx), events)
+	})
 }
 
-func (r *disasterRepository) Update(ctx context.Context, disaster *model.Disaster) error {
-	_, err := r.query.WithContext(ctx).Disaster.Where(r.query.Disaster.ID.Eq(disaster.ID)).Updates(disaster)
-	return err
+func (r *disasterRepository) Update(
+	ctx context.Context,
+	disaster *model.Disaster,
+	expectedUpdatedAt time.Time,
+	events ...*model.OutboxEvent,
+) error {
+	return r.client.Transaction(ctx, func(ctx context.Context) error {
+		q := query.Use(r.client.Conn(ctx))
+
+		// 関連（自治体・工種区分）は更新対象から外し、外部キーの変更が関連の値で上書きされないようにする
+		// updated_atを条件に含めることで、読み込み後に他のリクエストが更新していた場合は0件更新となる
+		result, err := q.WithContext(ctx).Disaster.
+			Omit(field.AssociationFields).
+			Where(q.Disaster.ID.Eq(disaster.ID), q.Disaster.UpdatedAt.Eq(expectedUpdatedAt)).
+			Updates(disaster)
+		if err != nil {
+			return err
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrVersionConflict
+		}
+
+		return insertOutboxEvents(r.client.Conn(ctx), events)
+	})
 }
 
-func (r *disasterRepository) Delete(ctx context.Context, id string) error {
-	_, err := r.query.WithContext(ctx).Disaster.Where(r.query.Disaster.ID.Eq(id)).Delete()
+func (r *disasterRepository) LockByID(ctx context.Context, id string) error {
+	var locked []string
+
+	// 子レコードの追加は外部キーの検査で災害の行を共有ロックするため、FOR UPDATEで追加も待たせる
+	if err := r.client.Conn(ctx).
+		Table(model.TableNameDisaster).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Pluck("id", &locked).Error; err != nil {
+		return err
+	}
+
+	if len(locked) == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *disasterRepository) SoftDelete(
+	ctx context.Context,
+	id string,
+	children []model.SoftDeleteRelation,
+) (map[string]int64, error) {
+	// 災害と子レコードに同じ削除日時を記録し、復元時に同時に削除されたものを特定できるようにする
+	deletedAt := time.Now().Truncate(time.Microsecond)
+	deleted := make(map[string]int64)
+
+	err := r.client.Transaction(ctx, func(ctx context.Context) error {
+		conn := r.client.Conn(ctx)
+
+		if err := softDeleteChildrenOf(conn, children, []string{id}, deletedAt, deleted); err != nil {
+			return err
+		}
+
+		result := conn.Session(&gorm.Session{NewDB: true}).
+			Table(model.TableNameDisaster).
+			Where("id = ? AND deleted_at IS NULL", id).
+			Update("deleted_at", deletedAt)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return deleted, nil
 }
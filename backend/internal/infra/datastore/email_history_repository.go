@@ -1,4 +1,3 @@
-//go:generate mockgen -source=email_history_repository.go -destination=../../tests/mock/datastore/email_history_repository.mock.go
 package datastore
 
 import (
@@ -12,18 +11,18 @@ import (
 
 type emailRepository struct {
 	dbClient db.Client
-	query    *query.Query
 }
 
 func NewEmailHistoryRepository(ctx context.Context, dbClient db.Client) domain.EmailHistoryRepository {
 	return &emailRepository{
 		dbClient: dbClient,
-		query:    query.Use(dbClient.Conn(ctx)),
 	}
 }
 
 func (r *emailRepository) SaveEmailHistory(ctx context.Context, email *model.EmailHistory) error {
-	err := r.query.WithContext(ctx).EmailHistory.Create(email)
+	q := query.Use(r.dbClient.Conn(ctx))
+
+	err := q.WithContext(ctx).EmailHistory.Create(email)
 	if err != nil {
 		return err
 	}
@@ -32,11 +31,13 @@ func (r *emailRepository) SaveEmailHistory(ctx context.Context, email *model.Ema
 }
 
 func (r *emailRepository) ListEmailHistoriesByUserID(ctx context.Context, userID string) ([]*model.EmailHistory, error) {
-	q := r.query.WithContext(ctx).EmailHistory.
-		Where(r.query.EmailHistory.UserID.Eq(userID)).
-		Order(r.query.EmailHistory.SentAt.Desc())
+	q := query.Use(r.dbClient.Conn(ctx))
+
+	do := q.WithContext(ctx).EmailHistory.
+		Where(q.EmailHistory.UserID.Eq(userID)).
+		Order(q.EmailHistory.SentAt.Desc())
 
-	emailHistories, err := q.Find()
+	emailHistories, err := do.Find()
 	if err != nil {
 		return nil, err
 	}
@@ -15,7 +15,6 @@ import (
 
 type emailVarificationTokenRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewEmailVarificationTokenRepository(
@@ -24,22 +23,25 @@ func NewEmailVarificationTokenRepository(
 ) domain.EmailVarificationTokenRepository {
 	return &emailVarificationTokenRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
 func (e *emailVarificationTokenRepository) Save(ctx context.Context, token *model.EmailVerificationToken) error {
-	if err := e.query.WithContext(ctx).EmailVerificationToken.Create(token); err != nil {
+	q := query.Use(e.client.Conn(ctx))
+
+	if err := q.WithContext(ctx).EmailVerificationToken.Create(token); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (e *emailVarificationTokenRepository) FindByToken(ctx context.Context, token string) (*model.EmailVerificationToken, error) {
-	verificationToken, err := e.query.WithContext(ctx).
+	q := query.Use(e.client.Conn(ctx))
+
+	verificationToken, err := q.WithContext(ctx).
 		EmailVerificationToken.
 		Where(
-			e.query.EmailVerificationToken.Token.Eq(token),
+			q.EmailVerificationToken.Token.Eq(token),
 		).
 		First()
 	if err != nil {
@@ -57,12 +59,14 @@ func (e *emailVarificationTokenRepository) FindByToken(ctx context.Context, toke
 }
 
 func (e *emailVarificationTokenRepository) MarkAsUsed(ctx context.Context, tokenID string) error {
-	result, err := e.query.WithContext(ctx).
+	q := query.Use(e.client.Conn(ctx))
+
+	result, err := q.WithContext(ctx).
 		EmailVerificationToken.
 		Where(
-			e.query.EmailVerificationToken.ID.Eq(tokenID),
+			q.EmailVerificationToken.ID.Eq(tokenID),
 		).
-		Update(e.query.EmailVerificationToken.IsUsed, true)
+		Update(q.EmailVerificationToken.IsUsed, true)
 	if err != nil {
 		return err
 	}
@@ -1,18 +1,18 @@
-//go:generate mockgen -source=facility_equipment_repository.go -destination=../../../tests/mock/datastore/facility_equipment_repository.mock.go
 package datastore
 
 import (
 	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
-	"github.com/AI1411/fullstack-react-go/internal/domain/query"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
 	"github.com/AI1411/fullstack-react-go/internal/infra/db"
 )
 
 type facilityEquipmentRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewFacilityEquipmentRepository(
@@ -21,7 +21,6 @@ func NewFacilityEquipmentRepository(
 ) domain.FacilityEquipmentRepository {
 	return &facilityEquipmentRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
@@ -47,8 +46,26 @@ func (r *facilityEquipmentRepository) Create(ctx context.Context, facilityEquipm
 	return r.client.Conn(ctx).Create(facilityEquipment).Error
 }
 
-func (r *facilityEquipmentRepository) Update(ctx context.Context, facilityEquipment *model.FacilityEquipment) error {
-	return r.client.Conn(ctx).Save(facilityEquipment).Error
+func (r *facilityEquipmentRepository) Update(
+	ctx context.Context,
+	facilityEquipment *model.FacilityEquipment,
+	expectedUpdatedAt time.Time,
+) error {
+	// Saveと同様に全カラムを更新しつつ、updated_atが読み込み時から変わっていない場合のみ更新する
+	result := r.client.Conn(ctx).
+		Select("*").
+		Omit(clause.Associations, "created_at").
+		Where("updated_at = ?", expectedUpdatedAt).
+		Updates(facilityEquipment)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrVersionConflict
+	}
+
+	return nil
 }
 
 func (r *facilityEquipmentRepository) Delete(ctx context.Context, id int32) error {
@@ -0,0 +1,98 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type idempotencyKeyRepository struct {
+	client db.Client
+}
+
+func NewIdempotencyKeyRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.IdempotencyKeyRepository {
+	return &idempotencyKeyRepository{
+		client: client,
+	}
+}
+
+func (r *idempotencyKeyRepository) Reserve(ctx context.Context, key *model.IdempotencyKey) (bool, error) {
+	// 同じキーが同時に送られた場合でも1件だけが予約できるよう、INSERT ... ON CONFLICTで判定する
+	// 有効期限切れのキーは新しいリクエストで上書きする
+	result := r.client.Conn(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "scope"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"request_method",
+			"request_path",
+			"request_fingerprint",
+			"response_status",
+			"response_content_type",
+			"response_body",
+			"expires_at",
+			"created_at",
+			"updated_at",
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Lte{Column: clause.Column{Table: model.TableNameIdempotencyKey, Name: "expires_at"}, Value: time.Now()},
+		}},
+	}).Create(key)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *idempotencyKeyRepository) FindByKey(ctx context.Context, scope, key string) (*model.IdempotencyKey, error) {
+	var idempotencyKey model.IdempotencyKey
+	if err := r.client.Conn(ctx).
+		Where("scope = ? AND key = ? AND expires_at > ?", scope, key, time.Now()).
+		First(&idempotencyKey).Error; err != nil {
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+func (r *idempotencyKeyRepository) Complete(
+	ctx context.Context,
+	scope string,
+	key string,
+	status int32,
+	contentType string,
+	body []byte,
+) error {
+	return r.client.Conn(ctx).
+		Model(&model.IdempotencyKey{}).
+		Where("scope = ? AND key = ?", scope, key).
+		Updates(map[string]any{
+			"response_status":       status,
+			"response_content_type": contentType,
+			"response_body":         body,
+			"updated_at":            gorm.Expr("CURRENT_TIMESTAMP"),
+		}).Error
+}
+
+func (r *idempotencyKeyRepository) Release(ctx context.Context, scope, key string) error {
+	return r.client.Conn(ctx).
+		Where("scope = ? AND key = ? AND response_status IS NULL", scope, key).
+		Delete(&model.IdempotencyKey{}).Error
+}
+
+func (r *idempotencyKeyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.client.Conn(ctx).Where("expires_at <= ?", now).Delete(&model.IdempotencyKey{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,146 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type jobRepository struct {
+	client db.Client
+}
+
+func NewJobRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.JobRepository {
+	return &jobRepository{
+		client: client,
+	}
+}
+
+func (r *jobRepository) Enqueue(ctx context.Context, job *model.Job) error {
+	return r.client.Conn(ctx).Create(job).Error
+}
+
+func (r *jobRepository) Claim(ctx context.Context, workerID string, now, staleBefore time.Time) (*model.Job, error) {
+	// SKIP LOCKEDで他のワーカーが選択中の行を飛ばし、取り出しと実行中への更新を1文で行う
+	var jobs []*model.Job
+	err := r.client.Conn(ctx).Raw(`
+UPDATE jobs
+SET status     = ?,
+    attempts   = attempts + 1,
+    locked_by  = ?,
+    locked_at  = ?,
+    updated_at = ?
+WHERE id = (SELECT id
+            FROM jobs
+            WHERE (status = ? AND run_at <= ?)
+               OR (status = ? AND locked_at < ?)
+            ORDER BY run_at, id
+            LIMIT 1 FOR UPDATE SKIP LOCKED)
+RETURNING *`,
+		model.JobStatusRunning, workerID, now, now,
+		model.JobStatusPending, now,
+		model.JobStatusRunning, staleBefore,
+	).Scan(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	return jobs[0], nil
+}
+
+func (r *jobRepository) MarkSucceeded(ctx context.Context, job *model.Job, finishedAt time.Time) error {
+	return r.finishClaimed(ctx, job, map[string]any{
+		"status":      model.JobStatusSucceeded,
+		"locked_by":   nil,
+		"locked_at":   nil,
+		"finished_at": finishedAt,
+		"updated_at":  finishedAt,
+	})
+}
+
+func (r *jobRepository) MarkRetry(ctx context.Context, job *model.Job, runAt time.Time, lastError string) error {
+	return r.finishClaimed(ctx, job, map[string]any{
+		"status":     model.JobStatusPending,
+		"run_at":     runAt,
+		"locked_by":  nil,
+		"locked_at":  nil,
+		"last_error": lastError,
+		"updated_at": time.Now(),
+	})
+}
+
+func (r *jobRepository) MarkDead(ctx context.Context, job *model.Job, finishedAt time.Time, lastError string) error {
+	return r.finishClaimed(ctx, job, map[string]any{
+		"status":      model.JobStatusDead,
+		"locked_by":   nil,
+		"locked_at":   nil,
+		"last_error":  lastError,
+		"finished_at": finishedAt,
+		"updated_at":  finishedAt,
+	})
+}
+
+// finishClaimed updates the job only while the worker still holds it. When the job was reclaimed after
+// exceeding the lock timeout, the new owner records the result instead.
+func (r *jobRepository) finishClaimed(ctx context.Context, job *model.Job, values map[string]any) error {
+	return r.client.Conn(ctx).Model(&model.Job{}).
+		Where("id = ? AND status = ? AND locked_by = ?", job.ID, model.JobStatusRunning, job.LockedBy).
+		Updates(values).Error
+}
+
+func (r *jobRepository) Find(ctx context.Context, filter model.JobFilter) ([]*model.Job, error) {
+	q := r.client.Conn(ctx).Model(&model.Job{})
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if filter.JobType != "" {
+		q = q.Where("job_type = ?", filter.JobType)
+	}
+	if filter.BeforeID > 0 {
+		q = q.Where("id < ?", filter.BeforeID)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	var jobs []*model.Job
+	if err := q.Order("id DESC").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r *jobRepository) Retry(ctx context.Context, id int64, now time.Time) (*model.Job, error) {
+	var job model.Job
+	result := r.client.Conn(ctx).Model(&job).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND status = ?", id, model.JobStatusDead).
+		Updates(map[string]any{
+			"status":      model.JobStatusPending,
+			"attempts":    0,
+			"run_at":      now,
+			"finished_at": nil,
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &job, nil
+}
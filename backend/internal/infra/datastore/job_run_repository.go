@@ -0,0 +1,42 @@
+package datastore
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type jobRunRepository struct {
+	client db.Client
+}
+
+func NewJobRunRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.JobRunRepository {
+	return &jobRunRepository{
+		client: client,
+	}
+}
+
+func (r *jobRunRepository) Start(ctx context.Context, run *model.JobRun) (bool, error) {
+	// 同じ予定日時の実行が別インスタンスで済んでいれば何もしない
+	result := r.client.Conn(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(run)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *jobRunRepository) Finish(ctx context.Context, run *model.JobRun) error {
+	return r.client.Conn(ctx).Model(run).
+		Select("status", "processed_count", "error_message", "finished_at").
+		Updates(run).Error
+}
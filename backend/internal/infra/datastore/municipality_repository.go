@@ -0,0 +1,37 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/domain/query"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type municipalityRepository struct {
+	client db.Client
+}
+
+func NewMunicipalityRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.MunicipalityRepository {
+	return &municipalityRepository{
+		client: client,
+	}
+}
+
+func (r *municipalityRepository) FindByID(ctx context.Context, id int32) (*model.Municipality, error) {
+	q := query.Use(r.client.Conn(ctx))
+
+	municipality, err := q.WithContext(ctx).
+		Municipality.
+		Where(q.Municipality.ID.Eq(id)).
+		First()
+	if err != nil {
+		return nil, err
+	}
+
+	return municipality, nil
+}
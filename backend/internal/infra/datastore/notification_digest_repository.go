@@ -0,0 +1,49 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type notificationDigestRepository struct {
+	client db.Client
+}
+
+func NewNotificationDigestRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.NotificationDigestRepository {
+	return &notificationDigestRepository{
+		client: client,
+	}
+}
+
+func (r *notificationDigestRepository) Create(ctx context.Context, entry *model.NotificationDigestEntry) error {
+	return r.client.Conn(ctx).Create(entry).Error
+}
+
+func (r *notificationDigestRepository) FindPending(ctx context.Context) ([]*model.NotificationDigestEntry, error) {
+	var entries []*model.NotificationDigestEntry
+	if err := r.client.Conn(ctx).
+		Where("sent_at IS NULL").
+		Order("user_id, id").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *notificationDigestRepository) MarkSent(ctx context.Context, ids []int64, sentAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.client.Conn(ctx).Model(&model.NotificationDigestEntry{}).
+		Where("id IN ? AND sent_at IS NULL", ids).
+		Update("sent_at", sentAt).Error
+}
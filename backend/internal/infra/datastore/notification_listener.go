@@ -0,0 +1,194 @@
+package datastore
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+// NotificationCreatedChannel is the Postgres NOTIFY channel carrying the user ID of each new notification
+const NotificationCreatedChannel = "notification_created"
+
+// NotificationBroadcastPayload is sent on NotificationCreatedChannel instead of a user ID when a broadcast
+// reaches many users at once, so a single NOTIFY wakes every subscriber
+const NotificationBroadcastPayload = "*"
+
+const (
+	listenRetryMinInterval = time.Second
+	listenRetryMaxInterval = 30 * time.Second
+)
+
+// NotificationListener LISTENs on NotificationCreatedChannel over a dedicated connection and fans
+// the signals out to the subscribers of this API instance. It implements domain.NotificationStream.
+type NotificationListener struct {
+	client db.Client
+	l      *logger.Logger
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewNotificationListener(l *logger.Logger, client db.Client) *NotificationListener {
+	return &NotificationListener{
+		client:      client,
+		l:           l,
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+func (n *NotificationListener) Subscribe(userID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	n.mu.Lock()
+	if n.subscribers[userID] == nil {
+		n.subscribers[userID] = make(map[chan struct{}]struct{})
+	}
+	n.subscribers[userID][ch] = struct{}{}
+	n.mu.Unlock()
+
+	unsubscribe := func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		delete(n.subscribers[userID], ch)
+		if len(n.subscribers[userID]) == 0 {
+			delete(n.subscribers, userID)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// Start begins listening in the background until Stop is called
+func (n *NotificationListener) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	n.cancel = cancel
+	n.done = make(chan struct{})
+
+	go n.run(ctx)
+}
+
+// Stop stops listening and waits for the background goroutine to exit
+func (n *NotificationListener) Stop(ctx context.Context) error {
+	if n.cancel == nil {
+		return nil
+	}
+
+	n.cancel()
+
+	select {
+	case <-n.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (n *NotificationListener) run(ctx context.Context) {
+	defer close(n.done)
+
+	retryInterval := listenRetryMinInterval
+	for {
+		err := n.listen(ctx, func() { retryInterval = listenRetryMinInterval })
+		if ctx.Err() != nil {
+			return
+		}
+
+		n.l.ErrorContext(ctx, err, "Notification listener disconnected", "retry_in", retryInterval.String())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+
+		retryInterval = min(retryInterval*2, listenRetryMaxInterval)
+	}
+}
+
+// listen holds a connection from the pool for LISTEN and dispatches notifications until an error occurs.
+// onListening is called once the LISTEN is in place.
+func (n *NotificationListener) listen(ctx context.Context, onListening func()) error {
+	sqlDB, err := n.client.Conn(ctx).DB()
+	if err != nil {
+		return err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver connection type %T", driverConn)
+		}
+
+		pgxConn := stdlibConn.Conn()
+		if _, err := pgxConn.Exec(ctx, "LISTEN "+pgx.Identifier{NotificationCreatedChannel}.Sanitize()); err != nil {
+			return err
+		}
+
+		onListening()
+
+		// 切断中に作成された通知を取りこぼさないよう、接続のたびに全購読者へ再取得を促す
+		n.broadcast()
+
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				// LISTEN状態の接続をプールへ戻さないよう破棄させる
+				return errors.Join(driver.ErrBadConn, err)
+			}
+
+			if notification.Payload == NotificationBroadcastPayload {
+				n.broadcast()
+				continue
+			}
+			n.dispatch(notification.Payload)
+		}
+	})
+}
+
+// dispatch signals every subscriber of the user without blocking
+func (n *NotificationListener) dispatch(userID string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for ch := range n.subscribers[userID] {
+		signal(ch)
+	}
+}
+
+func (n *NotificationListener) broadcast() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, chs := range n.subscribers {
+		for ch := range chs {
+			signal(ch)
+		}
+	}
+}
+
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+		// 未処理のシグナルがあれば、購読者はそれを受け取った時点でまとめて取得する
+	}
+}
@@ -0,0 +1,66 @@
+package datastore
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type notificationPreferenceRepository struct {
+	client db.Client
+}
+
+func NewNotificationPreferenceRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{
+		client: client,
+	}
+}
+
+func (r *notificationPreferenceRepository) FindByUserID(ctx context.Context, userID string) ([]*model.NotificationPreference, error) {
+	var preferences []*model.NotificationPreference
+	if err := r.client.Conn(ctx).
+		Where("user_id = ?", userID).
+		Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func (r *notificationPreferenceRepository) FindByUserIDAndType(
+	ctx context.Context,
+	userID, notificationType string,
+) (*model.NotificationPreference, error) {
+	var preference model.NotificationPreference
+	if err := r.client.Conn(ctx).
+		Where("user_id = ? AND notification_type = ?", userID, notificationType).
+		First(&preference).Error; err != nil {
+		return nil, err
+	}
+
+	return &preference, nil
+}
+
+func (r *notificationPreferenceRepository) Upsert(ctx context.Context, preferences []*model.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	return r.client.Conn(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "notification_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"in_app",
+			"email",
+			"email_frequency",
+			"webhook",
+			"webhook_url",
+		}),
+	}).Create(&preferences).Error
+}
@@ -1,10 +1,11 @@
-//go:generate mockgen -source=notification_repository.go -destination=../../../tests/mock/datastore/notification_repository.mock.go
 package datastore
 
 import (
 	"context"
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
 	"github.com/AI1411/fullstack-react-go/internal/infra/db"
@@ -23,9 +24,23 @@ func NewNotificationRepository(
 	}
 }
 
+// withBroadcastContent fills the title, message and related entity of broadcast recipients' rows from the
+// broadcast, since those rows only hold the per-user read state
+func withBroadcastContent(db *gorm.DB) *gorm.DB {
+	return db.
+		Select(`notifications.id, notifications.user_id, notifications.notification_type, notifications.is_read,
+			notifications.read_at, notifications.created_at, notifications.updated_at, notifications.deleted_at,
+			notifications.broadcast_id,
+			COALESCE(notifications.title, b.title) AS title,
+			COALESCE(notifications.message, b.message) AS message,
+			COALESCE(notifications.related_entity_type, b.related_entity_type) AS related_entity_type,
+			COALESCE(notifications.related_entity_id, b.related_entity_id) AS related_entity_id`).
+		Joins("LEFT JOIN broadcast_notifications b ON b.id = notifications.broadcast_id")
+}
+
 func (r *notificationRepository) Find(ctx context.Context) ([]*model.Notification, error) {
 	var notifications []*model.Notification
-	if err := r.client.Conn(ctx).Find(&notifications).Error; err != nil {
+	if err := r.client.Conn(ctx).Scopes(withBroadcastContent).Find(&notifications).Error; err != nil {
 		return nil, err
 	}
 
@@ -34,27 +49,68 @@ func (r *notificationRepository) Find(ctx context.Context) ([]*model.Notificatio
 
 func (r *notificationRepository) FindByID(ctx context.Context, id int32) (*model.Notification, error) {
 	var notification model.Notification
-	if err := r.client.Conn(ctx).Where("id = ?", id).First(&notification).Error; err != nil {
+	if err := r.client.Conn(ctx).Scopes(withBroadcastContent).Where("notifications.id = ?", id).First(&notification).Error; err != nil {
 		return nil, err
 	}
 
 	return &notification, nil
 }
 
-func (r *notificationRepository) FindByUserID(ctx context.Context, userID int32) ([]*model.Notification, error) {
+func (r *notificationRepository) FindByUserID(ctx context.Context, userID string) ([]*model.Notification, error) {
 	var notifications []*model.Notification
-	if err := r.client.Conn(ctx).Where("user_id = ?", userID).Find(&notifications).Error; err != nil {
+	if err := r.client.Conn(ctx).Scopes(withBroadcastContent).
+		Where("notifications.user_id = ?", userID).
+		Order("notifications.id DESC").
+		Find(&notifications).Error; err != nil {
 		return nil, err
 	}
 
 	return notifications, nil
 }
 
+func (r *notificationRepository) FindByUserIDAfter(ctx context.Context, userID string, afterID int32) ([]*model.Notification, error) {
+	var notifications []*model.Notification
+	if err := r.client.Conn(ctx).Scopes(withBroadcastContent).
+		Where("notifications.user_id = ? AND notifications.id > ?", userID, afterID).
+		Order("notifications.id").
+		Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *notificationRepository) FindLatestIDByUserID(ctx context.Context, userID string) (int32, error) {
+	var latestID int32
+	if err := r.client.Conn(ctx).Model(&model.Notification{}).
+		Select("COALESCE(MAX(id), 0)").
+		Where("user_id = ?", userID).
+		Scan(&latestID).Error; err != nil {
+		return 0, err
+	}
+
+	return latestID, nil
+}
+
 func (r *notificationRepository) Create(ctx context.Context, notification *model.Notification) error {
-	return r.client.Conn(ctx).Create(notification).Error
+	return r.client.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.client.Conn(ctx).Create(notification).Error; err != nil {
+			return err
+		}
+
+		// NOTIFYはコミット時に配信されるため、ロールバックされた通知が購読者に届くことはない
+		return r.client.Conn(ctx).Exec("SELECT pg_notify(?, ?)", NotificationCreatedChannel, notification.UserID).Error
+	})
 }
 
 func (r *notificationRepository) Update(ctx context.Context, notification *model.Notification) error {
+	if notification.BroadcastID != nil {
+		// 一斉通知の本文は共有しているため、受信者の行には書き戻さない
+		return r.client.Conn(ctx).
+			Omit("title", "message", "related_entity_type", "related_entity_id").
+			Save(notification).Error
+	}
+
 	return r.client.Conn(ctx).Save(notification).Error
 }
 
@@ -72,3 +128,72 @@ func (r *notificationRepository) MarkAsRead(ctx context.Context, id int32) error
 			"read_at": now,
 		}).Error
 }
+
+func (r *notificationRepository) MarkAllAsReadByUserID(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error) {
+	now := time.Now()
+
+	result := r.client.Conn(ctx).Model(&model.Notification{}).
+		Where("user_id = ? AND is_read = FALSE", userID).
+		Scopes(withNotificationFilter(filter)).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": now,
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *notificationRepository) DeleteByUserID(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error) {
+	result := r.client.Conn(ctx).
+		Where("user_id = ?", userID).
+		Scopes(withNotificationFilter(filter)).
+		Delete(&model.Notification{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *notificationRepository) CountUnreadByUserID(ctx context.Context, userID string) (map[string]int64, error) {
+	var rows []struct {
+		NotificationType string
+		Count            int64
+	}
+	if err := r.client.Conn(ctx).Model(&model.Notification{}).
+		Select("notification_type, COUNT(*) AS count").
+		Where("user_id = ? AND is_read = FALSE", userID).
+		Group("notification_type").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.NotificationType] = row.Count
+	}
+
+	return counts, nil
+}
+
+func withNotificationFilter(filter *model.NotificationFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return db
+		}
+		if len(filter.IDs) > 0 {
+			db = db.Where("id IN ?", filter.IDs)
+		}
+		if filter.NotificationType != nil {
+			db = db.Where("notification_type = ?", *filter.NotificationType)
+		}
+		if filter.Before != nil {
+			db = db.Where("created_at <= ?", *filter.Before)
+		}
+
+		return db
+	}
+}
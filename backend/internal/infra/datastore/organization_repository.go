@@ -1,8 +1,10 @@
-//go:generate mockgen -source=organization_repository.go -destination=../../../tests/mock/datastore/organization_repository.mock.go
 package datastore
 
 import (
 	"context"
+	"time"
+
+	"gorm.io/gen/field"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/domain/query"
@@ -12,7 +14,6 @@ import (
 
 type organizationRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewOrganizationRepository(
@@ -21,12 +22,13 @@ func NewOrganizationRepository(
 ) domain.OrganizationRepository {
 	return &organizationRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
 func (r *organizationRepository) Find(ctx context.Context) ([]*model.Organization, error) {
-	organizations, err := r.query.WithContext(ctx).Organization.Find()
+	q := query.Use(r.client.Conn(ctx))
+
+	organizations, err := q.WithContext(ctx).Organization.Find()
 	if err != nil {
 		return nil, err
 	}
@@ -35,10 +37,12 @@ func (r *organizationRepository) Find(ctx context.Context) ([]*model.Organizatio
 }
 
 func (r *organizationRepository) FindByID(ctx context.Context, id int64) (*model.Organization, error) {
-	organization, err := r.query.WithContext(ctx).
+	q := query.Use(r.client.Conn(ctx))
+
+	organization, err := q.WithContext(ctx).
 		Organization.
-		Where(r.query.Organization.ID.Eq(id)).
-		Preload(r.query.Organization.Users).
+		Where(q.Organization.ID.Eq(id)).
+		Preload(q.Organization.Users).
 		First()
 	if err != nil {
 		return nil, err
@@ -48,15 +52,33 @@ func (r *organizationRepository) FindByID(ctx context.Context, id int64) (*model
 }
 
 func (r *organizationRepository) Create(ctx context.Context, organization *model.Organization) error {
-	return r.query.WithContext(ctx).Organization.Create(organization)
+	q := query.Use(r.client.Conn(ctx))
+
+	return q.WithContext(ctx).Organization.Create(organization)
 }
 
-func (r *organizationRepository) Update(ctx context.Context, organization *model.Organization) error {
-	_, err := r.query.WithContext(ctx).Organization.Where(r.query.Organization.ID.Eq(organization.ID)).Updates(organization)
-	return err
+func (r *organizationRepository) Update(ctx context.Context, organization *model.Organization, expectedUpdatedAt time.Time) error {
+	q := query.Use(r.client.Conn(ctx))
+
+	// 所属ユーザーは更新対象外とし、updated_atが読み込み時から変わっていない場合のみ更新する
+	result, err := q.WithContext(ctx).Organization.
+		Omit(field.AssociationFields).
+		Where(q.Organization.ID.Eq(organization.ID), q.Organization.UpdatedAt.Eq(expectedUpdatedAt)).
+		Updates(organization)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrVersionConflict
+	}
+
+	return nil
 }
 
 func (r *organizationRepository) Delete(ctx context.Context, id int64) error {
-	_, err := r.query.WithContext(ctx).Organization.Where(r.query.Organization.ID.Eq(id)).Delete()
+	q := query.Use(r.client.Conn(ctx))
+
+	_, err := q.WithContext(ctx).Organization.Where(q.Organization.ID.Eq(id)).Delete()
 	return err
 }
@@ -0,0 +1,101 @@
+package datastore
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type outboxRepository struct {
+	client db.Client
+}
+
+func NewOutboxRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.OutboxRepository {
+	return &outboxRepository{
+		client: client,
+	}
+}
+
+func (r *outboxRepository) Claim(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*model.OutboxEvent, error) {
+	// SKIP LOCKEDで他のリレーが選択中の行を飛ばし、配信可能日時をリース期限まで進めて他のリレーから隠す
+	var events []*model.OutboxEvent
+	err := r.client.Conn(ctx).Raw(`
+UPDATE outbox_events
+SET available_at = ?,
+    attempts     = attempts + 1
+WHERE id IN (SELECT id
+             FROM outbox_events
+             WHERE published_at IS NULL
+               AND dead_at IS NULL
+               AND available_at <= ?
+             ORDER BY id
+             LIMIT ? FOR UPDATE SKIP LOCKED)
+RETURNING *`,
+		leaseUntil, now, limit,
+	).Scan(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNINGの順序は保証されないため登録順に並べ直す
+	slices.SortFunc(events, func(a, b *model.OutboxEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return events, nil
+}
+
+func (r *outboxRepository) MarkPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	return r.client.Conn(ctx).Model(&model.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"published_at": publishedAt,
+			"last_error":   nil,
+		}).Error
+}
+
+func (r *outboxRepository) MarkFailed(ctx context.Context, id int64, availableAt time.Time, lastError string) error {
+	return r.client.Conn(ctx).Model(&model.OutboxEvent{}).
+		Where("id = ? AND published_at IS NULL", id).
+		Updates(map[string]any{
+			"available_at": availableAt,
+			"last_error":   lastError,
+		}).Error
+}
+
+func (r *outboxRepository) MarkDead(ctx context.Context, id int64, deadAt time.Time, lastError string) error {
+	return r.client.Conn(ctx).Model(&model.OutboxEvent{}).
+		Where("id = ? AND published_at IS NULL", id).
+		Updates(map[string]any{
+			"dead_at":    deadAt,
+			"last_error": lastError,
+		}).Error
+}
+
+func (r *outboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.client.Conn(ctx).
+		Where("published_at < ?", before).
+		Delete(&model.OutboxEvent{})
+
+	return result.RowsAffected, result.Error
+}
+
+// insertOutboxEvents writes the events to the outbox on conn. Repositories call it inside the transaction of
+// the change the events describe, so an event is stored if and only if the change is committed.
+func insertOutboxEvents(conn *gorm.DB, events []*model.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return conn.Session(&gorm.Session{NewDB: true}).Create(events).Error
+}
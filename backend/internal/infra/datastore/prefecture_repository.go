@@ -1,4 +1,3 @@
-//go:generate mockgen -source=prefecture_repository.go -destination=../../../tests/mock/datastore/prefecture_repository.mock.go
 package datastore
 
 import (
@@ -16,7 +15,6 @@ import (
 
 type prefectureRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewPrefectureRepository(
@@ -25,12 +23,13 @@ func NewPrefectureRepository(
 ) domain.PrefectureRepository {
 	return &prefectureRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
 func (r *prefectureRepository) Find(ctx context.Context) ([]*model.Prefecture, error) {
-	prefectures, err := r.query.WithContext(ctx).Prefecture.Find()
+	q := query.Use(r.client.Conn(ctx))
+
+	prefectures, err := q.WithContext(ctx).Prefecture.Find()
 	if err != nil {
 		return nil, err
 	}
@@ -39,10 +38,12 @@ func (r *prefectureRepository) Find(ctx context.Context) ([]*model.Prefecture, e
 }
 
 func (r *prefectureRepository) FindByID(ctx context.Context, code string) (*model.Prefecture, error) {
-	prefecture, err := r.query.WithContext(ctx).
+	q := query.Use(r.client.Conn(ctx))
+
+	prefecture, err := q.WithContext(ctx).
 		Prefecture.
-		Where(r.query.Prefecture.Code.Eq(code)).
-		Preload(r.query.Prefecture.Municipalities.On(r.query.Municipality.IsActive.Is(true))).
+		Where(q.Prefecture.Code.Eq(code)).
+		Preload(q.Prefecture.Municipalities.On(q.Municipality.IsActive.Is(true))).
 		First()
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
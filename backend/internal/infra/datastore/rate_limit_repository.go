@@ -0,0 +1,67 @@
+package datastore
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type rateLimitRepository struct {
+	client db.Client
+}
+
+// NewRateLimitRepository returns a rate limit store in Postgres, shared by all instances of the API
+func NewRateLimitRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.RateLimitRepository {
+	return &rateLimitRepository{
+		client: client,
+	}
+}
+
+// refilledTokens is the number of tokens in the existing bucket b, refilled for the time since its last request
+const refilledTokens = `LEAST(CAST(@burst AS DOUBLE PRECISION),
+             b.tokens + GREATEST(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - b.updated_at), 0) * CAST(@rate AS DOUBLE PRECISION))`
+
+// takeTokenQuery creates a full bucket less one token, or refills the existing one and takes a token if one is left
+var takeTokenQuery = strings.ReplaceAll(`
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at, expires_at)
+VALUES (@key, CAST(@burst AS DOUBLE PRECISION) - 1, TRUE, CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP + make_interval(secs => @period))
+ON CONFLICT (key) DO UPDATE
+    SET tokens     = CASE WHEN {refilled} >= 1 THEN {refilled} - 1 ELSE {refilled} END,
+        allowed    = {refilled} >= 1,
+        updated_at = CURRENT_TIMESTAMP,
+        expires_at = CURRENT_TIMESTAMP + make_interval(secs => @period)
+RETURNING *`, "{refilled}", refilledTokens)
+
+func (r *rateLimitRepository) Take(ctx context.Context, key string, limit model.RateLimit) (*model.RateLimitResult, error) {
+	// 補充と取り出しを1文で行い、同じキーへの同時リクエストでもトークンを二重に使わせない
+	// 時刻はインスタンス間でずれないようデータベースの時刻を使う
+	var bucket model.RateLimitBucket
+	err := r.client.Conn(ctx).Raw(takeTokenQuery, map[string]any{
+		"key":    key,
+		"burst":  limit.Requests,
+		"rate":   limit.RefillRate(),
+		"period": limit.Period.Seconds(),
+	}).Scan(&bucket).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return limit.Result(bucket.Tokens, bucket.Allowed), nil
+}
+
+func (r *rateLimitRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.client.Conn(ctx).Where("expires_at <= ?", now).Delete(&model.RateLimitBucket{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,150 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+// relatedEntityTable describes how a related entity type is stored and linked
+type relatedEntityTable struct {
+	name         string
+	idColumn     string
+	labelColumn  string
+	statusColumn string
+	// the canonical path is the PathPrefix of the type followed by the value of pathColumn
+	pathColumn string
+	softDelete bool
+}
+
+var relatedEntityTables = map[model.RelatedEntityType]relatedEntityTable{
+	model.RelatedEntityDisaster: {
+		name: "disasters", idColumn: "id", labelColumn: "name", statusColumn: "status",
+		pathColumn: "id", softDelete: true,
+	},
+	model.RelatedEntityAssessment: {
+		name: "assessments", idColumn: "id", labelColumn: "assessment_type", statusColumn: "status",
+		pathColumn: "disaster_id", softDelete: true,
+	},
+	model.RelatedEntitySupportApplication: {
+		name: "support_applications", idColumn: "application_id", labelColumn: "disaster_name || '（' || applicant_name || '）'",
+		statusColumn: "status", pathColumn: "application_id",
+	},
+}
+
+type relatedEntityRepository struct {
+	client db.Client
+}
+
+func NewRelatedEntityRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.RelatedEntityRepository {
+	return &relatedEntityRepository{
+		client: client,
+	}
+}
+
+func (r *relatedEntityRepository) Resolve(
+	ctx context.Context,
+	refs []model.RelatedEntityRef,
+) (map[model.RelatedEntityRef]*model.RelatedEntityLink, error) {
+	links := make(map[model.RelatedEntityRef]*model.RelatedEntityLink, len(refs))
+
+	// 見つからない参照は削除済みとして扱うので、先にすべて削除済みで埋めておく
+	ids := make(map[model.RelatedEntityType][]any)
+	refsByKey := make(map[model.RelatedEntityType]map[string][]model.RelatedEntityRef)
+	for _, ref := range refs {
+		if _, ok := links[ref]; ok {
+			continue
+		}
+		links[ref] = &model.RelatedEntityLink{Type: ref.Type, ID: ref.ID, Deleted: true}
+
+		id, key, ok := parseRelatedEntityID(ref)
+		if !ok {
+			continue
+		}
+
+		if refsByKey[ref.Type] == nil {
+			refsByKey[ref.Type] = make(map[string][]model.RelatedEntityRef)
+		}
+		if _, seen := refsByKey[ref.Type][key]; !seen {
+			ids[ref.Type] = append(ids[ref.Type], id)
+		}
+		refsByKey[ref.Type][key] = append(refsByKey[ref.Type][key], ref)
+	}
+
+	for entityType, entityIDs := range ids {
+		table := relatedEntityTables[entityType]
+
+		deleted := "FALSE"
+		if table.softDelete {
+			deleted = "deleted_at IS NOT NULL"
+		}
+
+		var rows []struct {
+			ID      string
+			Label   string
+			Status  string
+			PathID  string
+			Deleted bool
+		}
+		if err := r.client.Conn(ctx).
+			Table(table.name).
+			Select(fmt.Sprintf(
+				"CAST(%s AS TEXT) AS id, %s AS label, CAST(%s AS TEXT) AS status, CAST(%s AS TEXT) AS path_id, %s AS deleted",
+				table.idColumn, table.labelColumn, table.statusColumn, table.pathColumn, deleted,
+			)).
+			Where(table.idColumn+" IN ?", entityIDs).
+			Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			for _, ref := range refsByKey[entityType][row.ID] {
+				link := links[ref]
+				link.Label = row.Label
+				link.Status = row.Status
+				link.Deleted = row.Deleted
+				if !row.Deleted {
+					link.Path = entityType.PathPrefix() + row.PathID
+				}
+			}
+		}
+	}
+
+	return links, nil
+}
+
+// parseRelatedEntityID converts the reference ID to the column type and returns it along with its
+// text form as returned by the database. ok is false when the ID cannot match any record.
+func parseRelatedEntityID(ref model.RelatedEntityRef) (id any, key string, ok bool) {
+	if !ref.Type.Valid() {
+		return nil, "", false
+	}
+
+	switch ref.Type.IDFormat() {
+	case model.RelatedEntityIDUUID:
+		u, err := uuid.Parse(ref.ID)
+		if err != nil {
+			return nil, "", false
+		}
+
+		return u.String(), u.String(), true
+	case model.RelatedEntityIDSerial:
+		n, err := strconv.ParseInt(ref.ID, 10, 64)
+		if err != nil {
+			return nil, "", false
+		}
+
+		return n, strconv.FormatInt(n, 10), true
+	default:
+		return ref.ID, ref.ID, ref.ID != ""
+	}
+}
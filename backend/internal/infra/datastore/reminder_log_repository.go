@@ -0,0 +1,45 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type reminderLogRepository struct {
+	client db.Client
+}
+
+func NewReminderLogRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.ReminderLogRepository {
+	return &reminderLogRepository{
+		client: client,
+	}
+}
+
+func (r *reminderLogRepository) Create(ctx context.Context, log *model.ReminderLog) error {
+	return r.client.Conn(ctx).Create(log).Error
+}
+
+func (r *reminderLogRepository) ExistsSince(
+	ctx context.Context,
+	userID string,
+	ref model.RelatedEntityRef,
+	since time.Time,
+) (bool, error) {
+	var count int64
+	if err := r.client.Conn(ctx).Model(&model.ReminderLog{}).
+		Where("user_id = ? AND related_entity_type = ? AND related_entity_id = ?", userID, string(ref.Type), ref.ID).
+		Where("reminded_at >= ?", since).
+		Limit(1).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
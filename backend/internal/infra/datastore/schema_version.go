@@ -0,0 +1,36 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+// CheckSchemaVersion returns an error when the migrations recorded by golang-migrate in schema_migrations
+// are older than expected or were left dirty by a failed run. A newer schema is accepted so that instances
+// of the previous release stay ready during a rolling deploy.
+func CheckSchemaVersion(ctx context.Context, client db.Client, expected uint) error {
+	var migration struct {
+		Version uint
+		Dirty   bool
+	}
+	result := client.Conn(ctx).Raw("SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&migration)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("no migration has been applied, expected version %d", expected)
+	}
+
+	if migration.Dirty {
+		return fmt.Errorf("migration %d is dirty", migration.Version)
+	}
+
+	if migration.Version < expected {
+		return fmt.Errorf("schema version %d is older than %d", migration.Version, expected)
+	}
+
+	return nil
+}
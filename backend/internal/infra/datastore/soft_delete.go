@@ -0,0 +1,72 @@
+package datastore
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+// softDeleteChildrenOf soft-deletes the live children of the given parents, recording the count per table in deleted.
+// All rows are stamped with the same deletedAt so that restoreChildren can find them again.
+func softDeleteChildrenOf(
+	conn *gorm.DB,
+	children []model.SoftDeleteRelation,
+	parentIDs any,
+	deletedAt time.Time,
+	deleted map[string]int64,
+) error {
+	for _, child := range children {
+		childIDs := conn.Session(&gorm.Session{NewDB: true}).
+			Table(child.Table).
+			Select("id").
+			Where(child.ForeignKey+" IN (?) AND deleted_at IS NULL", parentIDs)
+
+		// 子を削除するとdeleted_atで対象を絞り込めなくなるため、孫から先に削除する
+		if err := softDeleteChildrenOf(conn, child.Children, childIDs, deletedAt, deleted); err != nil {
+			return err
+		}
+
+		result := conn.Session(&gorm.Session{NewDB: true}).
+			Table(child.Table).
+			Where(child.ForeignKey+" IN (?) AND deleted_at IS NULL", parentIDs).
+			Update("deleted_at", deletedAt)
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted[child.Table] += result.RowsAffected
+	}
+
+	return nil
+}
+
+// restoreChildren restores the children of the given parents that were deleted at or after since,
+// i.e. together with the parent. Children deleted individually before the parent stay in the trash.
+func restoreChildren(conn *gorm.DB, children []model.SoftDeleteRelation, parentIDs any, since time.Time) (int64, error) {
+	var restored int64
+	for _, child := range children {
+		childIDs := conn.Session(&gorm.Session{NewDB: true}).
+			Table(child.Table).
+			Select("id").
+			Where(child.ForeignKey+" IN (?) AND deleted_at >= ?", parentIDs, since)
+
+		// 子を復元するとdeleted_atで対象を絞り込めなくなるため、孫から先に復元する
+		n, err := restoreChildren(conn, child.Children, childIDs, since)
+		if err != nil {
+			return 0, err
+		}
+		restored += n
+
+		result := conn.Session(&gorm.Session{NewDB: true}).
+			Table(child.Table).
+			Where(child.ForeignKey+" IN (?) AND deleted_at >= ?", parentIDs, since).
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		restored += result.RowsAffected
+	}
+
+	return restored, nil
+}
@@ -1,8 +1,8 @@
-//go:generate mockgen -source=support_application_repository.go -destination=../../../tests/mock/datastore/support_application_repository.mock.go
 package datastore
 
 import (
 	"context"
+	"time"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/domain/query"
@@ -12,7 +12,6 @@ import (
 
 type supportApplicationRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewSupportApplicationRepository(
@@ -21,13 +20,14 @@ func NewSupportApplicationRepository(
 ) domain.SupportApplicationRepository {
 	return &supportApplicationRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
 func (r *supportApplicationRepository) Find(ctx context.Context) ([]*model.SupportApplication, error) {
-	supportApplications, err := r.query.WithContext(ctx).SupportApplication.
-		Order(r.query.SupportApplication.ApplicationDate.Desc()).
+	q := query.Use(r.client.Conn(ctx))
+
+	supportApplications, err := q.WithContext(ctx).SupportApplication.
+		Order(q.SupportApplication.ApplicationDate.Desc()).
 		Find()
 	if err != nil {
 		return nil, err
@@ -37,9 +37,11 @@ func (r *supportApplicationRepository) Find(ctx context.Context) ([]*model.Suppo
 }
 
 func (r *supportApplicationRepository) FindByID(ctx context.Context, id string) (*model.SupportApplication, error) {
-	supportApplication, err := r.query.WithContext(ctx).
+	q := query.Use(r.client.Conn(ctx))
+
+	supportApplication, err := q.WithContext(ctx).
 		SupportApplication.
-		Where(r.query.SupportApplication.ApplicationID.Eq(id)).
+		Where(q.SupportApplication.ApplicationID.Eq(id)).
 		First()
 	if err != nil {
 		return nil, err
@@ -48,6 +50,73 @@ func (r *supportApplicationRepository) FindByID(ctx context.Context, id string)
 	return supportApplication, nil
 }
 
-func (r *supportApplicationRepository) Create(ctx context.Context, supportApplication *model.SupportApplication) error {
-	return r.query.WithContext(ctx).SupportApplication.Create(supportApplication)
+func (r *supportApplicationRepository) Create(
+	ctx context.Context,
+	supportApplication *model.SupportApplication,
+	events ...*model.OutboxEvent,
+) error {
+	return r.client.Transaction(ctx, func(ctx context.Context) error {
+		if err := query.Use(r.client.Conn(ctx)).WithContext(ctx).SupportApplication.Create(supportApplication); err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(r.client.Conn(ctx), events)
+	})
+}
+
+func (r *supportApplicationRepository) CountByDisasterIDForUpdate(
+	ctx context.Context,
+	disasterID string,
+	statuses []string,
+) (int64, error) {
+	var count int64
+
+	// 集計関数とFOR UPDATEは併用できないため、ロックした行を外側で数える
+	err := r.client.Conn(ctx).Raw(`
+		SELECT COUNT(*) FILTER (WHERE status IN ?)
+		FROM (SELECT status FROM support_applications WHERE disaster_id = ? FOR UPDATE) AS locked`,
+		statuses, disasterID,
+	).Scan(&count).Error
+
+	return count, err
+}
+
+func (r *supportApplicationRepository) FindByStatusUpdatedBefore(ctx context.Context, status string, before time.Time) ([]*model.SupportApplication, error) {
+	q := query.Use(r.client.Conn(ctx))
+
+	return q.WithContext(ctx).SupportApplication.
+		Where(
+			q.SupportApplication.Status.Eq(status),
+			q.SupportApplication.UpdatedAt.Lt(before),
+			q.SupportApplication.ApplicantUserID.IsNotNull(),
+		).
+		Order(q.SupportApplication.ApplicationID).
+		Find()
+}
+
+func (r *supportApplicationRepository) UpdateStatus(
+	ctx context.Context,
+	id, status string,
+	updatedAt, expectedUpdatedAt time.Time,
+	events ...*model.OutboxEvent,
+) error {
+	return r.client.Transaction(ctx, func(ctx context.Context) error {
+		q := query.Use(r.client.Conn(ctx))
+
+		// 読み込み時からupdated_atが変わっていない場合のみ更新し、同時に別の遷移が行われるのを防ぐ
+		result, err := q.WithContext(ctx).SupportApplication.
+			Where(q.SupportApplication.ApplicationID.Eq(id), q.SupportApplication.UpdatedAt.Eq(expectedUpdatedAt)).
+			UpdateSimple(
+				q.SupportApplication.Status.Value(status),
+				q.SupportApplication.UpdatedAt.Value(updatedAt),
+			)
+		if err != nil {
+			return err
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrVersionConflict
+		}
+
+		return insertOutboxEvents(r.client.Conn(ctx), events)
+	})
 }
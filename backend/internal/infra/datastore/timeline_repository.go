@@ -1,4 +1,3 @@
-//go:generate mockgen -source=timeline_repository.go -destination=../../../tests/mock/datastore/timeline_repository.mock.go
 package datastore
 
 import (
@@ -12,7 +11,6 @@ import (
 
 type timelineRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewTimelineRepository(
@@ -21,15 +19,16 @@ func NewTimelineRepository(
 ) domain.TimelineRepository {
 	return &timelineRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
 func (r *timelineRepository) FindByDisasterID(ctx context.Context, disasterID string) ([]*model.Timeline, error) {
-	timelines, err := r.query.WithContext(ctx).
+	q := query.Use(r.client.Conn(ctx))
+
+	timelines, err := q.WithContext(ctx).
 		Timeline.
-		Where(r.query.Timeline.DisasterID.Eq(disasterID)).
-		Order(r.query.Timeline.EventTime.Desc()).
+		Where(q.Timeline.DisasterID.Eq(disasterID)).
+		Order(q.Timeline.EventTime.Desc()).
 		Find()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,200 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+// foreignKeyViolation is the PostgreSQL SQLSTATE for foreign_key_violation
+const foreignKeyViolation = "23503"
+
+// trashTable describes how a trash type is stored
+type trashTable struct {
+	name        string
+	labelColumn string
+	// parent is the record that must not be in the trash for this record to be restored
+	parent *model.SoftDeleteRelation
+}
+
+var disasterParent = &model.SoftDeleteRelation{Table: model.TableNameDisaster, ForeignKey: "disaster_id"}
+
+var trashTables = map[model.TrashType]trashTable{
+	model.TrashTypeDisaster:          {name: "disasters", labelColumn: "name"},
+	model.TrashTypeTimeline:          {name: "timelines", labelColumn: "event_name", parent: disasterParent},
+	model.TrashTypeFacilityEquipment: {name: "facility_equipment", labelColumn: "name"},
+	model.TrashTypeGisData:           {name: "gis_data", labelColumn: "name", parent: disasterParent},
+	model.TrashTypeAssessment:        {name: "assessments", labelColumn: "assessment_type", parent: disasterParent},
+	model.TrashTypeUser:              {name: "users", labelColumn: "name"},
+}
+
+type trashRepository struct {
+	client db.Client
+}
+
+func NewTrashRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.TrashRepository {
+	return &trashRepository{
+		client: client,
+	}
+}
+
+func (r *trashRepository) FindDeleted(ctx context.Context, trashType model.TrashType) ([]*model.TrashItem, error) {
+	table, err := lookupTrashTable(trashType)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*model.TrashItem
+	err = r.client.Conn(ctx).
+		Table(table.name).
+		Select(fmt.Sprintf("CAST(id AS TEXT) AS id, %s AS label, deleted_at", table.labelColumn)).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Scan(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		item.Type = trashType
+	}
+
+	return items, nil
+}
+
+func (r *trashRepository) Restore(
+	ctx context.Context,
+	trashType model.TrashType,
+	id string,
+	children []model.SoftDeleteRelation,
+) (int64, error) {
+	table, err := lookupTrashTable(trashType)
+	if err != nil {
+		return 0, err
+	}
+
+	recordID, err := parseTrashRecordID(trashType, id)
+	if err != nil {
+		return 0, err
+	}
+
+	var restored int64
+	err = r.client.Transaction(ctx, func(ctx context.Context) error {
+		conn := r.client.Conn(ctx)
+
+		var record struct {
+			DeletedAt time.Time
+		}
+		if err := conn.Table(table.name).
+			Select("deleted_at").
+			Where("id = ? AND deleted_at IS NOT NULL", recordID).
+			Take(&record).Error; err != nil {
+			return err
+		}
+
+		if table.parent != nil {
+			var deletedParents int64
+			parentID := conn.Session(&gorm.Session{NewDB: true}).
+				Table(table.name).
+				Select(table.parent.ForeignKey).
+				Where("id = ?", recordID)
+			if err := conn.Session(&gorm.Session{NewDB: true}).
+				Table(table.parent.Table).
+				Where("id IN (?) AND deleted_at IS NOT NULL", parentID).
+				Count(&deletedParents).Error; err != nil {
+				return err
+			}
+
+			if deletedParents > 0 {
+				return domain.ErrParentDeleted
+			}
+		}
+
+		n, err := restoreChildren(conn, children, []any{recordID}, record.DeletedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := conn.Session(&gorm.Session{NewDB: true}).
+			Table(table.name).
+			Where("id = ?", recordID).
+			Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+
+		restored = n
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return restored, nil
+}
+
+func (r *trashRepository) Purge(ctx context.Context, trashType model.TrashType, id string) error {
+	table, err := lookupTrashTable(trashType)
+	if err != nil {
+		return err
+	}
+
+	recordID, err := parseTrashRecordID(trashType, id)
+	if err != nil {
+		return err
+	}
+
+	// 子テーブルは外部キーのON DELETE CASCADEで削除される
+	result := r.client.Conn(ctx).
+		Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ? AND deleted_at IS NOT NULL", table.name), recordID)
+	if result.Error != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(result.Error, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return domain.ErrReferenced
+		}
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func lookupTrashTable(trashType model.TrashType) (trashTable, error) {
+	table, ok := trashTables[trashType]
+	if !ok {
+		return trashTable{}, fmt.Errorf("unknown trash type: %s", trashType)
+	}
+
+	return table, nil
+}
+
+// parseTrashRecordID converts id to the primary key type of the trash type.
+// A malformed serial ID cannot match any record and is reported as not found.
+func parseTrashRecordID(trashType model.TrashType, id string) (any, error) {
+	if trashType.UsesUUID() {
+		return id, nil
+	}
+
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return n, nil
+}
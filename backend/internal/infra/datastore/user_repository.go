@@ -1,4 +1,3 @@
-//go:generate mockgen -source=user_repository.go -destination=../../../tests/mock/datastore/user_repository.mock.go
 package datastore
 
 import (
@@ -12,7 +11,6 @@ import (
 
 type userRepository struct {
 	client db.Client
-	query  *query.Query
 }
 
 func NewUserRepository(
@@ -21,7 +19,6 @@ func NewUserRepository(
 ) domain.UserRepository {
 	return &userRepository{
 		client: client,
-		query:  query.Use(client.Conn(ctx)),
 	}
 }
 
@@ -35,9 +32,11 @@ func (r *userRepository) Find(ctx context.Context) ([]*model.User, error) {
 }
 
 func (r *userRepository) FindByID(ctx context.Context, id string) (*model.User, error) {
-	user, err := r.query.User.
-		Where(r.query.User.ID.Eq(id)).
-		Preload(r.query.User.Organizations).
+	q := query.Use(r.client.Conn(ctx))
+
+	user, err := q.User.
+		Where(q.User.ID.Eq(id)).
+		Preload(q.User.Organizations).
 		First()
 	if err != nil {
 		return nil, err
@@ -47,7 +46,7 @@ func (r *userRepository) FindByID(ctx context.Context, id string) (*model.User,
 }
 
 func (r *userRepository) Create(ctx context.Context, user *model.User) error {
-	return r.query.User.Create(user)
+	return query.Use(r.client.Conn(ctx)).User.Create(user)
 }
 
 func (r *userRepository) Update(ctx context.Context, user *model.User) error {
@@ -55,9 +54,11 @@ func (r *userRepository) Update(ctx context.Context, user *model.User) error {
 }
 
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
-	user, err := r.query.User.
-		Where(r.query.User.Email.Eq(email)).
-		Preload(r.query.User.Organizations).
+	q := query.Use(r.client.Conn(ctx))
+
+	user, err := q.User.
+		Where(q.User.Email.Eq(email)).
+		Preload(q.User.Organizations).
 		First()
 	if err != nil {
 		return nil, err
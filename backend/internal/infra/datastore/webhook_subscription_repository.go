@@ -0,0 +1,149 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type webhookSubscriptionRepository struct {
+	client db.Client
+}
+
+func NewWebhookSubscriptionRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{
+		client: client,
+	}
+}
+
+func (r *webhookSubscriptionRepository) Find(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	var subscriptions []*model.WebhookSubscription
+	if err := r.client.Conn(ctx).Order("created_at").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (r *webhookSubscriptionRepository) FindByID(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	var subscription model.WebhookSubscription
+	if err := r.client.Conn(ctx).Where("id = ?", id).First(&subscription).Error; err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (r *webhookSubscriptionRepository) FindActiveByEventType(ctx context.Context, eventType string) ([]*model.WebhookSubscription, error) {
+	contains, err := json.Marshal([]string{eventType})
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []*model.WebhookSubscription
+	if err := r.client.Conn(ctx).
+		Where("active AND event_types @> ?::jsonb", string(contains)).
+		Order("created_at").
+		Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (r *webhookSubscriptionRepository) Create(ctx context.Context, subscription *model.WebhookSubscription) error {
+	return r.client.Conn(ctx).Create(subscription).Error
+}
+
+func (r *webhookSubscriptionRepository) Update(ctx context.Context, subscription *model.WebhookSubscription) error {
+	result := r.client.Conn(ctx).Model(subscription).
+		Select("url", "event_types", "description", "active", "updated_at").
+		Updates(subscription)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *webhookSubscriptionRepository) Delete(ctx context.Context, id string) error {
+	result := r.client.Conn(ctx).Where("id = ?", id).Delete(&model.WebhookSubscription{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+type webhookDeliveryRepository struct {
+	client db.Client
+}
+
+func NewWebhookDeliveryRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		client: client,
+	}
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, delivery *model.WebhookDelivery) error {
+	// イベントの振り分けジョブが再実行された場合は既存の配信を使う
+	result := r.client.Conn(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(delivery)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	return r.client.Conn(ctx).
+		Where("subscription_id = ? AND event_id = ?", delivery.SubscriptionID, delivery.EventID).
+		First(delivery).Error
+}
+
+func (r *webhookDeliveryRepository) FindByID(ctx context.Context, id int64) (*model.WebhookDelivery, error) {
+	var delivery model.WebhookDelivery
+	if err := r.client.Conn(ctx).Where("id = ?", id).First(&delivery).Error; err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func (r *webhookDeliveryRepository) FindBySubscriptionID(ctx context.Context, subscriptionID string, limit int) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	if err := r.client.Conn(ctx).
+		Where("subscription_id = ?", subscriptionID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *webhookDeliveryRepository) RecordAttempt(ctx context.Context, delivery *model.WebhookDelivery) error {
+	return r.client.Conn(ctx).Model(delivery).
+		Select("status", "attempts", "response_status", "response_body", "error_message", "duration_ms", "last_attempt_at", "delivered_at").
+		Updates(delivery).Error
+}
@@ -0,0 +1,31 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+type workCategoryRepository struct {
+	client db.Client
+}
+
+func NewWorkCategoryRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.WorkCategoryRepository {
+	return &workCategoryRepository{
+		client: client,
+	}
+}
+
+func (r *workCategoryRepository) FindByID(ctx context.Context, id int64) (*model.WorkCategory, error) {
+	var workCategory model.WorkCategory
+	if err := r.client.Conn(ctx).Where("id = ?", id).First(&workCategory).Error; err != nil {
+		return nil, err
+	}
+
+	return &workCategory, nil
+}
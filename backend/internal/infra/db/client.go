@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -16,10 +18,27 @@ import (
 
 // Client インターフェース
 type Client interface {
+	// Conn returns the transaction carried by ctx, or a connection from the pool when there is none
 	Conn(ctx context.Context) *gorm.DB
 	Close() error
 	Ping(ctx context.Context) error
-	Transaction(ctx context.Context, fn func(tx Client) error) error
+	// Transaction runs fn in a transaction carried by the ctx passed to fn, so every repository called with that ctx
+	// joins it. A Transaction started inside another one becomes a savepoint of the outer transaction.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// txKey is the context key of the transaction started by Client.Transaction
+type txKey struct{}
+
+// withTx returns a copy of ctx carrying tx
+func withTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// txFrom returns the transaction carried by ctx
+func txFrom(ctx context.Context) (*gorm.DB, bool) {
+	tx, ok := ctx.Value(txKey{}).(*gorm.DB)
+	return tx, ok
 }
 
 // SQLLogger インターフェース
@@ -27,6 +46,12 @@ type SQLLogger interface {
 	logger.Interface
 }
 
+// QueryObserver receives the duration of every SQL statement, e.g. to export it as a metric
+type QueryObserver interface {
+	// ObserveQuery is called with the statement's operation such as SELECT, or OTHER for anything else
+	ObserveQuery(operation string, elapsed time.Duration, err error)
+}
+
 // SQLHandler はDatabaseHandlerの実装
 type SQLHandler struct {
 	conn *gorm.DB
@@ -35,12 +60,13 @@ type SQLHandler struct {
 // JSONLogger is a custom GORM logger that uses our application's JSON logger
 type JSONLogger struct {
 	logger        *applogger.Logger
+	observer      QueryObserver
 	slowThreshold time.Duration
 	logLevel      logger.LogLevel
 }
 
-// NewJSONLogger creates a new JSONLogger
-func NewJSONLogger(appLogger *applogger.Logger) SQLLogger {
+// NewJSONLogger creates a new JSONLogger. observer may be nil.
+func NewJSONLogger(appLogger *applogger.Logger, observer QueryObserver) SQLLogger {
 	// 環境変数でSQLログレベルを設定可能にする
 	sqlLogLevel := logger.Info
 
@@ -70,6 +96,7 @@ func NewJSONLogger(appLogger *applogger.Logger) SQLLogger {
 
 	return &JSONLogger{
 		logger:        appLogger,
+		observer:      observer,
 		slowThreshold: slowThreshold,
 		logLevel:      sqlLogLevel,
 	}
@@ -106,13 +133,22 @@ func (l *JSONLogger) Error(ctx context.Context, msg string, data ...interface{})
 
 // Trace logs SQL statements with execution time
 func (l *JSONLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
-	if l.logLevel <= logger.Silent {
+	if l.observer == nil && l.logLevel <= logger.Silent {
 		return
 	}
 
 	elapsed := time.Since(begin)
 	sql, rows := fc()
 
+	// ログを出さない設定でも計測は行う
+	if l.observer != nil {
+		l.observer.ObserveQuery(queryOperation(sql), elapsed, err)
+	}
+
+	if l.logLevel <= logger.Silent {
+		return
+	}
+
 	if err != nil {
 		l.logger.ErrorContext(ctx, err, "SQL error",
 			"sql", sql,
@@ -140,6 +176,17 @@ func (l *JSONLogger) Trace(ctx context.Context, begin time.Time, fc func() (stri
 	}
 }
 
+// queryOperation returns the SQL command of the statement, limited to a few values to keep metric labels bounded
+func queryOperation(sql string) string {
+	command, _, _ := strings.Cut(strings.TrimSpace(sql), " ")
+	switch command = strings.ToUpper(command); command {
+	case "SELECT", "INSERT", "UPDATE", "DELETE", "WITH":
+		return command
+	default:
+		return "OTHER"
+	}
+}
+
 // DatabaseConfig データベース設定
 type DatabaseConfig struct {
 	Host            string
@@ -152,6 +199,10 @@ type DatabaseConfig struct {
 	MaxIdleConns    int
 	MaxOpenConns    int
 	ConnMaxLifetime time.Duration
+	// QueryObserver is notified of every SQL statement when set
+	QueryObserver QueryObserver
+	// TracerProvider records a span for every SQL statement when set
+	TracerProvider trace.TracerProvider
 }
 
 // DefaultDatabaseConfig デフォルト設定を返す
@@ -182,11 +233,16 @@ func NewSQLHandler(config *DatabaseConfig, appLogger *applogger.Logger) (Client,
 
 	// PostgreSQLに直接接続
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: NewJSONLogger(appLogger),
+		Logger: NewJSONLogger(appLogger, config.QueryObserver),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
+	if config.TracerProvider != nil {
+		if err := db.Use(NewTracingPlugin(config.TracerProvider, config.DBName)); err != nil {
+			return nil, fmt.Errorf("failed to register tracing plugin: %w", err)
+		}
+	}
 
 	// 接続プールの設定
 	sqlDB, err := db.DB()
@@ -204,8 +260,12 @@ func NewSQLHandler(config *DatabaseConfig, appLogger *applogger.Logger) (Client,
 	}, nil
 }
 
-// Conn returns the underlying GORM DB instance
+// Conn returns the underlying GORM DB instance, or the transaction carried by ctx
 func (s *SQLHandler) Conn(ctx context.Context) *gorm.DB {
+	if tx, ok := txFrom(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+
 	return s.conn.WithContext(ctx)
 }
 
@@ -230,10 +290,10 @@ func (s *SQLHandler) Ping(ctx context.Context) error {
 }
 
 // Transaction executes a function within a database transaction
-func (s *SQLHandler) Transaction(ctx context.Context, fn func(tx Client) error) error {
-	return s.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		txHandler := &SQLHandler{conn: tx}
-		return fn(txHandler)
+func (s *SQLHandler) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// 既にトランザクション中であれば、GORMがセーブポイントとして入れ子にする
+	return s.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(withTx(ctx, tx))
 	})
 }
 
@@ -275,12 +335,12 @@ func (m *MockDatabaseHandler) Ping(ctx context.Context) error {
 }
 
 // Transaction executes a function within a mock transaction
-func (m *MockDatabaseHandler) Transaction(ctx context.Context, fn func(tx Client) error) error {
+func (m *MockDatabaseHandler) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
 	if m.shouldError {
 		return errors.New(m.errorMessage)
 	}
 
-	return fn(m)
+	return fn(ctx)
 }
 
 // SetError sets the mock to return errors
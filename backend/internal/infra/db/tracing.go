@@ -0,0 +1,93 @@
+package db
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// spanKey is the key of the span of the running statement in the gorm.DB instance
+const spanKey = "tracing:span"
+
+// TracingPlugin is a GORM plugin that records a client span for every SQL statement. The span carries the
+// statement with its placeholders, never the bound values.
+type TracingPlugin struct {
+	tracer trace.Tracer
+	dbName string
+}
+
+func NewTracingPlugin(tp trace.TracerProvider, dbName string) *TracingPlugin {
+	return &TracingPlugin{
+		tracer: tp.Tracer("github.com/AI1411/fullstack-react-go/internal/infra/db"),
+		dbName: dbName,
+	}
+}
+
+func (p *TracingPlugin) Name() string {
+	return "tracing"
+}
+
+func (p *TracingPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("tracing:before_create", p.before),
+		cb.Create().After("gorm:create").Register("tracing:after_create", p.after),
+		cb.Query().Before("gorm:query").Register("tracing:before_query", p.before),
+		cb.Query().After("gorm:query").Register("tracing:after_query", p.after),
+		cb.Update().Before("gorm:update").Register("tracing:before_update", p.before),
+		cb.Update().After("gorm:update").Register("tracing:after_update", p.after),
+		cb.Delete().Before("gorm:delete").Register("tracing:before_delete", p.before),
+		cb.Delete().After("gorm:delete").Register("tracing:after_delete", p.after),
+		cb.Row().Before("gorm:row").Register("tracing:before_row", p.before),
+		cb.Row().After("gorm:row").Register("tracing:after_row", p.after),
+		cb.Raw().Before("gorm:raw").Register("tracing:before_raw", p.before),
+		cb.Raw().After("gorm:raw").Register("tracing:after_raw", p.after),
+	)
+}
+
+func (p *TracingPlugin) before(db *gorm.DB) {
+	// 名前はSQLが組み立てられた後に決まるため、仮の名前で開始する
+	_, span := p.tracer.Start(db.Statement.Context, "db", trace.WithSpanKind(trace.SpanKindClient))
+	db.InstanceSet(spanKey, span)
+}
+
+func (p *TracingPlugin) after(db *gorm.DB) {
+	v, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	sql := db.Statement.SQL.String()
+	operation := queryOperation(sql)
+	table := db.Statement.Table
+
+	name := operation
+	if table != "" {
+		name += " " + table
+	}
+	span.SetName(name)
+	span.SetAttributes(
+		semconv.DBSystemPostgreSQL,
+		semconv.DBNamespace(p.dbName),
+		semconv.DBOperationName(operation),
+		semconv.DBCollectionName(table),
+		semconv.DBQueryText(sql),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+
+	// 見つからないことは呼び出し元が扱う正常な結果なのでエラーにしない
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -0,0 +1,76 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+)
+
+func TestTracingPlugin(t *testing.T) {
+	// Setup
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	// DryRunではSQLを組み立てるだけで実行しないため、データベースなしでコールバックを確認できる
+	conn, err := gorm.Open(postgres.Open("host=localhost dbname=gen"), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, conn.Use(db.NewTracingPlugin(tp, "gen")))
+
+	// Test cases
+	tests := []struct {
+		name         string
+		run          func(conn *gorm.DB) *gorm.DB
+		expectedName string
+	}{
+		{
+			name: "Query",
+			run: func(conn *gorm.DB) *gorm.DB {
+				return conn.Where("id = ?", "secret-value").Find(&[]*model.Disaster{})
+			},
+			expectedName: "SELECT disasters",
+		},
+		{
+			name: "Create",
+			run: func(conn *gorm.DB) *gorm.DB {
+				return conn.Create(&model.Prefecture{Code: "01", Name: "北海道"})
+			},
+			expectedName: "INSERT prefectures",
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+
+			tx := tt.run(conn)
+			assert.NoError(t, tx.Error)
+
+			spans := exporter.GetSpans()
+			if !assert.Len(t, spans, 1) {
+				return
+			}
+			span := spans[0]
+			assert.Equal(t, tt.expectedName, span.Name)
+			assert.Equal(t, trace.SpanKindClient, span.SpanKind)
+			assert.Contains(t, span.Attributes, attribute.String("db.system", "postgresql"))
+			assert.Contains(t, span.Attributes, attribute.String("db.query.text", tx.Statement.SQL.String()))
+
+			// バインドされた値はスパンに含めない
+			for _, attr := range span.Attributes {
+				assert.NotContains(t, attr.Value.Emit(), "secret-value")
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// コンポーネントおよびサービス全体の状態
+const (
+	StatusUp       = "up"
+	StatusDown     = "down"
+	StatusDegraded = "degraded"
+	// StatusDraining is reported while the server is shutting down
+	StatusDraining = "draining"
+)
+
+// Check is one dependency the service needs to handle traffic
+type Check struct {
+	Name string
+	// Optional marks a dependency the service can work without for a while. Its failure reports the service
+	// as degraded instead of taking it out of rotation.
+	Optional bool
+	// CacheFor reuses the result of the last run for this long, for checks too costly to run on every probe
+	CacheFor time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// ComponentStatus is the result of one check. Error is only for the logs: the probes are public and the
+// message may reveal internal addresses.
+type ComponentStatus struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"-"`
+}
+
+// Report is the result of all checks
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components"`
+}
+
+// Ready reports whether the service should receive traffic
+func (r *Report) Ready() bool {
+	return r.Status != StatusDown
+}
+
+// Checker runs the readiness checks
+type Checker struct {
+	timeout time.Duration
+	checks  []Check
+	cache   []cachedStatus
+}
+
+// cachedStatus is the last result of a check with CacheFor set
+type cachedStatus struct {
+	mu        sync.Mutex
+	status    ComponentStatus
+	checkedAt time.Time
+}
+
+// NewChecker returns a checker that gives each check at most timeout to finish
+func NewChecker(timeout time.Duration, checks ...Check) *Checker {
+	return &Checker{
+		timeout: timeout,
+		checks:  checks,
+		cache:   make([]cachedStatus, len(checks)),
+	}
+}
+
+// Check runs all checks concurrently and reports the status of each
+func (c *Checker) Check(ctx context.Context) *Report {
+	results := make([]ComponentStatus, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.runCached(ctx, check, &c.cache[i])
+		}()
+	}
+	wg.Wait()
+
+	report := &Report{
+		Status:     StatusUp,
+		Components: make(map[string]ComponentStatus, len(c.checks)),
+	}
+	for i, check := range c.checks {
+		report.Components[check.Name] = results[i]
+
+		if results[i].Status == StatusUp {
+			continue
+		}
+		if !check.Optional {
+			report.Status = StatusDown
+		} else if report.Status == StatusUp {
+			report.Status = StatusDegraded
+		}
+	}
+
+	return report
+}
+
+// runCached returns the cached result while it is fresh. Concurrent probes wait for the same run instead of
+// each starting their own.
+func (c *Checker) runCached(ctx context.Context, check Check, cache *cachedStatus) ComponentStatus {
+	if check.CacheFor <= 0 {
+		return c.run(ctx, check)
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if !cache.checkedAt.IsZero() && time.Since(cache.checkedAt) < check.CacheFor {
+		return cache.status
+	}
+
+	// 結果を他のプローブと共有するため、呼び出し元のキャンセルでは中断しない
+	cache.status = c.run(context.WithoutCancel(ctx), check)
+	cache.checkedAt = time.Now()
+
+	return cache.status
+}
+
+func (c *Checker) run(ctx context.Context, check Check) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Run(ctx)
+	latency := float64(time.Since(start).Microseconds()) / 1000
+
+	if err != nil {
+		return ComponentStatus{Status: StatusDown, LatencyMs: latency, Error: err.Error()}
+	}
+
+	return ComponentStatus{Status: StatusUp, LatencyMs: latency}
+}
@@ -0,0 +1,105 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/health"
+)
+
+func up(context.Context) error { return nil }
+
+func down(context.Context) error { return errors.New("connection refused") }
+
+func TestChecker_Check(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name           string
+		checks         []health.Check
+		expectedStatus string
+		expectedReady  bool
+	}{
+		{
+			name: "All Up",
+			checks: []health.Check{
+				{Name: "database", Run: up},
+				{Name: "mail", Optional: true, Run: up},
+			},
+			expectedStatus: health.StatusUp,
+			expectedReady:  true,
+		},
+		{
+			name: "Required Down",
+			checks: []health.Check{
+				{Name: "database", Run: down},
+				{Name: "mail", Optional: true, Run: up},
+			},
+			expectedStatus: health.StatusDown,
+			expectedReady:  false,
+		},
+		{
+			name: "Optional Down",
+			checks: []health.Check{
+				{Name: "database", Run: up},
+				{Name: "mail", Optional: true, Run: down},
+			},
+			expectedStatus: health.StatusDegraded,
+			expectedReady:  true,
+		},
+		{
+			name: "Timeout",
+			checks: []health.Check{
+				{Name: "database", Run: func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}},
+			},
+			expectedStatus: health.StatusDown,
+			expectedReady:  false,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := health.NewChecker(50*time.Millisecond, tt.checks...)
+
+			report := checker.Check(context.Background())
+
+			assert.Equal(t, tt.expectedStatus, report.Status)
+			assert.Equal(t, tt.expectedReady, report.Ready())
+			assert.Len(t, report.Components, len(tt.checks))
+			for _, check := range tt.checks {
+				component := report.Components[check.Name]
+				if component.Status == health.StatusDown {
+					assert.NotEmpty(t, component.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestChecker_Check_CacheFor(t *testing.T) {
+	var runs int
+	checker := health.NewChecker(50*time.Millisecond, health.Check{
+		Name:     "mail",
+		Optional: true,
+		CacheFor: time.Hour,
+		Run: func(context.Context) error {
+			runs++
+			return errors.New("connection refused")
+		},
+	})
+
+	// キャッシュの有効期間内は前回の結果を返し、依存先に再接続しない
+	first := checker.Check(context.Background())
+	second := checker.Check(context.Background())
+
+	assert.Equal(t, 1, runs)
+	assert.Equal(t, health.StatusDegraded, first.Status)
+	assert.Equal(t, first.Components["mail"], second.Components["mail"])
+}
@@ -7,6 +7,8 @@ import (
 	"log/slog"
 	"os"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // LogLevel represents the logging level.
@@ -136,9 +138,13 @@ func WithTraceID(ctx context.Context, traceID string) context.Context {
 	return context.WithValue(ctx, traceIDKey{}, traceID)
 }
 
-// TraceIDFromContext returns the trace ID from the context.
-// If no trace ID is found, it returns an empty string.
+// TraceIDFromContext returns the trace ID of the span in the context, or the one attached by WithTraceID when
+// there is no span. If no trace ID is found, it returns an empty string.
 func TraceIDFromContext(ctx context.Context) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+
 	if traceID, ok := ctx.Value(traceIDKey{}).(string); ok {
 		return traceID
 	}
@@ -146,20 +152,29 @@ func TraceIDFromContext(ctx context.Context) string {
 	return ""
 }
 
-// addTraceIDFromContext adds trace_id field to the log arguments if present in context.
-func (l *Logger) addTraceIDFromContext(ctx context.Context, args []any) []any {
+// traceAttrs returns the trace_id and span_id fields of the context.
+func traceAttrs(ctx context.Context) []any {
+	var attrs []any
 	if traceID := TraceIDFromContext(ctx); traceID != "" {
-		return append(args, slog.String("trace_id", traceID))
+		attrs = append(attrs, slog.String("trace_id", traceID))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasSpanID() {
+		attrs = append(attrs, slog.String("span_id", sc.SpanID().String()))
 	}
 
-	return args
+	return attrs
 }
 
-// WithTrace returns a new Logger with trace_id from context added to all logs.
+// addTraceIDFromContext adds trace_id and span_id fields to the log arguments if present in context.
+func (l *Logger) addTraceIDFromContext(ctx context.Context, args []any) []any {
+	return append(args, traceAttrs(ctx)...)
+}
+
+// WithTrace returns a new Logger with trace_id and span_id from context added to all logs.
 func (l *Logger) WithTrace(ctx context.Context) *Logger {
-	if traceID := TraceIDFromContext(ctx); traceID != "" {
+	if attrs := traceAttrs(ctx); len(attrs) > 0 {
 		return &Logger{
-			Logger: l.Logger.With(slog.String("trace_id", traceID)),
+			Logger: l.Logger.With(attrs...),
 		}
 	}
 
@@ -0,0 +1,97 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/env"
+)
+
+var tracer = otel.Tracer("github.com/AI1411/fullstack-react-go/internal/infra/mail")
+
+type smtpMailer struct {
+	addr string
+	from string
+}
+
+func NewSMTPMailer(e *env.Values) domain.Mailer {
+	return &smtpMailer{
+		addr: net.JoinHostPort(e.SMTPHost, strconv.Itoa(e.SMTPPort)),
+		from: e.MailFrom,
+	}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, to, subject, htmlBody string) error {
+	// RFC 5322準拠のメールメッセージを作成（件名は日本語を含むためMIMEエンコードする）
+	message := fmt.Sprintf(
+		"From: %s\r\n"+
+			"To: %s\r\n"+
+			"Subject: %s\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/html; charset=UTF-8\r\n"+
+			"\r\n"+
+			"%s",
+		m.from,
+		to,
+		mime.BEncoding.Encode("UTF-8", subject),
+		htmlBody,
+	)
+
+	host, port, _ := net.SplitHostPort(m.addr)
+	portNumber, _ := strconv.Atoi(port)
+	_, span := tracer.Start(ctx, "smtp send",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.ServerAddress(host), semconv.ServerPort(portNumber)),
+	)
+	defer span.End()
+
+	// MailHogは認証不要なのでnilを渡す
+	if err := smtp.SendMail(m.addr, nil, m.from, []string{to}, []byte(message)); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (m *smtpMailer) Ping(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	// 挨拶を受け取れることまで確認し、メールは送らずに切断する
+	host, _, err := net.SplitHostPort(m.addr)
+	if err != nil {
+		return err
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (m *smtpMailer) Provider() string {
+	return "smtp"
+}
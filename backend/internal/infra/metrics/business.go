@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+)
+
+// closedApplicationStatuses are the support application statuses no longer counted as open
+var closedApplicationStatuses = []string{"完了", "却下"}
+
+// queuedJobStatuses are the job statuses counted in the queue depth
+var queuedJobStatuses = []string{model.JobStatusPending, model.JobStatusRunning}
+
+var (
+	disastersDesc = prometheus.NewDesc(
+		"disasters_by_status",
+		"Number of disasters that are not deleted, by status.",
+		[]string{"status"}, nil,
+	)
+	openApplicationsDesc = prometheus.NewDesc(
+		"support_applications_open_by_status",
+		"Number of support applications that are neither completed nor rejected, by status.",
+		[]string{"status"}, nil,
+	)
+	jobQueueDepthDesc = prometheus.NewDesc(
+		"job_queue_depth",
+		"Number of pending and running background jobs, by job type and status.",
+		[]string{"job_type", "status"}, nil,
+	)
+	emailSendFailuresDesc = prometheus.NewDesc(
+		"email_send_failures_total",
+		"Number of emails recorded as failed in email_histories, by email type.",
+		[]string{"email_type"}, nil,
+	)
+)
+
+// BusinessCollector reads the business metrics from the database. The counts cover the whole database, so
+// every API instance reports the same values.
+type BusinessCollector struct {
+	repo     domain.BusinessMetricsRepository
+	timeout  time.Duration
+	cacheFor time.Duration
+
+	mu          sync.Mutex
+	cached      []prometheus.Metric
+	collectedAt time.Time
+}
+
+// NewBusinessCollector returns a collector whose queries give up after timeout. Scrapes within cacheFor of
+// the last query get the same values instead of querying the database again.
+func NewBusinessCollector(repo domain.BusinessMetricsRepository, timeout, cacheFor time.Duration) *BusinessCollector {
+	return &BusinessCollector{
+		repo:     repo,
+		timeout:  timeout,
+		cacheFor: cacheFor,
+	}
+}
+
+func (c *BusinessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- disastersDesc
+	ch <- openApplicationsDesc
+	ch <- jobQueueDepthDesc
+	ch <- emailSendFailuresDesc
+}
+
+func (c *BusinessCollector) Collect(ch chan<- prometheus.Metric) {
+	// 同時のスクレイプも1回の集計を待ち、結果を共有する
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.collectedAt.IsZero() || time.Since(c.collectedAt) >= c.cacheFor {
+		c.cached = c.query()
+		c.collectedAt = time.Now()
+	}
+
+	for _, metric := range c.cached {
+		ch <- metric
+	}
+}
+
+// query runs the GROUP BY queries and returns the metrics built from their results
+func (c *BusinessCollector) query() []prometheus.Metric {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var metrics []prometheus.Metric
+
+	disasters, err := c.repo.CountDisastersByStatus(ctx)
+	metrics = collect(metrics, disastersDesc, prometheus.GaugeValue, disasters, err, byStatus)
+
+	applications, err := c.repo.CountSupportApplicationsByStatus(ctx, closedApplicationStatuses)
+	metrics = collect(metrics, openApplicationsDesc, prometheus.GaugeValue, applications, err, byStatus)
+
+	jobs, err := c.repo.CountJobsByTypeAndStatus(ctx, queuedJobStatuses)
+	metrics = collect(metrics, jobQueueDepthDesc, prometheus.GaugeValue, jobs, err, byGroupAndStatus)
+
+	// 履歴は削除されないため、失敗件数は単調増加のカウンタとして公開する
+	failedEmails, err := c.repo.CountEmailsByTypeAndStatus(ctx, model.DeliveryStatusFailed)
+	metrics = collect(metrics, emailSendFailuresDesc, prometheus.CounterValue, failedEmails, err, byGroup)
+
+	return metrics
+}
+
+func byStatus(count *model.GroupCount) []string { return []string{count.Status} }
+
+func byGroup(count *model.GroupCount) []string { return []string{count.Group} }
+
+func byGroupAndStatus(count *model.GroupCount) []string { return []string{count.Group, count.Status} }
+
+// collect appends one metric per count, or an invalid metric that shows up in the scrape errors when the query failed
+func collect(
+	metrics []prometheus.Metric,
+	desc *prometheus.Desc,
+	valueType prometheus.ValueType,
+	counts []*model.GroupCount,
+	err error,
+	labels func(count *model.GroupCount) []string,
+) []prometheus.Metric {
+	if err != nil {
+		return append(metrics, prometheus.NewInvalidMetric(desc, err))
+	}
+
+	for _, count := range counts {
+		metrics = append(metrics, prometheus.MustNewConstMetric(desc, valueType, float64(count.Count), labels(count)...))
+	}
+
+	return metrics
+}
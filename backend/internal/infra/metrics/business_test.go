@@ -0,0 +1,114 @@
+package metrics_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/metrics"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+func TestBusinessCollector_Collect(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name             string
+		mockSetup        func(repo *mockdomain.MockBusinessMetricsRepository)
+		expectedLines    []string
+		notExpectedLines []string
+	}{
+		{
+			name: "Success",
+			mockSetup: func(repo *mockdomain.MockBusinessMetricsRepository) {
+				repo.EXPECT().CountDisastersByStatus(gomock.Any()).
+					Return([]*model.GroupCount{{Status: "pending", Count: 3}, {Status: "completed", Count: 1}}, nil)
+				repo.EXPECT().CountSupportApplicationsByStatus(gomock.Any(), []string{"完了", "却下"}).
+					Return([]*model.GroupCount{{Status: "審査中", Count: 2}}, nil)
+				repo.EXPECT().CountJobsByTypeAndStatus(gomock.Any(), []string{model.JobStatusPending, model.JobStatusRunning}).
+					Return([]*model.GroupCount{{Group: model.JobTypeWelcomeEmail, Status: model.JobStatusPending, Count: 5}}, nil)
+				repo.EXPECT().CountEmailsByTypeAndStatus(gomock.Any(), model.DeliveryStatusFailed).
+					Return([]*model.GroupCount{{Group: "welcome", Status: model.DeliveryStatusFailed, Count: 4}}, nil)
+			},
+			expectedLines: []string{
+				`disasters_by_status{status="pending"} 3`,
+				`disasters_by_status{status="completed"} 1`,
+				`support_applications_open_by_status{status="審査中"} 2`,
+				`job_queue_depth{job_type="welcome_email",status="pending"} 5`,
+				`email_send_failures_total{email_type="welcome"} 4`,
+			},
+		},
+		{
+			name: "Query Error",
+			mockSetup: func(repo *mockdomain.MockBusinessMetricsRepository) {
+				repo.EXPECT().CountDisastersByStatus(gomock.Any()).Return(nil, errors.New("database error"))
+				repo.EXPECT().CountSupportApplicationsByStatus(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().CountJobsByTypeAndStatus(gomock.Any(), gomock.Any()).
+					Return([]*model.GroupCount{{Group: model.JobTypeWelcomeEmail, Status: model.JobStatusRunning, Count: 1}}, nil)
+				repo.EXPECT().CountEmailsByTypeAndStatus(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			// 失敗した指標以外は公開され続ける
+			expectedLines: []string{
+				`job_queue_depth{job_type="welcome_email",status="running"} 1`,
+			},
+			notExpectedLines: []string{
+				`disasters_by_status{`,
+			},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockdomain.NewMockBusinessMetricsRepository(ctrl)
+			tt.mockSetup(repo)
+
+			m := metrics.New()
+			err := m.Register(metrics.NewBusinessCollector(repo, time.Second, time.Minute))
+			assert.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+			m.Handler().ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			for _, line := range tt.expectedLines {
+				assert.Contains(t, w.Body.String(), line)
+			}
+			for _, line := range tt.notExpectedLines {
+				assert.NotContains(t, w.Body.String(), line)
+			}
+		})
+	}
+}
+
+func TestBusinessCollector_Collect_Cached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockdomain.NewMockBusinessMetricsRepository(ctrl)
+
+	// キャッシュの有効期間内のスクレイプではDBに問い合わせない
+	repo.EXPECT().CountDisastersByStatus(gomock.Any()).
+		Return([]*model.GroupCount{{Status: "pending", Count: 3}}, nil).Times(1)
+	repo.EXPECT().CountSupportApplicationsByStatus(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	repo.EXPECT().CountJobsByTypeAndStatus(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	repo.EXPECT().CountEmailsByTypeAndStatus(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+	m := metrics.New()
+	err := m.Register(metrics.NewBusinessCollector(repo, time.Second, time.Minute))
+	assert.NoError(t, err)
+
+	for range 2 {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+		m.Handler().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `disasters_by_status{status="pending"} 3`)
+	}
+}
@@ -0,0 +1,196 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/infra/queue"
+)
+
+// Subscriber handles one domain event. Events are delivered at least once: when any subscriber of an event
+// fails, the event is relayed again to all of its subscribers after a backoff, so subscribers must be idempotent.
+// An event that still fails after Config.MaxAttempts attempts is marked dead and not relayed again.
+type Subscriber func(ctx context.Context, event *model.OutboxEvent) error
+
+// Subscribe registers a subscriber that receives the event ID and the event payload decoded into T.
+// The ID stays the same when the event is relayed again, so subscribers can use it to drop duplicates.
+func Subscribe[T any](r *Relay, eventType string, fn func(ctx context.Context, eventID int64, payload T) error) {
+	r.Subscribe(eventType, func(ctx context.Context, event *model.OutboxEvent) error {
+		var payload T
+		if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+			return fmt.Errorf("invalid payload: %w", err)
+		}
+
+		return fn(ctx, event.ID, payload)
+	})
+}
+
+// Config controls how the relay polls and retries
+type Config struct {
+	// PollInterval is how long the relay waits before looking for events again after finding none
+	PollInterval time.Duration
+	// BatchSize is the maximum number of events claimed at once
+	BatchSize int
+	// LeaseTimeout is how long a claimed event stays hidden from other relays while it is being relayed
+	LeaseTimeout time.Duration
+	// BackoffBase is the delay before the first retry; each further retry doubles it up to BackoffMax
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// MaxAttempts is how many times an event is relayed before it is marked dead
+	MaxAttempts int32
+}
+
+// Relay publishes the events written to the outbox to the in-process subscribers. Events are claimed oldest first,
+// but an event waiting to be retried does not hold back the events written after it, so subscribers must not
+// rely on the order of events.
+type Relay struct {
+	l      *logger.Logger
+	events domain.OutboxRepository
+	config Config
+
+	mu          sync.Mutex
+	subscribers map[string][]Subscriber
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func New(l *logger.Logger, events domain.OutboxRepository, config Config) *Relay {
+	return &Relay{
+		l:           l,
+		events:      events,
+		config:      config,
+		subscribers: make(map[string][]Subscriber),
+	}
+}
+
+// Subscribe adds a subscriber of the event type. An event type may have several subscribers.
+func (r *Relay) Subscribe(eventType string, subscriber Subscriber) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscribers[eventType] = append(r.subscribers[eventType], subscriber)
+}
+
+// Start relays events until Stop is called
+func (r *Relay) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+		r.loop(ctx)
+	}()
+}
+
+// Stop lets the current batch finish and waits for it or for ctx to be done
+func (r *Relay) Stop(ctx context.Context) error {
+	r.mu.Lock()
+	cancel, done := r.cancel, r.done
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Relay) loop(ctx context.Context) {
+	for {
+		// 配信中のバッチは停止要求で中断せず最後まで処理する
+		relayed, err := r.RelayBatch(context.WithoutCancel(ctx))
+		if err != nil {
+			r.l.ErrorContext(ctx, err, "Failed to relay outbox events")
+		}
+		if relayed > 0 && err == nil {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.config.PollInterval):
+		}
+	}
+}
+
+// RelayBatch claims a batch of due events and passes each to its subscribers. It returns the number of events claimed.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	now := time.Now()
+	events, err := r.events.Claim(ctx, now, now.Add(r.config.LeaseTimeout), max(r.config.BatchSize, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for _, event := range events {
+		if err := r.relay(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("outbox event %d: %w", event.ID, err))
+		}
+	}
+
+	return len(events), errors.Join(errs...)
+}
+
+func (r *Relay) relay(ctx context.Context, event *model.OutboxEvent) error {
+	r.mu.Lock()
+	subscribers := r.subscribers[event.EventType]
+	r.mu.Unlock()
+
+	var errs []error
+	for _, subscriber := range subscribers {
+		if err := call(ctx, subscriber, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	finishedAt := time.Now()
+	if err := errors.Join(errs...); err != nil {
+		if event.Attempts >= max(r.config.MaxAttempts, 1) {
+			r.l.ErrorContext(ctx, err, "Outbox event moved to dead letter",
+				"event_id", event.ID, "event_type", event.EventType, "attempts", event.Attempts)
+
+			return r.events.MarkDead(ctx, event.ID, finishedAt, err.Error())
+		}
+
+		availableAt := finishedAt.Add(queue.Backoff(event.Attempts, r.config.BackoffBase, r.config.BackoffMax))
+		r.l.WarnContext(ctx, "Outbox event subscriber failed, will retry",
+			"event_id", event.ID, "event_type", event.EventType, "attempts", event.Attempts, "available_at", availableAt, "error", err.Error())
+
+		return r.events.MarkFailed(ctx, event.ID, availableAt, err.Error())
+	}
+
+	// 購読者のいないイベントも配信済みにする
+	return r.events.MarkPublished(ctx, event.ID, finishedAt)
+}
+
+// call runs the subscriber and turns a panic into an error so one subscriber cannot stop the relay
+func call(ctx context.Context, subscriber Subscriber, event *model.OutboxEvent) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("subscriber panicked: %v", p)
+		}
+	}()
+
+	return subscriber(ctx, event)
+}
@@ -0,0 +1,235 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/infra/outbox"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+const testEventType = "TestEvent"
+
+type testPayload struct {
+	Name string `json:"name"`
+}
+
+var testConfig = outbox.Config{
+	PollInterval: time.Second,
+	BatchSize:    10,
+	LeaseTimeout: time.Minute,
+	BackoffBase:  30 * time.Second,
+	BackoffMax:   time.Hour,
+	MaxAttempts:  5,
+}
+
+func TestRelay_RelayBatch(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name             string
+		events           []*model.OutboxEvent
+		subscriberErr    error
+		subscriberPanics bool
+		mockSetup        func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent)
+		expectedRelayed  int
+		expectedError    bool
+		expectedCalls    int
+	}{
+		{
+			name: "No Events",
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(nil, nil)
+			},
+		},
+		{
+			name: "Published In Order",
+			events: []*model.OutboxEvent{
+				{ID: 1, EventType: testEventType, Payload: `{"name":"test"}`, Attempts: 1},
+				{ID: 2, EventType: testEventType, Payload: `{"name":"test"}`, Attempts: 1},
+			},
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(claimed, nil)
+				gomock.InOrder(
+					events.EXPECT().MarkPublished(gomock.Any(), int64(1), gomock.Any()).Return(nil),
+					events.EXPECT().MarkPublished(gomock.Any(), int64(2), gomock.Any()).Return(nil),
+				)
+			},
+			expectedRelayed: 2,
+			expectedCalls:   2,
+		},
+		{
+			name: "No Subscribers",
+			events: []*model.OutboxEvent{
+				{ID: 1, EventType: "UnknownEvent", Payload: `{}`, Attempts: 1},
+			},
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(claimed, nil)
+				events.EXPECT().MarkPublished(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+			},
+			expectedRelayed: 1,
+		},
+		{
+			name: "Retry With Backoff",
+			events: []*model.OutboxEvent{
+				{ID: 1, EventType: testEventType, Payload: `{"name":"test"}`, Attempts: 2},
+			},
+			subscriberErr: errors.New("database error"),
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(claimed, nil)
+				events.EXPECT().MarkFailed(gomock.Any(), int64(1), gomock.Any(), "database error").
+					DoAndReturn(func(_ context.Context, _ int64, availableAt time.Time, _ string) error {
+						assert.WithinDuration(t, time.Now().Add(time.Minute), availableAt, 5*time.Second)
+						return nil
+					})
+			},
+			expectedRelayed: 1,
+			expectedCalls:   1,
+		},
+		{
+			name: "Dead After Max Attempts",
+			events: []*model.OutboxEvent{
+				{ID: 1, EventType: testEventType, Payload: `{"name":"test"}`, Attempts: 5},
+			},
+			subscriberErr: errors.New("database error"),
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(claimed, nil)
+				events.EXPECT().MarkDead(gomock.Any(), int64(1), gomock.Any(), "database error").Return(nil)
+			},
+			expectedRelayed: 1,
+			expectedCalls:   1,
+		},
+		{
+			name: "Later Event Not Held Back",
+			events: []*model.OutboxEvent{
+				{ID: 1, EventType: testEventType, Payload: `[]`, Attempts: 1},
+				{ID: 2, EventType: testEventType, Payload: `{"name":"test"}`, Attempts: 1},
+			},
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(claimed, nil)
+				events.EXPECT().MarkFailed(gomock.Any(), int64(1), gomock.Any(), gomock.Any()).Return(nil)
+				events.EXPECT().MarkPublished(gomock.Any(), int64(2), gomock.Any()).Return(nil)
+			},
+			expectedRelayed: 2,
+			expectedCalls:   1,
+		},
+		{
+			name: "Panic Retried",
+			events: []*model.OutboxEvent{
+				{ID: 1, EventType: testEventType, Payload: `{"name":"test"}`, Attempts: 1},
+			},
+			subscriberPanics: true,
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(claimed, nil)
+				events.EXPECT().MarkFailed(gomock.Any(), int64(1), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedRelayed: 1,
+			expectedCalls:   1,
+		},
+		{
+			name: "Invalid Payload Retried",
+			events: []*model.OutboxEvent{
+				{ID: 1, EventType: testEventType, Payload: `[]`, Attempts: 1},
+			},
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(claimed, nil)
+				events.EXPECT().MarkFailed(gomock.Any(), int64(1), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedRelayed: 1,
+		},
+		{
+			name: "Claim Error",
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "Mark Error",
+			events: []*model.OutboxEvent{
+				{ID: 1, EventType: testEventType, Payload: `{"name":"test"}`, Attempts: 1},
+			},
+			mockSetup: func(events *mockdomain.MockOutboxRepository, claimed []*model.OutboxEvent) {
+				events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(claimed, nil)
+				events.EXPECT().MarkPublished(gomock.Any(), int64(1), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedRelayed: 1,
+			expectedError:   true,
+			expectedCalls:   1,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			events := mockdomain.NewMockOutboxRepository(ctrl)
+			r := outbox.New(logger.New(logger.DefaultConfig()), events, testConfig)
+
+			var calls int
+			outbox.Subscribe(r, testEventType, func(_ context.Context, eventID int64, payload testPayload) error {
+				// 再配信でも変わらないアウトボックスのイベントIDを渡す
+				assert.Contains(t, eventIDs(tt.events), eventID)
+				calls++
+				assert.Equal(t, "test", payload.Name)
+				if tt.subscriberPanics {
+					panic("boom")
+				}
+				return tt.subscriberErr
+			})
+			tt.mockSetup(events, tt.events)
+
+			relayed, err := r.RelayBatch(context.Background())
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedRelayed, relayed)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestRelay_RelayBatch_RetriesAllSubscribers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	events := mockdomain.NewMockOutboxRepository(ctrl)
+	r := outbox.New(logger.New(logger.DefaultConfig()), events, testConfig)
+
+	// 片方の購読者が失敗しても、もう片方は呼ばれる
+	var first, second int
+	r.Subscribe(testEventType, func(context.Context, *model.OutboxEvent) error {
+		first++
+		return errors.New("database error")
+	})
+	r.Subscribe(testEventType, func(context.Context, *model.OutboxEvent) error {
+		second++
+		return nil
+	})
+
+	events.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), 10).
+		Return([]*model.OutboxEvent{{ID: 1, EventType: testEventType, Payload: `{}`, Attempts: 1}}, nil)
+	events.EXPECT().MarkFailed(gomock.Any(), int64(1), gomock.Any(), "database error").Return(nil)
+
+	relayed, err := r.RelayBatch(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, relayed)
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 1, second)
+}
+
+func eventIDs(events []*model.OutboxEvent) []int64 {
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
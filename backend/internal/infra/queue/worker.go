@@ -0,0 +1,219 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+// Handler processes one job. Returning an error schedules a retry with exponential backoff until
+// the job's max attempts are used up; errors wrapped with Permanent move the job to the dead state at once.
+type Handler func(ctx context.Context, job *model.Job) error
+
+// Handle registers a handler that receives the job payload decoded into T
+func Handle[T any](w *Worker, jobType string, fn func(ctx context.Context, payload T) error) {
+	w.Register(jobType, func(ctx context.Context, job *model.Job) error {
+		var payload T
+		if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+			return Permanent(fmt.Errorf("invalid payload: %w", err))
+		}
+
+		return fn(ctx, payload)
+	})
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not worth retrying
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// Config controls how the workers poll and retry
+type Config struct {
+	// Concurrency is the number of jobs processed at the same time by this instance
+	Concurrency int
+	// PollInterval is how long an idle worker waits before looking for jobs again
+	PollInterval time.Duration
+	// LockTimeout is how long a job may run before another worker assumes it was abandoned and runs it again
+	LockTimeout time.Duration
+	// BackoffBase is the delay before the first retry; each further retry doubles it up to BackoffMax
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+// Worker pulls jobs from the jobs table and runs their handlers
+type Worker struct {
+	l      *logger.Logger
+	jobs   domain.JobRepository
+	config Config
+	id     string
+
+	mu       sync.Mutex
+	handlers map[string]Handler
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func New(l *logger.Logger, jobs domain.JobRepository, config Config) *Worker {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return &Worker{
+		l:        l,
+		jobs:     jobs,
+		config:   config,
+		id:       fmt.Sprintf("%s:%d", hostname, os.Getpid()),
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register sets the handler of the job type
+func (w *Worker) Register(jobType string, handler Handler) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.handlers[jobType] = handler
+}
+
+// Start runs Config.Concurrency workers until Stop is called
+func (w *Worker) Start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	for i := range max(w.config.Concurrency, 1) {
+		workerID := fmt.Sprintf("%s:%d", w.id, i)
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.loop(ctx, workerID)
+		}()
+	}
+}
+
+// Stop lets running jobs finish and waits for them to return or for ctx to be done
+func (w *Worker) Stop(ctx context.Context) error {
+	w.mu.Lock()
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Worker) loop(ctx context.Context, workerID string) {
+	for {
+		// 実行中のジョブは停止要求で中断せず最後まで処理する
+		processed, err := w.ProcessNext(context.WithoutCancel(ctx), workerID)
+		if err != nil {
+			w.l.ErrorContext(ctx, err, "Failed to process job", "worker_id", workerID)
+		}
+		if processed && err == nil {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.config.PollInterval):
+		}
+	}
+}
+
+// ProcessNext claims one runnable job and runs its handler. It reports whether a job was found.
+func (w *Worker) ProcessNext(ctx context.Context, workerID string) (bool, error) {
+	now := time.Now()
+	job, err := w.jobs.Claim(ctx, workerID, now, now.Add(-w.config.LockTimeout))
+	if err != nil {
+		return false, err
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	w.mu.Lock()
+	handler, ok := w.handlers[job.JobType]
+	w.mu.Unlock()
+
+	var runErr error
+	if ok {
+		runErr = run(ctx, handler, job)
+	} else {
+		runErr = Permanent(fmt.Errorf("no handler registered for job type %q", job.JobType))
+	}
+
+	finishedAt := time.Now()
+	duration := finishedAt.Sub(now).String()
+	if runErr == nil {
+		w.l.InfoContext(ctx, "Job succeeded", "job_id", job.ID, "job_type", job.JobType, "attempts", job.Attempts, "duration", duration)
+		return true, w.jobs.MarkSucceeded(ctx, job, finishedAt)
+	}
+
+	var permanent *permanentError
+	if errors.As(runErr, &permanent) || job.Attempts >= job.MaxAttempts {
+		w.l.ErrorContext(ctx, runErr, "Job moved to dead letter", "job_id", job.ID, "job_type", job.JobType, "attempts", job.Attempts)
+		return true, w.jobs.MarkDead(ctx, job, finishedAt, runErr.Error())
+	}
+
+	runAt := finishedAt.Add(Backoff(job.Attempts, w.config.BackoffBase, w.config.BackoffMax))
+	w.l.WarnContext(ctx, "Job failed, will retry", "job_id", job.ID, "job_type", job.JobType, "attempts", job.Attempts, "run_at", runAt, "error", runErr.Error())
+
+	return true, w.jobs.MarkRetry(ctx, job, runAt, runErr.Error())
+}
+
+// Backoff returns the delay before retrying a job that has failed attempts times: base, 2*base, 4*base, ... capped at maxDelay
+func Backoff(attempts int32, base, maxDelay time.Duration) time.Duration {
+	delay := base
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+
+	return min(delay, maxDelay)
+}
+
+// run calls the handler and turns a panic into an error so one job cannot stop the worker
+func run(ctx context.Context, handler Handler, job *model.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return handler(ctx, job)
+}
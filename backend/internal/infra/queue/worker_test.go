@@ -0,0 +1,166 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/infra/queue"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+const (
+	testWorkerID = "worker-0"
+	testJobType  = "test_job"
+)
+
+type testPayload struct {
+	Name string `json:"name"`
+}
+
+var testConfig = queue.Config{
+	Concurrency:  1,
+	PollInterval: time.Second,
+	LockTimeout:  10 * time.Minute,
+	BackoffBase:  30 * time.Second,
+	BackoffMax:   time.Hour,
+}
+
+func TestWorker_ProcessNext(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name              string
+		job               *model.Job
+		handlerErr        error
+		handlerPanics     bool
+		mockSetup         func(jobRepo *mockdomain.MockJobRepository, job *model.Job)
+		expectedProcessed bool
+		expectedCalled    bool
+	}{
+		{
+			name: "No Job",
+			mockSetup: func(jobRepo *mockdomain.MockJobRepository, job *model.Job) {
+				jobRepo.EXPECT().Claim(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "Success",
+			job:  &model.Job{ID: 1, JobType: testJobType, Payload: `{"name":"test"}`, Attempts: 1, MaxAttempts: 5},
+			mockSetup: func(jobRepo *mockdomain.MockJobRepository, job *model.Job) {
+				jobRepo.EXPECT().Claim(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(job, nil)
+				jobRepo.EXPECT().MarkSucceeded(gomock.Any(), job, gomock.Any()).Return(nil)
+			},
+			expectedProcessed: true,
+			expectedCalled:    true,
+		},
+		{
+			name:       "Retry With Backoff",
+			job:        &model.Job{ID: 1, JobType: testJobType, Payload: `{"name":"test"}`, Attempts: 2, MaxAttempts: 5},
+			handlerErr: errors.New("smtp unavailable"),
+			mockSetup: func(jobRepo *mockdomain.MockJobRepository, job *model.Job) {
+				jobRepo.EXPECT().Claim(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(job, nil)
+				jobRepo.EXPECT().MarkRetry(gomock.Any(), job, gomock.Any(), "smtp unavailable").
+					DoAndReturn(func(_ context.Context, _ *model.Job, runAt time.Time, _ string) error {
+						assert.WithinDuration(t, time.Now().Add(time.Minute), runAt, 5*time.Second)
+						return nil
+					})
+			},
+			expectedProcessed: true,
+			expectedCalled:    true,
+		},
+		{
+			name:       "Dead After Max Attempts",
+			job:        &model.Job{ID: 1, JobType: testJobType, Payload: `{"name":"test"}`, Attempts: 5, MaxAttempts: 5},
+			handlerErr: errors.New("smtp unavailable"),
+			mockSetup: func(jobRepo *mockdomain.MockJobRepository, job *model.Job) {
+				jobRepo.EXPECT().Claim(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(job, nil)
+				jobRepo.EXPECT().MarkDead(gomock.Any(), job, gomock.Any(), "smtp unavailable").Return(nil)
+			},
+			expectedProcessed: true,
+			expectedCalled:    true,
+		},
+		{
+			name:       "Dead On Permanent Error",
+			job:        &model.Job{ID: 1, JobType: testJobType, Payload: `{"name":"test"}`, Attempts: 1, MaxAttempts: 5},
+			handlerErr: queue.Permanent(errors.New("user deleted")),
+			mockSetup: func(jobRepo *mockdomain.MockJobRepository, job *model.Job) {
+				jobRepo.EXPECT().Claim(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(job, nil)
+				jobRepo.EXPECT().MarkDead(gomock.Any(), job, gomock.Any(), "user deleted").Return(nil)
+			},
+			expectedProcessed: true,
+			expectedCalled:    true,
+		},
+		{
+			name: "Dead On Invalid Payload",
+			job:  &model.Job{ID: 1, JobType: testJobType, Payload: `[]`, Attempts: 1, MaxAttempts: 5},
+			mockSetup: func(jobRepo *mockdomain.MockJobRepository, job *model.Job) {
+				jobRepo.EXPECT().Claim(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(job, nil)
+				jobRepo.EXPECT().MarkDead(gomock.Any(), job, gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedProcessed: true,
+		},
+		{
+			name: "Dead On Unknown Type",
+			job:  &model.Job{ID: 1, JobType: "unknown", Payload: `{}`, Attempts: 1, MaxAttempts: 5},
+			mockSetup: func(jobRepo *mockdomain.MockJobRepository, job *model.Job) {
+				jobRepo.EXPECT().Claim(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(job, nil)
+				jobRepo.EXPECT().MarkDead(gomock.Any(), job, gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedProcessed: true,
+		},
+		{
+			name:          "Panic Retried",
+			job:           &model.Job{ID: 1, JobType: testJobType, Payload: `{"name":"test"}`, Attempts: 1, MaxAttempts: 5},
+			handlerPanics: true,
+			mockSetup: func(jobRepo *mockdomain.MockJobRepository, job *model.Job) {
+				jobRepo.EXPECT().Claim(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(job, nil)
+				jobRepo.EXPECT().MarkRetry(gomock.Any(), job, gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedProcessed: true,
+			expectedCalled:    true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			jobRepo := mockdomain.NewMockJobRepository(ctrl)
+			w := queue.New(logger.New(logger.DefaultConfig()), jobRepo, testConfig)
+
+			var called bool
+			queue.Handle(w, testJobType, func(_ context.Context, payload testPayload) error {
+				called = true
+				assert.Equal(t, "test", payload.Name)
+				if tt.handlerPanics {
+					panic("boom")
+				}
+				return tt.handlerErr
+			})
+			tt.mockSetup(jobRepo, tt.job)
+
+			processed, err := w.ProcessNext(context.Background(), testWorkerID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedProcessed, processed)
+			assert.Equal(t, tt.expectedCalled, called)
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	base := 30 * time.Second
+	maxDelay := 10 * time.Minute
+
+	assert.Equal(t, 30*time.Second, queue.Backoff(1, base, maxDelay))
+	assert.Equal(t, time.Minute, queue.Backoff(2, base, maxDelay))
+	assert.Equal(t, 4*time.Minute, queue.Backoff(4, base, maxDelay))
+	assert.Equal(t, maxDelay, queue.Backoff(6, base, maxDelay))
+	assert.Equal(t, maxDelay, queue.Backoff(100, base, maxDelay))
+}
@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	limit := model.RateLimit{Requests: 2, Period: 10 * time.Second}
+
+	// Test cases
+	tests := []struct {
+		name              string
+		elapsed           []time.Duration
+		expectedAllowed   bool
+		expectedRemaining int
+		expectedRetry     time.Duration
+	}{
+		{
+			name:              "First Request",
+			elapsed:           []time.Duration{0},
+			expectedAllowed:   true,
+			expectedRemaining: 1,
+		},
+		{
+			name:              "Burst Used Up",
+			elapsed:           []time.Duration{0, 0, 0},
+			expectedAllowed:   false,
+			expectedRemaining: 0,
+			expectedRetry:     5 * time.Second,
+		},
+		{
+			name:              "Refilled Over Time",
+			elapsed:           []time.Duration{0, 0, 0, 5 * time.Second},
+			expectedAllowed:   true,
+			expectedRemaining: 0,
+		},
+		{
+			name:              "Refill Capped At Limit",
+			elapsed:           []time.Duration{0, time.Hour},
+			expectedAllowed:   true,
+			expectedRemaining: 1,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+			s := newMemoryStore(func() time.Time { return now })
+
+			var result *model.RateLimitResult
+			for _, elapsed := range tt.elapsed {
+				now = now.Add(elapsed)
+				var err error
+				result, err = s.Take(ctx, "key", limit)
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedAllowed, result.Allowed)
+			assert.Equal(t, tt.expectedRemaining, result.Remaining)
+			assert.Equal(t, tt.expectedRetry, result.RetryAfter)
+			assert.Equal(t, 2, result.Limit)
+		})
+	}
+}
+
+func TestMemoryStore_DeleteExpired(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	s := newMemoryStore(func() time.Time { return now })
+	limit := model.RateLimit{Requests: 2, Period: 10 * time.Second}
+
+	_, _ = s.Take(ctx, "old", limit)
+	now = now.Add(5 * time.Second)
+	_, _ = s.Take(ctx, "new", limit)
+
+	deleted, err := s.DeleteExpired(ctx, now.Add(5*time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.Len(t, s.buckets, 1)
+	assert.Contains(t, s.buckets, "new")
+}
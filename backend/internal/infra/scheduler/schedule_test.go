@@ -0,0 +1,119 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/scheduler"
+)
+
+func TestParseSchedule_Next(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	base := time.Date(2025, 1, 15, 10, 30, 0, 0, jst) // 水曜日
+
+	// Test cases
+	tests := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "Daily At 9",
+			spec:     "0 9 * * *",
+			from:     base,
+			expected: time.Date(2025, 1, 16, 9, 0, 0, 0, jst),
+		},
+		{
+			name:     "Later Today",
+			spec:     "0 18 * * *",
+			from:     base,
+			expected: time.Date(2025, 1, 15, 18, 0, 0, 0, jst),
+		},
+		{
+			name:     "Step Minutes",
+			spec:     "*/15 * * * *",
+			from:     base,
+			expected: time.Date(2025, 1, 15, 10, 45, 0, 0, jst),
+		},
+		{
+			name:     "Weekdays Range",
+			spec:     "0 8 * * 1-5",
+			from:     time.Date(2025, 1, 17, 9, 0, 0, 0, jst), // 金曜日
+			expected: time.Date(2025, 1, 20, 8, 0, 0, 0, jst),
+		},
+		{
+			name:     "Sunday As 7",
+			spec:     "0 0 * * 7",
+			from:     base,
+			expected: time.Date(2025, 1, 19, 0, 0, 0, 0, jst),
+		},
+		{
+			name:     "Day Of Month Or Day Of Week",
+			spec:     "0 0 1 * 1",
+			from:     base,
+			expected: time.Date(2025, 1, 20, 0, 0, 0, 0, jst),
+		},
+		{
+			name:     "List And Month",
+			spec:     "30 6 1,15 3 *",
+			from:     base,
+			expected: time.Date(2025, 3, 1, 6, 30, 0, 0, jst),
+		},
+		{
+			name:     "Monthly Descriptor",
+			spec:     "@monthly",
+			from:     base,
+			expected: time.Date(2025, 2, 1, 0, 0, 0, 0, jst),
+		},
+		{
+			name:     "Every Interval",
+			spec:     "@every 1h",
+			from:     base,
+			expected: time.Date(2025, 1, 15, 11, 0, 0, 0, jst),
+		},
+		{
+			name:     "Never Matches",
+			spec:     "0 0 30 2 *",
+			from:     base,
+			expected: time.Time{},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := scheduler.ParseSchedule(tt.spec)
+			if assert.NoError(t, err) {
+				assert.True(t, tt.expected.Equal(s.Next(tt.from)), "expected %s, got %s", tt.expected, s.Next(tt.from))
+			}
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name string
+		spec string
+	}{
+		{name: "Empty", spec: ""},
+		{name: "Too Few Fields", spec: "0 9 * *"},
+		{name: "Out Of Range", spec: "60 9 * * *"},
+		{name: "Reversed Range", spec: "0 9 * * 5-1"},
+		{name: "Zero Step", spec: "*/0 * * * *"},
+		{name: "Not A Number", spec: "a 9 * * *"},
+		{name: "Unknown Descriptor", spec: "@yearly"},
+		{name: "Short Interval", spec: "@every 100ms"},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := scheduler.ParseSchedule(tt.spec)
+			assert.Error(t, err)
+		})
+	}
+}
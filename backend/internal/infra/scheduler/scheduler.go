@@ -0,0 +1,187 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+// Job is a task run by the Scheduler
+type Job struct {
+	// Name identifies the job in job_runs and in the lock shared by all instances
+	Name     string
+	Schedule Schedule
+	// Run processes the slot scheduled at scheduledAt and returns the number of processed records
+	Run func(ctx context.Context, scheduledAt time.Time) (int, error)
+}
+
+// Scheduler runs jobs on their schedules. Each scheduled slot of a job runs on at most one API instance:
+// the instance must take the job's lock and be the first to record the slot in job_runs.
+type Scheduler struct {
+	l        *logger.Logger
+	locker   domain.JobLocker
+	jobRuns  domain.JobRunRepository
+	location *time.Location
+	hostname string
+
+	mu     sync.Mutex
+	jobs   []*Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New returns a Scheduler that evaluates schedules in location
+func New(l *logger.Logger, locker domain.JobLocker, jobRuns domain.JobRunRepository, location *time.Location) *Scheduler {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return &Scheduler{
+		l:        l,
+		locker:   locker,
+		jobRuns:  jobRuns,
+		location: location,
+		hostname: hostname,
+	}
+}
+
+// Add registers a job. Jobs added after Start are not run.
+func (s *Scheduler) Add(job *Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every registered job in its own goroutine until Stop is called
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}()
+	}
+}
+
+// Stop cancels running jobs and waits for them to return or for ctx to be done
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job *Job) {
+	for {
+		next := job.Schedule.Next(time.Now().In(s.location))
+		if next.IsZero() {
+			s.l.WarnContext(ctx, "Job has no upcoming run", "job", job.Name)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			s.Run(ctx, job, next)
+		}
+	}
+}
+
+// Run executes the slot of the job scheduled at scheduledAt and records the result in job_runs. It does
+// nothing when another instance holds the job's lock or has already run the slot.
+func (s *Scheduler) Run(ctx context.Context, job *Job, scheduledAt time.Time) {
+	unlock, acquired, err := s.locker.TryLock(ctx, job.Name)
+	if err != nil {
+		s.l.ErrorContext(ctx, err, "Failed to acquire job lock", "job", job.Name)
+		return
+	}
+	if !acquired {
+		s.l.InfoContext(ctx, "Skipped job running on another instance", "job", job.Name, "scheduled_at", scheduledAt)
+		return
+	}
+	defer unlock()
+
+	run := &model.JobRun{
+		JobName:     job.Name,
+		ScheduledAt: scheduledAt,
+		Status:      model.JobRunStatusRunning,
+		Hostname:    s.hostname,
+		StartedAt:   time.Now(),
+	}
+	started, err := s.jobRuns.Start(ctx, run)
+	if err != nil {
+		s.l.ErrorContext(ctx, err, "Failed to record job run", "job", job.Name)
+		return
+	}
+	if !started {
+		s.l.InfoContext(ctx, "Skipped job already run by another instance", "job", job.Name, "scheduled_at", scheduledAt)
+		return
+	}
+
+	processed, runErr := runJob(ctx, job, scheduledAt)
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	run.ProcessedCount = int32(processed)
+	run.Status = model.JobRunStatusSucceeded
+	if runErr != nil {
+		errorMessage := runErr.Error()
+		run.Status = model.JobRunStatusFailed
+		run.ErrorMessage = &errorMessage
+	}
+
+	// 停止処理中でも結果を残す
+	if err := s.jobRuns.Finish(context.WithoutCancel(ctx), run); err != nil {
+		s.l.ErrorContext(ctx, err, "Failed to record job result", "job", job.Name, "job_run_id", run.ID)
+	}
+
+	duration := finishedAt.Sub(run.StartedAt)
+	if runErr != nil {
+		s.l.ErrorContext(ctx, runErr, "Job failed", "job", job.Name, "processed", processed, "duration", duration.String())
+		return
+	}
+
+	s.l.InfoContext(ctx, "Job succeeded", "job", job.Name, "processed", processed, "duration", duration.String())
+}
+
+// runJob calls the job and turns a panic into an error so one job cannot stop the scheduler
+func runJob(ctx context.Context, job *Job, scheduledAt time.Time) (processed int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return job.Run(ctx, scheduledAt)
+}
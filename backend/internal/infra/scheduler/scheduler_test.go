@@ -0,0 +1,152 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/infra/scheduler"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+const testJobName = "test_job"
+
+func TestScheduler_Run(t *testing.T) {
+	scheduledAt := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		run            func(ctx context.Context, scheduledAt time.Time) (int, error)
+		mockSetup      func(locker *mockdomain.MockJobLocker, jobRuns *mockdomain.MockJobRunRepository, unlocked *bool)
+		expectedCalled bool
+	}{
+		{
+			name: "Success",
+			run: func(context.Context, time.Time) (int, error) {
+				return 3, nil
+			},
+			mockSetup: func(locker *mockdomain.MockJobLocker, jobRuns *mockdomain.MockJobRunRepository, unlocked *bool) {
+				locker.EXPECT().TryLock(gomock.Any(), testJobName).Return(func() { *unlocked = true }, true, nil)
+				jobRuns.EXPECT().Start(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, run *model.JobRun) (bool, error) {
+						assert.Equal(t, testJobName, run.JobName)
+						assert.Equal(t, scheduledAt, run.ScheduledAt)
+						assert.Equal(t, model.JobRunStatusRunning, run.Status)
+						return true, nil
+					})
+				jobRuns.EXPECT().Finish(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, run *model.JobRun) error {
+						assert.Equal(t, model.JobRunStatusSucceeded, run.Status)
+						assert.Equal(t, int32(3), run.ProcessedCount)
+						assert.Nil(t, run.ErrorMessage)
+						assert.NotNil(t, run.FinishedAt)
+						return nil
+					})
+			},
+			expectedCalled: true,
+		},
+		{
+			name: "Job Error Recorded",
+			run: func(context.Context, time.Time) (int, error) {
+				return 1, errors.New("send failed")
+			},
+			mockSetup: func(locker *mockdomain.MockJobLocker, jobRuns *mockdomain.MockJobRunRepository, unlocked *bool) {
+				locker.EXPECT().TryLock(gomock.Any(), testJobName).Return(func() { *unlocked = true }, true, nil)
+				jobRuns.EXPECT().Start(gomock.Any(), gomock.Any()).Return(true, nil)
+				jobRuns.EXPECT().Finish(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, run *model.JobRun) error {
+						assert.Equal(t, model.JobRunStatusFailed, run.Status)
+						assert.Equal(t, int32(1), run.ProcessedCount)
+						if assert.NotNil(t, run.ErrorMessage) {
+							assert.Equal(t, "send failed", *run.ErrorMessage)
+						}
+						return nil
+					})
+			},
+			expectedCalled: true,
+		},
+		{
+			name: "Panic Recorded",
+			run: func(context.Context, time.Time) (int, error) {
+				panic("boom")
+			},
+			mockSetup: func(locker *mockdomain.MockJobLocker, jobRuns *mockdomain.MockJobRunRepository, unlocked *bool) {
+				locker.EXPECT().TryLock(gomock.Any(), testJobName).Return(func() { *unlocked = true }, true, nil)
+				jobRuns.EXPECT().Start(gomock.Any(), gomock.Any()).Return(true, nil)
+				jobRuns.EXPECT().Finish(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, run *model.JobRun) error {
+						assert.Equal(t, model.JobRunStatusFailed, run.Status)
+						if assert.NotNil(t, run.ErrorMessage) {
+							assert.Contains(t, *run.ErrorMessage, "boom")
+						}
+						return nil
+					})
+			},
+			expectedCalled: true,
+		},
+		{
+			name: "Locked By Another Instance",
+			run: func(context.Context, time.Time) (int, error) {
+				return 0, nil
+			},
+			mockSetup: func(locker *mockdomain.MockJobLocker, jobRuns *mockdomain.MockJobRunRepository, unlocked *bool) {
+				*unlocked = true
+				locker.EXPECT().TryLock(gomock.Any(), testJobName).Return(nil, false, nil)
+			},
+		},
+		{
+			name: "Already Run",
+			run: func(context.Context, time.Time) (int, error) {
+				return 0, nil
+			},
+			mockSetup: func(locker *mockdomain.MockJobLocker, jobRuns *mockdomain.MockJobRunRepository, unlocked *bool) {
+				locker.EXPECT().TryLock(gomock.Any(), testJobName).Return(func() { *unlocked = true }, true, nil)
+				jobRuns.EXPECT().Start(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+		},
+		{
+			name: "Lock Error",
+			run: func(context.Context, time.Time) (int, error) {
+				return 0, nil
+			},
+			mockSetup: func(locker *mockdomain.MockJobLocker, jobRuns *mockdomain.MockJobRunRepository, unlocked *bool) {
+				*unlocked = true
+				locker.EXPECT().TryLock(gomock.Any(), testJobName).Return(nil, false, errors.New("connection refused"))
+			},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			locker := mockdomain.NewMockJobLocker(ctrl)
+			jobRuns := mockdomain.NewMockJobRunRepository(ctrl)
+			s := scheduler.New(logger.New(logger.DefaultConfig()), locker, jobRuns, time.UTC)
+
+			var unlocked bool
+			tt.mockSetup(locker, jobRuns, &unlocked)
+
+			var called bool
+			s.Run(context.Background(), &scheduler.Job{
+				Name:     testJobName,
+				Schedule: scheduler.Every(time.Hour),
+				Run: func(ctx context.Context, at time.Time) (int, error) {
+					called = true
+					assert.Equal(t, scheduledAt, at)
+					return tt.run(ctx, at)
+				},
+			}, scheduledAt)
+
+			assert.Equal(t, tt.expectedCalled, called)
+			assert.True(t, unlocked)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"os"
+
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/env"
+)
+
+type localStorage struct {
+	dir string
+}
+
+// NewLocalStorage returns a storage that keeps the files under StorageDir of the local file system
+func NewLocalStorage(e *env.Values) domain.FileStorage {
+	return &localStorage{dir: e.StorageDir}
+}
+
+func (s *localStorage) Ping(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// ディレクトリが存在するだけでなく、書き込めることまで確認する
+	f, err := os.CreateTemp(s.dir, ".ping-*")
+	if err != nil {
+		return err
+	}
+	name := f.Name()
+	if err := f.Close(); err != nil {
+		_ = os.Remove(name)
+		return err
+	}
+
+	return os.Remove(name)
+}
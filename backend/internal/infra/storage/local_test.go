@@ -0,0 +1,56 @@
+package storage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/env"
+	"github.com/AI1411/fullstack-react-go/internal/infra/storage"
+)
+
+func TestLocalStorage_Ping(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name          string
+		dir           func(t *testing.T) string
+		expectedError bool
+	}{
+		{
+			name:          "Writable Directory",
+			dir:           func(t *testing.T) string { return t.TempDir() },
+			expectedError: false,
+		},
+		{
+			name:          "Missing Directory",
+			dir:           func(t *testing.T) string { return filepath.Join(t.TempDir(), "missing") },
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			dir := tt.dir(t)
+			s := storage.NewLocalStorage(&env.Values{Storage: env.Storage{StorageDir: dir}})
+
+			err := s.Ping(context.Background())
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			// 確認用のファイルを残さない
+			entries, err := os.ReadDir(dir)
+			assert.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/env"
+)
+
+// responseBodyLimit is how much of the response body is kept for delivery logs
+const responseBodyLimit = 2 << 10
+
+var tracer = otel.Tracer("github.com/AI1411/fullstack-react-go/internal/infra/webhook")
+
+type httpSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender returns a sender that posts to public addresses only, unless the environment allows private
+// networks. Redirects are not followed, so that a public URL can not redirect a delivery to an internal one.
+func NewHTTPSender(e *env.Values) domain.WebhookSender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// プロキシ経由では接続先のアドレスを確認できないため使わない
+	transport.Proxy = nil
+	transport.DialContext = newDialer(e.NotificationWebhookTimeout, e.WebhookAllowPrivateNetworks).DialContext
+
+	return &httpSender{
+		client: &http.Client{
+			Timeout:   e.NotificationWebhookTimeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *httpSender) Post(ctx context.Context, url string, payload []byte) error {
+	res, err := s.Deliver(ctx, url, nil, payload)
+	if err != nil {
+		return err
+	}
+
+	if !res.Succeeded() {
+		return fmt.Errorf("webhook %s responded with status %d", url, res.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *httpSender) Deliver(ctx context.Context, url string, header http.Header, payload []byte) (*model.WebhookResponse, error) {
+	ctx, span := tracer.Start(ctx, http.MethodPost,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPRequestMethodKey.String(http.MethodPost)),
+	)
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// 受信側が配信を同じトレースに関連付けられるよう traceparent を送る
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	// URLにはクエリで認証情報が含まれることがあるため、ホストとパスだけを記録する
+	span.SetAttributes(semconv.ServerAddress(req.URL.Hostname()), semconv.URLPath(req.URL.Path))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(res.StatusCode))
+	if res.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, responseBodyLimit))
+	// 接続を再利用できるよう残りのボディを読み捨てる
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	return &model.WebhookResponse{
+		StatusCode: res.StatusCode,
+		Body:       strings.ToValidUTF8(string(body), string(utf8.RuneError)),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/AI1411/fullstack-react-go/internal/env"
+	"github.com/AI1411/fullstack-react-go/internal/infra/webhook"
+)
+
+func TestHTTPSender_Deliver(t *testing.T) {
+	// Setup
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := webhook.NewHTTPSender(&env.Values{Notification: env.Notification{
+		NotificationWebhookTimeout:  time.Second,
+		WebhookAllowPrivateNetworks: true,
+	}})
+
+	// Test cases
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus codes.Code
+	}{
+		{
+			name:           "Accepted",
+			path:           "/hook",
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:           "Rejected",
+			path:           "/broken",
+			expectedStatus: codes.Error,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+			traceparent = ""
+
+			_, err := sender.Deliver(context.Background(), server.URL+tt.path, nil, []byte(`{}`))
+			assert.NoError(t, err)
+
+			spans := exporter.GetSpans()
+			if !assert.Len(t, spans, 1) {
+				return
+			}
+			assert.Equal(t, tt.expectedStatus, spans[0].Status.Code)
+
+			// 受信側には配信のスパンが親として伝わる
+			sc := spans[0].SpanContext
+			assert.Equal(t, "00-"+sc.TraceID().String()+"-"+sc.SpanID().String()+"-01", traceparent)
+		})
+	}
+}
+
+func TestHTTPSender_Deliver_ForbiddenAddress(t *testing.T) {
+	// Setup
+	var received bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, server.URL, http.StatusFound)
+	}))
+	defer redirect.Close()
+
+	sender := webhook.NewHTTPSender(&env.Values{Notification: env.Notification{NotificationWebhookTimeout: time.Second}})
+	allowPrivate := webhook.NewHTTPSender(&env.Values{Notification: env.Notification{
+		NotificationWebhookTimeout:  time.Second,
+		WebhookAllowPrivateNetworks: true,
+	}})
+
+	// Test cases
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{
+			name: "Loopback",
+			url:  server.URL,
+		},
+		{
+			name: "Metadata",
+			url:  "http://169.254.169.254/latest/meta-data/",
+		},
+		{
+			name: "Private",
+			url:  "http://10.0.0.1/hook",
+		},
+		{
+			name: "IPv4 Mapped Loopback",
+			url:  "http://[::ffff:127.0.0.1]:1/hook",
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sender.Deliver(context.Background(), tt.url, nil, []byte(`{}`))
+			assert.ErrorIs(t, err, webhook.ErrForbiddenAddress)
+		})
+	}
+
+	// リダイレクトは追わず、リダイレクトの応答をそのまま返す
+	t.Run("Redirect Not Followed", func(t *testing.T) {
+		received = false
+		res, err := allowPrivate.Deliver(context.Background(), redirect.URL, nil, []byte(`{}`))
+		if assert.NoError(t, err) {
+			assert.Equal(t, http.StatusFound, res.StatusCode)
+		}
+		assert.False(t, received)
+	})
+}
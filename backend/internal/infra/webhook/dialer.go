@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned when a webhook URL resolves to an address webhooks must not reach
+var ErrForbiddenAddress = errors.New("webhook address is not allowed")
+
+// forbiddenPrefixes are the ranges not covered by the netip.Addr predicates that webhooks must not reach
+var forbiddenPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // 「このネットワーク」
+	netip.MustParsePrefix("100.64.0.0/10"), // キャリアグレードNAT（一部のクラウドのメタデータを含む）
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETFプロトコル割り当て
+	netip.MustParsePrefix("198.18.0.0/15"), // ベンチマーク用
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64（IPv4の内部アドレスに変換される）
+}
+
+// isPublicAddr reports whether addr is a globally routable unicast address
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsUnspecified() ||
+		addr.IsMulticast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() {
+		return false
+	}
+
+	for _, prefix := range forbiddenPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newDialer returns a dialer that refuses to connect to non-public addresses unless allowPrivate is set.
+// The check runs on the address being connected to, after name resolution, so that a host name resolving
+// to an internal address, or changing its address between checks, can not get through.
+func newDialer(timeout time.Duration, allowPrivate bool) *net.Dialer {
+	dialer := &net.Dialer{Timeout: timeout}
+	if allowPrivate {
+		return dialer
+	}
+
+	dialer.Control = func(_, address string, _ syscall.RawConn) error {
+		addrPort, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+		}
+
+		if !isPublicAddr(addrPort.Addr()) {
+			return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+		}
+
+		return nil
+	}
+
+	return dialer
+}
@@ -0,0 +1,326 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// datetimeLayout is the validator datetime layout of RFC 3339 timestamps
+const datetimeLayout = "2006-01-02T15:04:05Z07:00"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// direction is whether a type is read from a request or written to a response.
+// Requests require the fields validated with required, responses the fields that are always written.
+type direction int
+
+const (
+	response direction = iota
+	request
+)
+
+// schemaKey identifies a component schema; a type read from requests gets its own schema
+type schemaKey struct {
+	t   reflect.Type
+	dir direction
+}
+
+// schemaGenerator converts Go types to schemas, registering named structs as component schemas
+type schemaGenerator struct {
+	schemas map[string]*Schema
+	names   map[schemaKey]string
+	owners  map[string]schemaKey
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas: map[string]*Schema{},
+		names:   map[schemaKey]string{},
+		owners:  map[string]schemaKey{},
+	}
+}
+
+// schemaOf returns the schema of the type of v, a reference for named structs
+func (g *schemaGenerator) schemaOf(v any, dir direction) *Schema {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return g.schema(t, dir)
+}
+
+func (g *schemaGenerator) schema(t reflect.Type, dir direction) *Schema {
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		// 任意のJSONを埋め込むため型を限定しない
+		return &Schema{}
+	case t.Kind() != reflect.Struct && reflect.PointerTo(t).Implements(textMarshalerType):
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schema(t.Elem(), dir)
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer"}
+	case reflect.Int32, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schema(t.Elem(), dir)}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(t.Elem(), dir)}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t, dir)
+		}
+
+		return &Schema{Ref: "#/components/schemas/" + g.register(t, dir)}
+	default:
+		// interfaceなど、中身が実行時に決まる型は限定しない
+		return &Schema{}
+	}
+}
+
+// register adds the component schema of a named struct once and returns its name
+func (g *schemaGenerator) register(t reflect.Type, dir direction) string {
+	key := schemaKey{t: t, dir: dir}
+	if name, ok := g.names[key]; ok {
+		return name
+	}
+
+	name := g.componentName(key)
+	g.names[key] = name
+	g.owners[name] = key
+	// 再帰的な型が自身を参照できるよう、フィールドを解決する前に名前を登録する
+	g.schemas[name] = g.structSchema(t, dir)
+
+	return name
+}
+
+// componentName names the schema after the type. Types read from requests that are not named as requests get
+// an Input suffix so they do not collide with the response schema, and types of the same name in different
+// packages are prefixed with the package name.
+func (g *schemaGenerator) componentName(key schemaKey) string {
+	name := key.t.Name()
+	if key.dir == request && !strings.HasSuffix(name, "Request") {
+		name += "Input"
+	}
+
+	if owner, ok := g.owners[name]; ok && owner.t != key.t {
+		pkg := key.t.PkgPath()
+		pkg = pkg[strings.LastIndex(pkg, "/")+1:]
+		name = string(unicode.ToUpper(rune(pkg[0]))) + pkg[1:] + name
+	}
+
+	return name
+}
+
+// structSchema returns the object schema of the exported fields, flattening embedded structs like encoding/json
+func (g *schemaGenerator) structSchema(t reflect.Type, dir direction) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	g.addFields(s, t, dir)
+
+	return s
+}
+
+func (g *schemaGenerator) addFields(s *Schema, t reflect.Type, dir direction) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				g.addFields(s, ft, dir)
+
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		omitempty := strings.Contains(opts, "omitempty")
+		rules := strings.Split(f.Tag.Get("binding"), ",")
+
+		fs := g.schema(ft, dir)
+		applyRules(fs, ft, rules)
+
+		required := false
+		switch dir {
+		case request:
+			required = hasRule(rules, "required")
+			// ポインタはnullを受け付け、未指定と同じく扱われる
+			if ft.Kind() == reflect.Pointer && !required {
+				fs = nullable(fs)
+			}
+		case response:
+			// omitemptyのないフィールドは常に出力され、nilのポインタはnullになる
+			required = !omitempty
+			if ft.Kind() == reflect.Pointer && !omitempty {
+				fs = nullable(fs)
+			}
+		}
+
+		s.Properties[name] = fs
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// nullable allows null in addition to the schema
+func nullable(s *Schema) *Schema {
+	switch {
+	case s.Ref != "":
+		return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+	case s.Type == nil:
+		return s
+	default:
+		if t, ok := s.Type.(string); ok {
+			s.Type = []string{t, "null"}
+		}
+
+		return s
+	}
+}
+
+func hasRule(rules []string, rule string) bool {
+	for _, r := range rules {
+		if r == rule {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyRules translates the validator rules of a field into schema keywords.
+// Rules after dive apply to the elements of a slice.
+func applyRules(s *Schema, t reflect.Type, rules []string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for i, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "dive":
+			if s.Items != nil {
+				applyRules(s.Items, t.Elem(), rules[i+1:])
+			}
+
+			return
+		case "oneof":
+			for _, v := range strings.Fields(param) {
+				s.Enum = append(s.Enum, enumValue(t, v))
+			}
+		case "min", "gte":
+			applyBound(s, t, param, true)
+		case "max", "lte":
+			applyBound(s, t, param, false)
+		case "email":
+			s.Format = "email"
+		case "url":
+			s.Format = "uri"
+		case "uuid":
+			s.Format = "uuid"
+		case "datetime":
+			if param == datetimeLayout {
+				s.Format = "date-time"
+			}
+		}
+	}
+}
+
+// applyBound sets the bound of a min/max rule, which limits the length of strings, the number of items of slices
+// and the value of numbers
+func applyBound(s *Schema, t reflect.Type, param string, lower bool) {
+	switch t.Kind() {
+	case reflect.String:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return
+		}
+
+		if lower {
+			s.MinLength = &n
+		} else {
+			s.MaxLength = &n
+		}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return
+		}
+
+		if lower {
+			s.MinItems = &n
+		} else {
+			s.MaxItems = &n
+		}
+	default:
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return
+		}
+
+		if lower {
+			s.Minimum = &n
+		} else {
+			s.Maximum = &n
+		}
+	}
+}
+
+// enumValue converts a oneof value to the JSON type of the field
+func enumValue(t reflect.Type, v string) any {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return n
+		}
+	}
+
+	return v
+}
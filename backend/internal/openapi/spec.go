@@ -0,0 +1,228 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Parameter locations
+const (
+	InPath   = "path"
+	InQuery  = "query"
+	InHeader = "header"
+)
+
+// Content types
+const (
+	ContentTypeJSON        = "application/json"
+	ContentTypeEventStream = "text/event-stream"
+)
+
+// pathParam matches the gin path parameters converted to OpenAPI templates
+var pathParam = regexp.MustCompile(`[:*](\w+)`)
+
+// Spec is the API the document is built from
+type Spec struct {
+	Info            Info
+	Servers         []Server
+	Tags            []Tag
+	SecuritySchemes map[string]*SecurityScheme
+	// ErrorBody is the body of the error responses
+	ErrorBody any
+	Routes    []Route
+}
+
+// Route documents a route registered on the router
+type Route struct {
+	Method string
+	// Path is the gin path relative to the servers, e.g. /disasters/:id
+	Path        string
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+	// Servers overrides the servers of the document for routes outside of them
+	Servers []Server
+	Params  []Param
+	// Query is a struct whose form tags and binding rules describe the query parameters
+	Query any
+	// Body is the JSON request body; it is required unless BodyOptional is set
+	Body         any
+	BodyOptional bool
+	Responses    []RouteResponse
+	// Errors are the statuses returned with the error body
+	Errors   []int
+	Security []SecurityRequirement
+}
+
+// Param is a path, query or header parameter that is not bound from a struct
+type Param struct {
+	In          string
+	Name        string
+	Description string
+	// Type is a value of the Go type of the parameter
+	Type     any
+	Required bool
+	Enum     []any
+}
+
+// RouteResponse is a successful response of a route
+type RouteResponse struct {
+	Status      int
+	Description string
+	// Body is a value of the type written, nil when there is no body
+	Body        any
+	ContentType string
+	Headers     map[string]*Header
+}
+
+// Document builds the OpenAPI document of the spec
+func (s *Spec) Document() *Document {
+	g := newSchemaGenerator()
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    s.Info,
+		Servers: s.Servers,
+		Tags:    s.Tags,
+		Paths:   map[string]PathItem{},
+	}
+
+	for _, r := range s.Routes {
+		path := OpenAPIPath(r.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = PathItem{}
+		}
+
+		doc.Paths[path][strings.ToLower(r.Method)] = s.operation(g, r)
+	}
+
+	doc.Components = Components{
+		Schemas:         g.schemas,
+		SecuritySchemes: s.SecuritySchemes,
+	}
+
+	return doc
+}
+
+func (s *Spec) operation(g *schemaGenerator, r Route) *Operation {
+	op := &Operation{
+		OperationID: r.OperationID,
+		Summary:     r.Summary,
+		Description: r.Description,
+		Tags:        r.Tags,
+		Servers:     r.Servers,
+		Responses:   map[string]*Response{},
+		Security:    r.Security,
+	}
+
+	// パスのパラメータは明示されていなければ文字列として扱う
+	declared := map[string]bool{}
+	for _, p := range r.Params {
+		if p.In == InPath {
+			declared[p.Name] = true
+		}
+	}
+
+	for _, m := range pathParam.FindAllStringSubmatch(r.Path, -1) {
+		if !declared[m[1]] {
+			op.Parameters = append(op.Parameters, &Parameter{Name: m[1], In: InPath, Required: true, Schema: &Schema{Type: "string"}})
+		}
+	}
+
+	for _, p := range r.Params {
+		ps := &Schema{Type: "string"}
+		if p.Type != nil {
+			ps = g.schemaOf(p.Type, request)
+		}
+
+		ps.Enum = p.Enum
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:        p.Name,
+			In:          p.In,
+			Description: p.Description,
+			Required:    p.Required || p.In == InPath,
+			Schema:      ps,
+		})
+	}
+
+	if r.Query != nil {
+		op.Parameters = append(op.Parameters, g.queryParameters(r.Query)...)
+	}
+
+	if r.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: !r.BodyOptional,
+			Content:  map[string]*MediaType{ContentTypeJSON: {Schema: g.schemaOf(r.Body, request)}},
+		}
+	}
+
+	for _, res := range r.Responses {
+		out := &Response{Description: res.Description, Headers: res.Headers}
+		if out.Description == "" {
+			out.Description = http.StatusText(res.Status)
+		}
+
+		if res.Body != nil {
+			contentType := res.ContentType
+			if contentType == "" {
+				contentType = ContentTypeJSON
+			}
+
+			out.Content = map[string]*MediaType{contentType: {Schema: g.schemaOf(res.Body, response)}}
+		}
+
+		op.Responses[strconv.Itoa(res.Status)] = out
+	}
+
+	statuses := append([]int(nil), r.Errors...)
+	sort.Ints(statuses)
+	for _, status := range statuses {
+		out := &Response{Description: http.StatusText(status)}
+		if s.ErrorBody != nil {
+			out.Content = map[string]*MediaType{ContentTypeJSON: {Schema: g.schemaOf(s.ErrorBody, response)}}
+		}
+
+		op.Responses[strconv.Itoa(status)] = out
+	}
+
+	return op
+}
+
+// queryParameters returns the query parameters bound from the form tags of a struct
+func (g *schemaGenerator) queryParameters(v any) []*Parameter {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var params []*Parameter
+	for i := range t.NumField() {
+		f := t.Field(i)
+
+		name, _, _ := strings.Cut(f.Tag.Get("form"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		rules := strings.Split(f.Tag.Get("binding"), ",")
+		ps := g.schema(f.Type, request)
+		applyRules(ps, f.Type, rules)
+		params = append(params, &Parameter{
+			Name:     name,
+			In:       InQuery,
+			Required: hasRule(rules, "required"),
+			Schema:   ps,
+		})
+	}
+
+	return params
+}
+
+// OpenAPIPath converts the parameters of a gin path to OpenAPI templates, e.g. /disasters/:id to /disasters/{id}
+func OpenAPIPath(path string) string {
+	return pathParam.ReplaceAllString(path, "{$1}")
+}
@@ -0,0 +1,259 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AI1411/fullstack-react-go/internal/openapi"
+)
+
+type item struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Note      *string    `json:"note"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	Payload   json.RawMessage
+	Children  []*item `json:"children,omitempty"`
+}
+
+type base struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type createItemResponse struct {
+	*base
+	Item   item   `json:"item"`
+	Secret string `json:"-"`
+}
+
+type createItemRequest struct {
+	Name   string   `json:"name" binding:"required,max=100"`
+	Status string   `json:"status" binding:"omitempty,oneof=open closed"`
+	Level  *int32   `json:"level" binding:"omitempty,gte=1,lte=5"`
+	Tags   []string `json:"tags" binding:"required,min=1,dive,max=20"`
+	Email  string   `json:"email" binding:"omitempty,email"`
+	Parent *item    `json:"parent"`
+}
+
+type listItemsRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=open closed"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=200"`
+	From   string `form:"from" binding:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// toJSON marshals v and decodes it back so that schemas can be compared as plain JSON
+func toJSON(t *testing.T, v any) any {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	var out any
+	require.NoError(t, json.Unmarshal(b, &out))
+
+	return out
+}
+
+func TestSpec_Document(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name     string
+		route    openapi.Route
+		path     string
+		method   string
+		expected string
+		schemas  map[string]string
+	}{
+		{
+			name: "Response Schema",
+			route: openapi.Route{
+				Method:    http.MethodGet,
+				Path:      "/items/:id",
+				Responses: []openapi.RouteResponse{{Status: http.StatusOK, Body: item{}}},
+			},
+			path:   "/items/{id}",
+			method: "get",
+			expected: `{
+				"operationId": "",
+				"parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+				"responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/item"}}}}}
+			}`,
+			schemas: map[string]string{
+				"item": `{
+					"type": "object",
+					"properties": {
+						"id": {"type": "integer", "format": "int64"},
+						"name": {"type": "string"},
+						"note": {"type": ["string", "null"]},
+						"deleted_at": {"type": "string", "format": "date-time"},
+						"Payload": {},
+						"children": {"type": "array", "items": {"$ref": "#/components/schemas/item"}}
+					},
+					"required": ["id", "name", "note", "Payload"]
+				}`,
+			},
+		},
+		{
+			name: "Embedded Struct",
+			route: openapi.Route{
+				Method:    http.MethodPost,
+				Path:      "/items",
+				Responses: []openapi.RouteResponse{{Status: http.StatusCreated, Body: &createItemResponse{}}},
+			},
+			path:   "/items",
+			method: "post",
+			expected: `{
+				"operationId": "",
+				"responses": {"201": {"description": "Created", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/createItemResponse"}}}}}
+			}`,
+			schemas: map[string]string{
+				"createItemResponse": `{
+					"type": "object",
+					"properties": {
+						"created_at": {"type": "string", "format": "date-time"},
+						"item": {"$ref": "#/components/schemas/item"}
+					},
+					"required": ["created_at", "item"]
+				}`,
+			},
+		},
+		{
+			name: "Request Body",
+			route: openapi.Route{
+				Method: http.MethodPost,
+				Path:   "/items",
+				Body:   createItemRequest{},
+			},
+			path:   "/items",
+			method: "post",
+			expected: `{
+				"operationId": "",
+				"requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/createItemRequest"}}}},
+				"responses": {}
+			}`,
+			schemas: map[string]string{
+				"createItemRequest": `{
+					"type": "object",
+					"properties": {
+						"name": {"type": "string", "maxLength": 100},
+						"status": {"type": "string", "enum": ["open", "closed"]},
+						"level": {"type": ["integer", "null"], "format": "int32", "minimum": 1, "maximum": 5},
+						"tags": {"type": "array", "items": {"type": "string", "maxLength": 20}, "minItems": 1},
+						"email": {"type": "string", "format": "email"},
+						"parent": {"anyOf": [{"$ref": "#/components/schemas/itemInput"}, {"type": "null"}]}
+					},
+					"required": ["name", "tags"]
+				}`,
+				"itemInput": `{
+					"type": "object",
+					"properties": {
+						"id": {"type": "integer", "format": "int64"},
+						"name": {"type": "string"},
+						"note": {"type": ["string", "null"]},
+						"deleted_at": {"type": ["string", "null"], "format": "date-time"},
+						"Payload": {},
+						"children": {"type": "array", "items": {"$ref": "#/components/schemas/itemInput"}}
+					}
+				}`,
+			},
+		},
+		{
+			name: "Parameters And Errors",
+			route: openapi.Route{
+				Method: http.MethodGet,
+				Path:   "/items",
+				Params: []openapi.Param{
+					{In: openapi.InHeader, Name: "X-Request-ID", Description: "request id"},
+					{In: openapi.InQuery, Name: "sort", Enum: []any{"asc", "desc"}},
+				},
+				Query:  listItemsRequest{},
+				Errors: []int{http.StatusNotFound, http.StatusBadRequest},
+			},
+			path:   "/items",
+			method: "get",
+			expected: `{
+				"operationId": "",
+				"parameters": [
+					{"name": "X-Request-ID", "in": "header", "description": "request id", "schema": {"type": "string"}},
+					{"name": "sort", "in": "query", "schema": {"type": "string", "enum": ["asc", "desc"]}},
+					{"name": "status", "in": "query", "schema": {"type": "string", "enum": ["open", "closed"]}},
+					{"name": "limit", "in": "query", "schema": {"type": "integer", "minimum": 1, "maximum": 200}},
+					{"name": "from", "in": "query", "required": true, "schema": {"type": "string", "format": "date-time"}}
+				],
+				"responses": {
+					"400": {"description": "Bad Request", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/errorResponse"}}}},
+					"404": {"description": "Not Found", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/errorResponse"}}}}
+				}
+			}`,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			type errorResponse struct {
+				Code string `json:"code"`
+			}
+
+			spec := &openapi.Spec{ErrorBody: errorResponse{}, Routes: []openapi.Route{tt.route}}
+
+			// Execute
+			doc := spec.Document()
+
+			// Check results
+			assert.Equal(t, openapi.Version, doc.OpenAPI)
+			require.Contains(t, doc.Paths, tt.path)
+			require.Contains(t, doc.Paths[tt.path], tt.method)
+
+			var expected any
+			require.NoError(t, json.Unmarshal([]byte(tt.expected), &expected))
+			assert.Equal(t, expected, toJSON(t, doc.Paths[tt.path][tt.method]))
+
+			for name, schema := range tt.schemas {
+				require.Contains(t, doc.Components.Schemas, name)
+
+				var expected any
+				require.NoError(t, json.Unmarshal([]byte(schema), &expected))
+				assert.Equal(t, expected, toJSON(t, doc.Components.Schemas[name]), name)
+			}
+		})
+	}
+}
+
+func TestOpenAPIPath(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			name:     "No Parameters",
+			path:     "/disasters",
+			expected: "/disasters",
+		},
+		{
+			name:     "Parameters",
+			path:     "/trash/:type/:id",
+			expected: "/trash/{type}/{id}",
+		},
+		{
+			name:     "Parameter In The Middle",
+			path:     "/disasters/:id/timelines",
+			expected: "/disasters/{id}/timelines",
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, openapi.OpenAPIPath(tt.path))
+		})
+	}
+}
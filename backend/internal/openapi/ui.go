@@ -0,0 +1,60 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"strings"
+
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+// SpecFile is the path the document is served at, relative to the UI
+const SpecFile = "/openapi.json"
+
+//go:embed ui.html
+var uiHTML string
+
+var uiTemplate = template.Must(template.New("ui").Parse(uiHTML))
+
+// uiHandler serves Swagger UI, the document it shows and the Swagger UI assets under a prefix
+type uiHandler struct {
+	prefix string
+	title  string
+	spec   []byte
+	assets http.Handler
+}
+
+// NewUIHandler returns a handler that serves Swagger UI for the document at prefix, the document at
+// prefix + SpecFile and the assets of Swagger UI, which are embedded in the binary.
+func NewUIHandler(prefix string, doc *Document) (http.Handler, error) {
+	spec, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &uiHandler{
+		prefix: prefix,
+		title:  doc.Info.Title,
+		spec:   spec,
+		assets: http.StripPrefix(prefix, http.FileServerFS(swaggerFiles.FS)),
+	}, nil
+}
+
+func (h *uiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimPrefix(r.URL.Path, h.prefix) {
+	case "", "/", "/index.html":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = uiTemplate.Execute(w, map[string]string{
+			"Title":   h.title,
+			"Prefix":  h.prefix,
+			"SpecURL": h.prefix + SpecFile,
+		})
+	case SpecFile:
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(h.spec)
+	default:
+		h.assets.ServeHTTP(w, r)
+	}
+}
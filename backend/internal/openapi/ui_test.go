@@ -0,0 +1,78 @@
+package openapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AI1411/fullstack-react-go/internal/openapi"
+)
+
+func TestUIHandler(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:                "Index",
+			path:                "/docs",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        `<title>Test API</title>`,
+		},
+		{
+			name:                "Index With Trailing Slash",
+			path:                "/docs/",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        `src="/docs/swagger-ui-bundle.js"`,
+		},
+		{
+			name:                "Document",
+			path:                "/docs/openapi.json",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+			expectedBody:        `"openapi":"3.1.0"`,
+		},
+		{
+			name:                "Asset",
+			path:                "/docs/swagger-ui.css",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/css; charset=utf-8",
+			expectedBody:        ".swagger-ui",
+		},
+		{
+			name:           "Not Found",
+			path:           "/docs/missing.js",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			doc := (&openapi.Spec{Info: openapi.Info{Title: "Test API", Version: "1.0"}}).Document()
+			h, err := openapi.NewUIHandler("/docs", doc)
+			require.NoError(t, err)
+
+			// Execute
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			// Check results
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedContentType != "" {
+				assert.Equal(t, tt.expectedContentType, w.Header().Get("Content-Type"))
+			}
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/AI1411/fullstack-react-go/internal/env"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+// serveHTTP runs the HTTP server for the lifetime of the application. On stop it calls startDraining so that
+// readiness fails and streams close, keeps serving for the drain delay, then stops accepting connections and
+// waits for the in-flight requests to finish.
+func serveHTTP(lc fx.Lifecycle, l *logger.Logger, env *env.Values, handler http.Handler, startDraining context.CancelFunc) {
+	srv := &http.Server{
+		Addr:         fmt.Sprintf(":%s", env.ServerPort),
+		Handler:      handler,
+		ReadTimeout:  env.ServerReadTimeout,
+		WriteTimeout: env.ServerWriteTimeout,
+		IdleTimeout:  env.ServerIdleTimeout,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// ポートを確保できない場合は起動を失敗させる
+			ln, err := net.Listen("tcp", srv.Addr)
+			if err != nil {
+				return err
+			}
+
+			l.Info("Starting server", "addr", srv.Addr)
+			go func() {
+				if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					l.Error("HTTP server stopped unexpectedly", "error", err)
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			l.Info("Draining server", "drain_delay", env.ServerDrainDelay.String())
+			startDraining()
+
+			select {
+			case <-time.After(env.ServerDrainDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			l.Info("Shutting down server")
+			if err := srv.Shutdown(ctx); err != nil {
+				return fmt.Errorf("failed to drain in-flight requests: %w", err)
+			}
+
+			return nil
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"go.uber.org/fx"
+
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/env"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/infra/metrics"
+)
+
+// RegisterMetrics adds the business metrics read from the database and serves all metrics on /metrics of an
+// internal listener on MetricsPort, which is not published with the API
+func RegisterMetrics(
+	lc fx.Lifecycle,
+	l *logger.Logger,
+	env *env.Values,
+	m *metrics.Metrics,
+	businessMetricsRepo domain.BusinessMetricsRepository,
+) error {
+	if err := m.Register(metrics.NewBusinessCollector(businessMetricsRepo, env.MetricsQueryTimeout, env.MetricsCacheTTL)); err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", m.Handler())
+
+	srv := &http.Server{
+		Addr:         fmt.Sprintf(":%s", env.MetricsPort),
+		Handler:      mux,
+		ReadTimeout:  env.ServerReadTimeout,
+		WriteTimeout: env.ServerWriteTimeout,
+		IdleTimeout:  env.ServerIdleTimeout,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// ポートを確保できない場合は起動を失敗させる
+			ln, err := net.Listen("tcp", srv.Addr)
+			if err != nil {
+				return err
+			}
+
+			l.Info("Starting metrics server", "addr", srv.Addr)
+			go func() {
+				if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					l.Error("Metrics server stopped unexpectedly", "error", err)
+				}
+			}()
+
+			return nil
+		},
+		OnStop: srv.Shutdown,
+	})
+
+	return nil
+}
@@ -1,14 +1,18 @@
 package middleware
 
 import (
-	"net/http"
+	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/env"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 )
 
 // AuthMiddleware is a middleware for JWT authentication
@@ -20,8 +24,7 @@ func AuthMiddleware(env *env.Values) gin.HandlerFunc {
 			// Try to get token from cookie
 			tokenCookie, err := c.Cookie("auth_token")
 			if err != nil {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header or cookie is required"})
-				c.Abort()
+				abortWithError(c, unauthorized("authorization header or cookie is required"))
 				return
 			}
 			authHeader = "Bearer " + tokenCookie
@@ -29,8 +32,7 @@ func AuthMiddleware(env *env.Values) gin.HandlerFunc {
 
 		// Check if the header has the Bearer prefix
 		if !strings.HasPrefix(authHeader, "Bearer ") {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization format"})
-			c.Abort()
+			abortWithError(c, unauthorized("invalid authorization format"))
 			return
 		}
 
@@ -38,40 +40,29 @@ func AuthMiddleware(env *env.Values) gin.HandlerFunc {
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
 		// Parse and validate the token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Validate the signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, errors.New("unexpected signing method")
-			}
-			return []byte(env.JWTSecret), nil
-		})
-
+		token, err := parseToken(tokenString, env.JWTSecret)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
-			c.Abort()
+			abortWithError(c, unauthorized("invalid or expired token"))
 			return
 		}
 
 		// Check if the token is valid
 		if !token.Valid {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			c.Abort()
+			abortWithError(c, unauthorized("invalid token"))
 			return
 		}
 
 		// Extract claims
 		claims, ok := token.Claims.(jwt.MapClaims)
 		if !ok {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
-			c.Abort()
+			abortWithError(c, unauthorized("invalid token claims"))
 			return
 		}
 
 		// Set user ID in context
-		userID, ok := claims["sub"]
+		userID, ok := userIDClaim(claims)
 		if !ok {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
-			c.Abort()
+			abortWithError(c, unauthorized("invalid token claims"))
 			return
 		}
 
@@ -79,6 +70,92 @@ func AuthMiddleware(env *env.Values) gin.HandlerFunc {
 		c.Set("user_id", userID)
 		c.Set("user_email", claims["email"])
 		c.Set("user_name", claims["name"])
+		if roleID, ok := claims["role_id"].(float64); ok {
+			c.Set("role_id", int16(roleID))
+		}
+
+		c.Next()
+	}
+}
+
+// parseToken parses an access token and validates its signature and expiry
+func parseToken(tokenString, secret string) (*jwt.Token, error) {
+	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		// Validate the signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(secret), nil
+	})
+}
+
+// userIDClaim returns the user ID of the token claims
+func userIDClaim(claims jwt.MapClaims) (any, bool) {
+	// アプリが発行するトークンはuser_id、外部IdPのトークンはsubにユーザーIDを持つ
+	if userID, ok := claims["user_id"]; ok {
+		return userID, true
+	}
+	userID, ok := claims["sub"]
+
+	return userID, ok
+}
+
+// requestClient identifies the client of a request by the user of its access token, or by its IP address
+func requestClient(c *gin.Context, secret string) string {
+	if userID, ok := c.Get("user_id"); ok {
+		return fmt.Sprintf("user:%v", userID)
+	}
+
+	// 認証はルートごとに行われるため、ここではトークンの検証だけを行いユーザーを特定する
+	// 検証できないトークンではユーザーを詐称できないよう、IPアドレスで識別する
+	tokenString := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if tokenString == "" {
+		tokenString, _ = c.Cookie("auth_token")
+	}
+	if tokenString != "" {
+		if token, err := parseToken(tokenString, secret); err == nil && token.Valid {
+			if claims, ok := token.Claims.(jwt.MapClaims); ok {
+				if userID, ok := userIDClaim(claims); ok {
+					return fmt.Sprintf("user:%v", userID)
+				}
+			}
+		}
+	}
+
+	return "ip:" + c.ClientIP()
+}
+
+// apiVersionPrefix matches the version prefix of a route template such as /api/v1
+var apiVersionPrefix = regexp.MustCompile(`^/api/v\d+`)
+
+// isAuthRoute reports whether a route template is one of the login and registration routes of any API version
+func isAuthRoute(route string) bool {
+	return strings.HasPrefix(apiVersionPrefix.ReplaceAllString(route, ""), "/auth/")
+}
+
+// unauthorized returns the error for a request without a valid access token
+func unauthorized(reason string) error {
+	return myerrors.NewAPIError(myerrors.UnauthorizedError, myerrors.UnauthorizedErrorMessage, errors.New(reason), "unauthorized")
+}
+
+// RequireAdmin restricts a route to system administrators. It must be used after AuthMiddleware.
+func RequireAdmin() gin.HandlerFunc {
+	return RequireRole(model.RoleIDSystemAdmin)
+}
+
+// RequireRole restricts a route to users with one of the roles. It must be used after AuthMiddleware.
+func RequireRole(roleIDs ...int16) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		roleID, ok := c.Get("role_id")
+		if id, isRoleID := roleID.(int16); !ok || !isRoleID || !slices.Contains(roleIDs, id) {
+			abortWithError(c, myerrors.NewAPIError(
+				myerrors.ForbiddenError,
+				myerrors.ForbiddenErrorMessage,
+				errors.New("the role is not allowed"),
+				"forbidden",
+			))
+			return
+		}
 
 		c.Next()
 	}
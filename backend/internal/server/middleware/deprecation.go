@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeprecationConfig describes routes that are kept for existing clients but replaced by a successor
+type DeprecationConfig struct {
+	// SuccessorPrefix is prepended to the request path to get the path of the successor route
+	SuccessorPrefix string
+	// DeprecatedAt is when the routes were deprecated
+	DeprecatedAt time.Time
+	// Sunset is when the routes are removed; the Sunset header is not sent when it is zero
+	Sunset time.Time
+}
+
+// NewDeprecation marks the responses of deprecated routes with the Deprecation (RFC 9745) and Sunset (RFC 8594)
+// headers, and links to the successor route so that clients can find where to migrate
+func NewDeprecation(cfg DeprecationConfig) gin.HandlerFunc {
+	deprecation := fmt.Sprintf("@%d", cfg.DeprecatedAt.Unix())
+	sunset := ""
+	if !cfg.Sunset.IsZero() {
+		sunset = cfg.Sunset.UTC().Format(http.TimeFormat)
+	}
+
+	return func(c *gin.Context) {
+		c.Header("Deprecation", deprecation)
+		if sunset != "" {
+			c.Header("Sunset", sunset)
+		}
+		c.Writer.Header().Add("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, cfg.SuccessorPrefix, c.Request.URL.EscapedPath()))
+
+		c.Next()
+	}
+}
@@ -0,0 +1,80 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+func TestDeprecation(t *testing.T) {
+	deprecatedAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	// Test cases
+	tests := []struct {
+		name            string
+		path            string
+		sunset          time.Time
+		expectedHeaders map[string]string
+	}{
+		{
+			name:   "With Sunset",
+			path:   "/disasters/1",
+			sunset: time.Date(2027, 4, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+			expectedHeaders: map[string]string{
+				"Deprecation": "@1792368000",
+				"Sunset":      "Thu, 01 Apr 2027 00:00:00 GMT",
+				"Link":        `</api/v1/disasters/1>; rel="successor-version"`,
+			},
+		},
+		{
+			name: "Without Sunset",
+			path: "/disasters/1",
+			expectedHeaders: map[string]string{
+				"Deprecation": "@1792368000",
+				"Sunset":      "",
+				"Link":        `</api/v1/disasters/1>; rel="successor-version"`,
+			},
+		},
+		{
+			name: "Escaped Path",
+			path: "/trash/disaster/a%2Fb",
+			expectedHeaders: map[string]string{
+				"Link": `</api/v1/trash/disaster/a%2Fb>; rel="successor-version"`,
+			},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.UseRawPath = true
+			r.Use(middleware.NewDeprecation(middleware.DeprecationConfig{
+				SuccessorPrefix: "/api/v1",
+				DeprecatedAt:    deprecatedAt,
+				Sunset:          tt.sunset,
+			}))
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			r.GET("/disasters/:id", ok)
+			r.GET("/trash/:type/:id", ok)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			// Check results
+			assert.Equal(t, http.StatusOK, w.Code)
+			for key, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(key), key)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CancelOnDrain cancels the request context when draining is done, so long-lived responses such as
+// Server-Sent Events streams end when the server starts shutting down instead of holding it open
+func CancelOnDrain(draining context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithCancel(c.Request.Context())
+		defer cancel()
+
+		stop := context.AfterFunc(draining, cancel)
+		defer stop()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,47 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+func TestCancelOnDrain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	draining, startDraining := context.WithCancel(context.Background())
+	defer startDraining()
+
+	started := make(chan struct{})
+	r.GET("/stream", middleware.CancelOnDrain(draining), func(c *gin.Context) {
+		close(started)
+		<-c.Request.Context().Done()
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		req, _ := http.NewRequest(http.MethodGet, "/stream", nil)
+		r.ServeHTTP(w, req)
+	}()
+
+	<-started
+	startDraining()
+
+	select {
+	case <-done:
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	case <-time.After(time.Second):
+		t.Fatal("stream was not closed when draining started")
+	}
+}
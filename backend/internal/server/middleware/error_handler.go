@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/i18n"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+// NewErrorHandler writes the last error a handler added with c.Error as the error response, and turns panics into
+// a SystemError. It must be the last global middleware so that the middlewares before it, such as idempotency,
+// see the error response.
+func NewErrorHandler(appLogger *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			// 接続が切れた場合などnet/httpに任せるpanicはそのまま伝える
+			if r == http.ErrAbortHandler {
+				panic(r)
+			}
+
+			err := fmt.Errorf("panic: %v", r)
+			appLogger.ErrorContext(c.Request.Context(), err, "Recovered from panic", "stack", string(debug.Stack()))
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			abortWithError(c, err)
+		}()
+
+		c.Next()
+
+		// ハンドラーが既にレスポンスを書き込んでいる場合はそのまま返す
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		abortWithError(c, c.Errors.Last().Err)
+	}
+}
+
+// NotFound responds to requests for which no route matches
+func NotFound(c *gin.Context) {
+	_ = c.Error(myerrors.NewAPIError(myerrors.RouteNotFoundError, myerrors.RouteNotFoundErrorMessage, nil, ""))
+}
+
+// MethodNotAllowed responds to requests whose path matches a route registered for other methods
+func MethodNotAllowed(c *gin.Context) {
+	_ = c.Error(myerrors.NewAPIError(myerrors.MethodNotAllowedError, myerrors.MethodNotAllowedErrorMessage, nil, ""))
+}
+
+// abortWithError writes err as the error response and stops the remaining handlers
+func abortWithError(c *gin.Context, err error) {
+	ctx := c.Request.Context()
+	apiErr := myerrors.Normalize(err)
+	c.AbortWithStatusJSON(apiErr.HTTPStatus(), apiErr.Response(i18n.FromContext(ctx), logger.TraceIDFromContext(ctx)))
+}
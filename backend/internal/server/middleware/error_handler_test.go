@@ -0,0 +1,260 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+func TestErrorHandler(t *testing.T) {
+	// Test cases
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		acceptLanguage string
+		handler        gin.HandlerFunc
+		expectedStatus int
+		expectedBody   *myerrors.ErrorResponse
+	}{
+		{
+			name:   "API Error",
+			method: http.MethodGet,
+			path:   "/test",
+			handler: func(c *gin.Context) {
+				_ = c.Error(myerrors.NewValidationError(myerrors.FieldError{Field: "name", Message: "必須項目です"}))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.ValidationError,
+				Message: myerrors.ValidationErrorMessage,
+				Fields:  []myerrors.FieldError{{Field: "name", Message: "必須項目です"}},
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:           "English",
+			method:         http.MethodGet,
+			path:           "/test",
+			acceptLanguage: "en-US,en;q=0.9,ja;q=0.8",
+			handler: func(c *gin.Context) {
+				_ = c.Error(myerrors.NewValidationError(myerrors.NewFieldError("event_types", myerrors.FieldMinItems, 1)))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.ValidationError,
+				Message: "The request has invalid values",
+				Fields:  []myerrors.FieldError{{Field: "event_types", Message: "Event types must contain at least 1 item(s)"}},
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:           "Unsupported Language",
+			method:         http.MethodGet,
+			path:           "/test",
+			acceptLanguage: "fr-FR",
+			handler: func(c *gin.Context) {
+				_ = c.Error(myerrors.NewValidationError(myerrors.NewFieldError("event_types", myerrors.FieldMinItems, 1)))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.ValidationError,
+				Message: myerrors.ValidationErrorMessage,
+				Fields:  []myerrors.FieldError{{Field: "event_types", Message: "イベント種別は1件以上指定してください"}},
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:           "English Unique Violation",
+			method:         http.MethodGet,
+			path:           "/test",
+			acceptLanguage: "en",
+			handler: func(c *gin.Context) {
+				_ = c.Error(&pgconn.PgError{Code: "23505", Detail: "Key (email)=(a@example.com) already exists."})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.DuplicateError,
+				Message: "The resource already exists",
+				Fields:  []myerrors.FieldError{{Field: "email", Message: "Email address is already registered"}},
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:   "Record Not Found",
+			method: http.MethodGet,
+			path:   "/test",
+			handler: func(c *gin.Context) {
+				_ = c.Error(fmt.Errorf("failed to find: %w", gorm.ErrRecordNotFound))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.NotFoundError,
+				Message: myerrors.NotFoundErrorMessage,
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:   "Unique Violation",
+			method: http.MethodGet,
+			path:   "/test",
+			handler: func(c *gin.Context) {
+				_ = c.Error(&pgconn.PgError{Code: "23505", Detail: "Key (email)=(a@example.com) already exists."})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.DuplicateError,
+				Message: myerrors.DuplicateErrorMessage,
+				Fields:  []myerrors.FieldError{{Field: "email", Message: "メールアドレスは既に登録されています"}},
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:   "Foreign Key Violation On Insert",
+			method: http.MethodGet,
+			path:   "/test",
+			handler: func(c *gin.Context) {
+				_ = c.Error(&pgconn.PgError{
+					Code:    "23503",
+					Message: `insert or update on table "disasters" violates foreign key constraint "fk_municipality"`,
+					Detail:  `Key (municipality_id)=(999) is not present in table "municipalities".`,
+				})
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.ReferenceNotFoundError,
+				Message: myerrors.ReferenceNotFoundErrorMessage,
+				Fields:  []myerrors.FieldError{{Field: "municipality_id", Message: "指定された自治体は存在しないか削除されています"}},
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:   "Foreign Key Violation On Delete",
+			method: http.MethodGet,
+			path:   "/test",
+			handler: func(c *gin.Context) {
+				_ = c.Error(&pgconn.PgError{
+					Code:    "23503",
+					Message: `update or delete on table "organizations" violates foreign key constraint "fk_parent" on table "organizations"`,
+				})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.ResourceInUseError,
+				Message: myerrors.ResourceInUseErrorMessage,
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:   "Unexpected Error",
+			method: http.MethodGet,
+			path:   "/test",
+			handler: func(c *gin.Context) {
+				_ = c.Error(errors.New("dial tcp 10.0.0.1:5432: connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.SystemError,
+				Message: myerrors.SystemErrorMessage,
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:   "Panic",
+			method: http.MethodGet,
+			path:   "/test",
+			handler: func(c *gin.Context) {
+				panic("unexpected")
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.SystemError,
+				Message: myerrors.SystemErrorMessage,
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:   "Response Already Written",
+			method: http.MethodGet,
+			path:   "/test",
+			handler: func(c *gin.Context) {
+				c.Status(http.StatusAccepted)
+				_, _ = c.Writer.WriteString("{}")
+				_ = c.Error(errors.New("after write"))
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "Route Not Found",
+			method:         http.MethodGet,
+			path:           "/unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.RouteNotFoundError,
+				Message: myerrors.RouteNotFoundErrorMessage,
+				TraceID: "trace-1",
+			},
+		},
+		{
+			name:           "Method Not Allowed",
+			method:         http.MethodDelete,
+			path:           "/test",
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedBody: &myerrors.ErrorResponse{
+				Code:    myerrors.MethodNotAllowedError,
+				Message: myerrors.MethodNotAllowedErrorMessage,
+				TraceID: "trace-1",
+			},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.HandleMethodNotAllowed = true
+			r.Use(func(c *gin.Context) {
+				c.Request = c.Request.WithContext(logger.WithTraceID(c.Request.Context(), "trace-1"))
+			})
+			r.Use(middleware.NewLocale())
+			r.Use(middleware.NewErrorHandler(logger.New(logger.DefaultConfig())))
+			r.NoRoute(middleware.NotFound)
+			r.NoMethod(middleware.MethodNotAllowed)
+			handler := tt.handler
+			if handler == nil {
+				handler = func(c *gin.Context) { c.Status(http.StatusOK) }
+			}
+			r.GET("/test", handler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			r.ServeHTTP(w, req)
+
+			// Check results
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody == nil {
+				return
+			}
+			var body myerrors.ErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, *tt.expectedBody, body)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header clients use to make POST requests safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from a stored result
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// NewIdempotency makes POST requests carrying an Idempotency-Key header idempotent.
+// The first request with a key is processed normally and its response is stored for ttl;
+// retries with the same key and body receive the stored response, while the same key with
+// a different request is rejected with 422. Keys are scoped to the client, which is the user of a
+// valid access token verified with secret or else the client IP, and to the path, so that one client
+// can never receive the response stored for another. Requests without the header and the login and
+// registration routes, whose responses carry access tokens, are passed through.
+func NewIdempotency(
+	appLogger *logger.Logger,
+	repo domain.IdempotencyKeyRepository,
+	ttl time.Duration,
+	secret string,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" || !IdempotencyApplies(c.Request.Method, c.FullPath()) {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+
+		if len(key) > maxIdempotencyKeyLength {
+			abortWithError(c, myerrors.NewValidationError(
+				myerrors.NewFieldError(IdempotencyKeyHeader, myerrors.FieldMaxLength, maxIdempotencyKeyLength),
+			))
+
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			appLogger.ErrorContext(ctx, err, "Failed to read request body for idempotency check")
+			abortWithError(c, myerrors.NewValidationError(myerrors.NewFieldError("body", myerrors.FieldUnreadableBody)))
+
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+		path := c.Request.URL.RequestURI()
+		scope := requestClient(c, secret) + " " + c.Request.URL.Path
+		fingerprint := requestFingerprint(c.Request.Method, path, body)
+
+		reserved, err := repo.Reserve(ctx, &model.IdempotencyKey{
+			Scope:              scope,
+			Key:                key,
+			RequestMethod:      c.Request.Method,
+			RequestPath:        path,
+			RequestFingerprint: fingerprint,
+			ExpiresAt:          time.Now().Add(ttl),
+		})
+		if err != nil {
+			appLogger.ErrorContext(ctx, err, "Failed to reserve idempotency key", "idempotency_key", key)
+			abortWithError(c, err)
+
+			return
+		}
+
+		if !reserved {
+			replayIdempotentResponse(c, appLogger, repo, scope, key, fingerprint)
+			return
+		}
+
+		writer := &responseWriter{
+			ResponseWriter: c.Writer,
+			body:           bytes.NewBufferString(""),
+		}
+		c.Writer = writer
+
+		// ハンドラーがパニックした場合もキーが処理中のまま残らないよう、deferで状態を確定させる
+		defer func() {
+			// クライアントが切断してもキーの状態は必ず確定させる
+			storeCtx := context.WithoutCancel(ctx)
+
+			// パニックと5xxは一時的な障害の可能性があるため保存せず、同じキーでの再試行を許可する
+			if r := recover(); r != nil {
+				releaseIdempotencyKey(storeCtx, appLogger, repo, scope, key)
+				panic(r)
+			}
+			if writer.Status() >= http.StatusInternalServerError {
+				releaseIdempotencyKey(storeCtx, appLogger, repo, scope, key)
+				return
+			}
+
+			if err := repo.Complete(
+				storeCtx,
+				scope,
+				key,
+				int32(writer.Status()),
+				writer.Header().Get("Content-Type"),
+				writer.body.Bytes(),
+			); err != nil {
+				appLogger.ErrorContext(ctx, err, "Failed to store idempotent response", "idempotency_key", key)
+			}
+		}()
+
+		c.Next()
+	}
+}
+
+// releaseIdempotencyKey removes an in-progress key so that the request can be retried with it
+func releaseIdempotencyKey(
+	ctx context.Context,
+	appLogger *logger.Logger,
+	repo domain.IdempotencyKeyRepository,
+	scope string,
+	key string,
+) {
+	if err := repo.Release(ctx, scope, key); err != nil {
+		appLogger.ErrorContext(ctx, err, "Failed to release idempotency key", "idempotency_key", key)
+	}
+}
+
+func replayIdempotentResponse(
+	c *gin.Context,
+	appLogger *logger.Logger,
+	repo domain.IdempotencyKeyRepository,
+	scope string,
+	key string,
+	fingerprint string,
+) {
+	ctx := c.Request.Context()
+
+	stored, err := repo.FindByKey(ctx, scope, key)
+	if err != nil {
+		// 予約と参照の間に期限切れで削除された場合も含め、再試行を促す
+		appLogger.ErrorContext(ctx, err, "Failed to find idempotency key", "idempotency_key", key)
+		abortWithError(c, myerrors.NewAPIError(
+			myerrors.IdempotencyKeyInProgressError,
+			myerrors.IdempotencyKeyInProgressErrorMessage,
+			err,
+			"idempotency key not found",
+		))
+
+		return
+	}
+
+	if stored.RequestFingerprint != fingerprint {
+		abortWithError(c, myerrors.NewAPIError(
+			myerrors.IdempotencyKeyReusedError,
+			myerrors.IdempotencyKeyReusedErrorMessage,
+			errors.New("request fingerprint does not match"),
+			"idempotency key reused",
+		))
+
+		return
+	}
+
+	if stored.ResponseStatus == nil {
+		abortWithError(c, myerrors.NewAPIError(
+			myerrors.IdempotencyKeyInProgressError,
+			myerrors.IdempotencyKeyInProgressErrorMessage,
+			errors.New("response is not stored yet"),
+			"idempotency key in progress",
+		))
+
+		return
+	}
+
+	contentType := ""
+	if stored.ResponseContentType != nil {
+		contentType = *stored.ResponseContentType
+	}
+
+	appLogger.InfoContext(ctx, "Replaying idempotent response", "idempotency_key", key)
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(int(*stored.ResponseStatus), contentType, stored.ResponseBody)
+	c.Abort()
+}
+
+// requestFingerprint identifies a request by method, path and body
+func requestFingerprint(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// IdempotencyApplies reports whether requests to the route template are made idempotent by an Idempotency-Key header
+func IdempotencyApplies(method, route string) bool {
+	// 認証のルートはアクセストークンを返すため、結果を保存しない
+	return method == http.MethodPost && !isAuthRoute(route)
+}
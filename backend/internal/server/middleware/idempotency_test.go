@@ -0,0 +1,253 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+func setupIdempotencyTest(t *testing.T) (*gin.Engine, *mockdomain.MockIdempotencyKeyRepository, *int) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockIdempotencyKeyRepository(ctrl)
+	l := logger.New(logger.DefaultConfig())
+	r.Use(gin.CustomRecovery(func(c *gin.Context, _ any) {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
+	r.Use(middleware.NewIdempotency(l, mockRepo, time.Hour, rateLimitTestSecret))
+
+	calls := 0
+	r.POST("/disasters", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"id": "new-id"})
+	})
+	r.POST("/failing", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	})
+	r.POST("/panicking", func(c *gin.Context) {
+		calls++
+		panic("unexpected")
+	})
+	r.POST("/api/v1/auth/login", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusOK, gin.H{"token": "access-token"})
+	})
+
+	return r, mockRepo, &calls
+}
+
+func TestIdempotency(t *testing.T) {
+	status := int32(http.StatusCreated)
+	contentType := "application/json; charset=utf-8"
+	// リクエストのRemoteAddrは192.0.2.1
+	ipScope := "ip:192.0.2.1 /disasters"
+
+	// Test cases
+	tests := []struct {
+		name            string
+		path            string
+		idempotencyKey  string
+		authenticated   bool
+		mockSetup       func(mockRepo *mockdomain.MockIdempotencyKeyRepository)
+		expectedStatus  int
+		expectedBody    string
+		expectedCalls   int
+		expectedReplays bool
+	}{
+		{
+			name:           "Without Key",
+			path:           "/disasters",
+			mockSetup:      func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":"new-id"}`,
+			expectedCalls:  1,
+		},
+		{
+			name:           "First Request",
+			path:           "/disasters",
+			idempotencyKey: "key-1",
+			mockSetup: func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {
+				mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, k *model.IdempotencyKey) (bool, error) {
+						assert.Equal(t, ipScope, k.Scope)
+						assert.Equal(t, "key-1", k.Key)
+						assert.Equal(t, http.MethodPost, k.RequestMethod)
+						assert.Equal(t, "/disasters", k.RequestPath)
+						assert.Len(t, k.RequestFingerprint, 64)
+						assert.True(t, k.ExpiresAt.After(time.Now()))
+						return true, nil
+					})
+				mockRepo.EXPECT().
+					Complete(gomock.Any(), ipScope, "key-1", int32(http.StatusCreated), contentType, []byte(`{"id":"new-id"}`)).
+					Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":"new-id"}`,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Replay",
+			path:           "/disasters",
+			idempotencyKey: "key-1",
+			mockSetup: func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {
+				stored := &model.IdempotencyKey{
+					Key:                 "key-1",
+					ResponseStatus:      &status,
+					ResponseContentType: &contentType,
+					ResponseBody:        []byte(`{"id":"original-id"}`),
+				}
+				mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, k *model.IdempotencyKey) (bool, error) {
+						stored.RequestFingerprint = k.RequestFingerprint
+						return false, nil
+					})
+				mockRepo.EXPECT().FindByKey(gomock.Any(), ipScope, "key-1").Return(stored, nil)
+			},
+			expectedStatus:  http.StatusCreated,
+			expectedBody:    `{"id":"original-id"}`,
+			expectedCalls:   0,
+			expectedReplays: true,
+		},
+		{
+			name:           "Same Key With Different Body",
+			path:           "/disasters",
+			idempotencyKey: "key-1",
+			mockSetup: func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {
+				mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, nil)
+				mockRepo.EXPECT().FindByKey(gomock.Any(), ipScope, "key-1").Return(&model.IdempotencyKey{
+					Key:                "key-1",
+					RequestFingerprint: "another-request",
+					ResponseStatus:     &status,
+				}, nil)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCalls:  0,
+		},
+		{
+			name:           "Original Request In Progress",
+			path:           "/disasters",
+			idempotencyKey: "key-1",
+			mockSetup: func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {
+				stored := &model.IdempotencyKey{Key: "key-1"}
+				mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, k *model.IdempotencyKey) (bool, error) {
+						stored.RequestFingerprint = k.RequestFingerprint
+						return false, nil
+					})
+				mockRepo.EXPECT().FindByKey(gomock.Any(), ipScope, "key-1").Return(stored, nil)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCalls:  0,
+		},
+		{
+			name:           "Server Error Releases Key",
+			path:           "/failing",
+			idempotencyKey: "key-2",
+			mockSetup: func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {
+				mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(true, nil)
+				mockRepo.EXPECT().Release(gomock.Any(), "ip:192.0.2.1 /failing", "key-2").Return(nil)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Panic Releases Key",
+			path:           "/panicking",
+			idempotencyKey: "key-2",
+			mockSetup: func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {
+				mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(true, nil)
+				mockRepo.EXPECT().Release(gomock.Any(), "ip:192.0.2.1 /panicking", "key-2").Return(nil)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Scoped To User",
+			path:           "/disasters",
+			idempotencyKey: "key-1",
+			authenticated:  true,
+			mockSetup: func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {
+				mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, k *model.IdempotencyKey) (bool, error) {
+						assert.Equal(t, "user:user-1 /disasters", k.Scope)
+						return true, nil
+					})
+				mockRepo.EXPECT().
+					Complete(gomock.Any(), "user:user-1 /disasters", "key-1", int32(http.StatusCreated), contentType, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":"new-id"}`,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Auth Route Not Stored",
+			path:           "/api/v1/auth/login",
+			idempotencyKey: "key-1",
+			mockSetup:      func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"token":"access-token"}`,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Reserve Error",
+			path:           "/disasters",
+			idempotencyKey: "key-3",
+			mockSetup: func(mockRepo *mockdomain.MockIdempotencyKeyRepository) {
+				mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCalls:  0,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockRepo, calls := setupIdempotencyTest(t)
+			tt.mockSetup(mockRepo)
+
+			// Make request
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, tt.path, bytes.NewBufferString(`{"name":"New Disaster"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.RemoteAddr = "192.0.2.1:1234"
+			if tt.idempotencyKey != "" {
+				req.Header.Set(middleware.IdempotencyKeyHeader, tt.idempotencyKey)
+			}
+			if tt.authenticated {
+				req.Header.Set("Authorization", signedToken(t, rateLimitTestSecret))
+			}
+			r.ServeHTTP(w, req)
+
+			// Check response
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedCalls, *calls)
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+
+			if tt.expectedReplays {
+				assert.Equal(t, "true", w.Header().Get(middleware.IdempotentReplayedHeader))
+			} else {
+				assert.Empty(t, w.Header().Get(middleware.IdempotentReplayedHeader))
+			}
+		})
+	}
+}
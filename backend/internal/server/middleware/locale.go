@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/i18n"
+)
+
+// NewLocale negotiates the language of the messages from the Accept-Language header and stores it in the
+// request context. Requests without a supported language get Japanese.
+func NewLocale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale := i18n.Negotiate(c.GetHeader("Accept-Language"))
+		c.Request = c.Request.WithContext(i18n.WithLocale(c.Request.Context(), locale))
+
+		// キャッシュが言語ごとにレスポンスを分けられるようにする
+		c.Header("Content-Language", string(locale))
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		c.Next()
+	}
+}
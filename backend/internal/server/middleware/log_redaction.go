@@ -0,0 +1,204 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// redactedValue replaces the values of redacted headers and fields
+const redactedValue = "[REDACTED]"
+
+// redactor applies the redaction policy and size limit of LoggingConfig
+type redactor struct {
+	headers  map[string]bool
+	anywhere map[string]bool
+	paths    [][]string
+	maxBytes int
+}
+
+func newRedactor(cfg LoggingConfig) *redactor {
+	r := &redactor{
+		headers:  make(map[string]bool),
+		anywhere: make(map[string]bool),
+		maxBytes: cfg.MaxBodyBytes,
+	}
+	for _, name := range cfg.RedactHeaders {
+		r.headers[http.CanonicalHeaderKey(strings.TrimSpace(name))] = true
+	}
+	for _, field := range cfg.RedactFields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if strings.Contains(field, ".") {
+			r.paths = append(r.paths, strings.Split(field, "."))
+		} else {
+			r.anywhere[field] = true
+		}
+	}
+
+	return r
+}
+
+// header returns a copy of h with the values of the redacted headers replaced
+func (r *redactor) header(h http.Header) http.Header {
+	redacted := make(http.Header, len(h))
+	for name, values := range h {
+		if r.headers[http.CanonicalHeaderKey(name)] {
+			redacted[name] = []string{redactedValue}
+			continue
+		}
+		redacted[name] = values
+	}
+
+	return redacted
+}
+
+// body returns how a body of the content type is logged. captured is the start of the body and size its full size.
+// JSON is logged as a redacted document, other text as a string, and binary bodies only by their size.
+func (r *redactor) body(contentType string, captured []byte, size int) any {
+	if size == 0 {
+		return nil
+	}
+	if !loggableContentType(contentType) || size > bodyCaptureLimit {
+		return omittedBody(contentType, size)
+	}
+
+	if isJSON(contentType) || json.Valid(captured) {
+		var doc any
+		if err := json.Unmarshal(captured, &doc); err == nil {
+			doc = r.redactJSON(doc, nil)
+			b := mustMarshal(doc)
+			if len(b) <= r.maxBytes {
+				return doc
+			}
+			// 打ち切ると構造が壊れるため、秘匿した後の文字列を切り詰める
+			return r.truncate(string(b))
+		}
+		// 壊れたJSONは値を秘匿できないため内容を記録しない
+		return omittedBody(contentType, size)
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "application/x-www-form-urlencoded" {
+		values, err := url.ParseQuery(string(captured))
+		if err != nil {
+			return omittedBody(contentType, size)
+		}
+		form := make(map[string]any, len(values))
+		for key, v := range values {
+			form[key] = v
+		}
+
+		return r.body("application/json", mustMarshal(r.redactJSON(form, nil)), size)
+	}
+
+	return r.truncate(string(captured))
+}
+
+func mustMarshal(v any) []byte {
+	b, _ := json.Marshal(v)
+
+	return b
+}
+
+func (r *redactor) truncate(s string) string {
+	if len(s) <= r.maxBytes {
+		return s
+	}
+
+	cut := r.maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+
+	return fmt.Sprintf("%s...[truncated %d bytes]", s[:cut], len(s)-cut)
+}
+
+func (r *redactor) redactJSON(v any, path []string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			fieldPath := append(path[:len(path):len(path)], key)
+			if r.redactsField(fieldPath) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = r.redactJSON(value, fieldPath)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = r.redactJSON(value, path)
+		}
+	}
+
+	return v
+}
+
+func (r *redactor) redactsField(path []string) bool {
+	if r.anywhere[path[len(path)-1]] {
+		return true
+	}
+
+	for _, pattern := range r.paths {
+		if len(pattern) != len(path) {
+			continue
+		}
+		matched := true
+		for i, segment := range pattern {
+			if segment != "*" && segment != path[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// omittedBody describes a body that is not logged. size is negative when it is unknown.
+func omittedBody(contentType string, size int) string {
+	if contentType == "" {
+		contentType = "unknown content type"
+	}
+	if size < 0 {
+		return fmt.Sprintf("[omitted body of %s]", contentType)
+	}
+
+	return fmt.Sprintf("[omitted %d bytes of %s]", size, contentType)
+}
+
+// loggableContentType reports whether bodies of the content type are text that can be logged.
+// Bodies without a content type are treated as text since most clients of this API send JSON.
+func loggableContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case isJSON(mediaType), strings.HasPrefix(mediaType, "text/"):
+		return true
+	case mediaType == "application/x-www-form-urlencoded", mediaType == "application/xml":
+		return true
+	default:
+		// multipart/form-data や画像などのバイナリは記録しない
+		return false
+	}
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
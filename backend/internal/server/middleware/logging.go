@@ -3,8 +3,10 @@ package middleware
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,6 +15,25 @@ import (
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
 )
 
+// bodyCaptureLimit is the largest body read for logging. JSON can only be redacted as a whole, so larger bodies
+// are logged as omitted instead of being truncated unredacted.
+const bodyCaptureLimit = 1 << 20
+
+// LoggingConfig controls what the request and response logs contain
+type LoggingConfig struct {
+	// RedactHeaders are the names of the headers whose values are replaced, compared case-insensitively
+	RedactHeaders []string
+	// RedactFields are the JSON fields whose values are replaced. A name without dots matches the field at any depth;
+	// a dotted path matches from the root, with * matching any single field. Arrays are looked into without adding
+	// a path segment.
+	RedactFields []string
+	// MaxBodyBytes is how much of each body is logged after redaction
+	MaxBodyBytes int
+	// SuccessSampleRate is the fraction of successful requests that are logged. Requests that fail with a status of
+	// 400 or above are always logged.
+	SuccessSampleRate float64
+}
+
 type responseWriter struct {
 	gin.ResponseWriter
 	body *bytes.Buffer
@@ -23,7 +44,30 @@ func (w responseWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
-func NewLogging(appLogger *logger.Logger) gin.HandlerFunc {
+// logResponseWriter keeps the start of the response body for the response log
+type logResponseWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+	size int
+}
+
+func (w *logResponseWriter) Write(b []byte) (int, error) {
+	// 大きな一覧のレスポンスをすべて保持しないよう、ログに使う分だけ残す
+	if room := bodyCaptureLimit + 1 - w.body.Len(); room > 0 {
+		w.body.Write(b[:min(len(b), room)])
+	}
+	w.size += len(b)
+
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *logResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func NewLogging(appLogger *logger.Logger, cfg LoggingConfig) gin.HandlerFunc {
+	redactor := newRedactor(cfg)
+
 	return func(c *gin.Context) {
 		start := time.Now()
 		endpoint := c.Request.RequestURI
@@ -34,42 +78,60 @@ func NewLogging(appLogger *logger.Logger) gin.HandlerFunc {
 			return
 		}
 
-		// Get or generate trace ID
-		var traceID string
-		traceID = c.GetHeader("X-Trace-ID")
+		// Use the trace ID of the request span, falling back to X-Trace-ID or a generated one without a span
+		ctx := c.Request.Context()
+		traceID := logger.TraceIDFromContext(ctx)
+		if traceID == "" {
+			traceID = c.GetHeader("X-Trace-ID")
+		}
 
 		if traceID == "" {
-			traceID = getTraceID(c.Request.Context())
+			traceID = getTraceID(ctx)
 		}
 
 		// Set trace ID in context
-		ctx := logger.WithTraceID(c.Request.Context(), traceID)
+		ctx = logger.WithTraceID(ctx, traceID)
 		c.Request = c.Request.WithContext(ctx)
 
 		// Set trace ID in Gin context for easy access
 		c.Set("trace_id", traceID)
 
-		// Read and log request body
-		bufBody, _ := io.ReadAll(c.Request.Body)
-		reqBody := map[string]any{}
-		_ = json.Unmarshal(bufBody, &reqBody)
+		// Read the start of the request body for the log and put it back in front of the rest
+		var reqBody any
+		if loggableContentType(c.ContentType()) && c.Request.Body != nil {
+			bufBody, _ := io.ReadAll(io.LimitReader(c.Request.Body, bodyCaptureLimit+1))
+			c.Request.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(bufBody), c.Request.Body), Closer: c.Request.Body}
+			reqBody = redactor.body(c.ContentType(), bufBody, len(bufBody))
+		} else if c.Request.ContentLength != 0 {
+			// ContentLength is -1 when the size is unknown
+			reqBody = omittedBody(c.ContentType(), int(c.Request.ContentLength))
+		}
 
-		c.Request.Body = io.NopCloser(bytes.NewBuffer(bufBody))
+		logRequest := func() {
+			appLogger.InfoContext(ctx, "request",
+				"http_method", c.Request.Method,
+				"endpoint", endpoint,
+				"header", redactor.header(c.Request.Header),
+				"body", reqBody,
+			)
+		}
 
-		// Log request with trace ID from context
-		appLogger.InfoContext(ctx, "request",
-			"http_method", c.Request.Method,
-			"endpoint", endpoint,
-			"header", c.Request.Header,
-			"body", reqBody,
-		)
+		// 成功したリクエストは間引くが、失敗は後からリクエストも含めて必ず記録する
+		sampled := cfg.SuccessSampleRate >= 1 || rand.Float64() < cfg.SuccessSampleRate
+		if sampled {
+			logRequest()
+		}
 
-		// Wrap response writer to capture response body
-		writer := &responseWriter{
+		// Wrap response writer to capture response body.
+		// Event streams stay open indefinitely, so their body is not buffered.
+		writer := &logResponseWriter{
 			ResponseWriter: c.Writer,
 			body:           bytes.NewBufferString(""),
 		}
-		c.Writer = writer
+		streaming := strings.Contains(c.GetHeader("Accept"), "text/event-stream")
+		if !streaming {
+			c.Writer = writer
+		}
 
 		// Process request
 		c.Next()
@@ -77,17 +139,36 @@ func NewLogging(appLogger *logger.Logger) gin.HandlerFunc {
 		// Calculate latency
 		latency := time.Since(start)
 
+		status := c.Writer.Status()
+		if !sampled {
+			if status < http.StatusBadRequest {
+				return
+			}
+			logRequest()
+		}
+
+		var resBody any
+		if !streaming {
+			resBody = redactor.body(c.Writer.Header().Get("Content-Type"), writer.body.Bytes(), writer.size)
+		}
+
 		// Log response with trace ID from context
 		appLogger.InfoContext(ctx, "response",
 			"endpoint", endpoint,
-			"header", writer.Header(),
-			"http_status", writer.Status(),
-			"body", writer.body.String(),
+			"header", redactor.header(c.Writer.Header()),
+			"http_status", status,
+			"body", resBody,
 			"latency_ms", latency.Milliseconds(),
 		)
 	}
 }
 
+// readCloser reads from Reader and closes Closer
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
 func getTraceID(ctx context.Context) string {
 	// First check if trace ID already exists in context
 	if traceID := logger.TraceIDFromContext(ctx); traceID != "" {
@@ -0,0 +1,162 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+// logEntries returns the request and response log records written to buf, keyed by message
+func logEntries(t *testing.T, buf *bytes.Buffer) map[string]map[string]any {
+	entries := map[string]map[string]any{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		if assert.NoError(t, json.Unmarshal([]byte(line), &entry)) {
+			entries[entry["msg"].(string)] = entry
+		}
+	}
+
+	return entries
+}
+
+func TestNewLogging(t *testing.T) {
+	// Setup
+	gin.SetMode(gin.TestMode)
+	config := middleware.LoggingConfig{
+		RedactHeaders:     []string{"authorization", "Set-Cookie"},
+		RedactFields:      []string{"password", "secret", "profile.email"},
+		MaxBodyBytes:      256,
+		SuccessSampleRate: 1,
+	}
+
+	// Test cases
+	tests := []struct {
+		name        string
+		config      func(c middleware.LoggingConfig) middleware.LoggingConfig
+		contentType string
+		body        string
+		status      int
+		response    any
+		check       func(t *testing.T, entries map[string]map[string]any, logs string)
+	}{
+		{
+			name:        "Redacts Headers And Fields",
+			contentType: "application/json",
+			body:        `{"name":"山田","password":"p@ssw0rd","profile":{"email":"yamada@example.com"},"contacts":[{"email":"keep@example.com"}]}`,
+			status:      http.StatusCreated,
+			response:    gin.H{"id": "sub-1", "secret": "whsec_value"},
+			check: func(t *testing.T, entries map[string]map[string]any, logs string) {
+				assert.NotContains(t, logs, "p@ssw0rd")
+				assert.NotContains(t, logs, "Bearer token-value")
+				assert.NotContains(t, logs, "yamada@example.com")
+				assert.NotContains(t, logs, "whsec_value")
+				assert.NotContains(t, logs, "session=abc")
+
+				body := entries["request"]["body"].(map[string]any)
+				assert.Equal(t, "山田", body["name"])
+				assert.Equal(t, "[REDACTED]", body["password"])
+				// パス指定はルートからのみ一致する
+				assert.Equal(t, "keep@example.com", body["contacts"].([]any)[0].(map[string]any)["email"])
+				assert.Equal(t, []any{"[REDACTED]"}, entries["request"]["header"].(map[string]any)["Authorization"])
+				assert.Equal(t, "[REDACTED]", entries["response"]["body"].(map[string]any)["secret"])
+			},
+		},
+		{
+			name:        "Truncates Large Body",
+			contentType: "application/json",
+			body:        `{"password":"p@ssw0rd","description":"` + strings.Repeat("あ", 100) + `"}`,
+			status:      http.StatusOK,
+			response:    gin.H{"items": []string{strings.Repeat("x", 300)}},
+			check: func(t *testing.T, entries map[string]map[string]any, logs string) {
+				assert.NotContains(t, logs, "p@ssw0rd")
+				assert.Contains(t, entries["request"]["body"], "[truncated ")
+				assert.Contains(t, entries["response"]["body"], "[truncated ")
+			},
+		},
+		{
+			name:        "Skips Multipart Body",
+			contentType: "multipart/form-data; boundary=xyz",
+			body:        "--xyz\r\nContent-Disposition: form-data; name=\"file\"\r\n\r\nbinary\r\n--xyz--\r\n",
+			status:      http.StatusOK,
+			response:    gin.H{"ok": true},
+			check: func(t *testing.T, entries map[string]map[string]any, logs string) {
+				assert.NotContains(t, logs, "binary")
+				assert.Contains(t, entries["request"]["body"], "[omitted ")
+			},
+		},
+		{
+			name: "Samples Out Success",
+			config: func(c middleware.LoggingConfig) middleware.LoggingConfig {
+				c.SuccessSampleRate = 0
+				return c
+			},
+			contentType: "application/json",
+			body:        `{"name":"山田"}`,
+			status:      http.StatusOK,
+			response:    gin.H{"ok": true},
+			check: func(t *testing.T, entries map[string]map[string]any, logs string) {
+				assert.Empty(t, entries)
+			},
+		},
+		{
+			name: "Always Logs Errors",
+			config: func(c middleware.LoggingConfig) middleware.LoggingConfig {
+				c.SuccessSampleRate = 0
+				return c
+			},
+			contentType: "application/json",
+			body:        `{"name":"山田"}`,
+			status:      http.StatusInternalServerError,
+			response:    gin.H{"error": "failed"},
+			check: func(t *testing.T, entries map[string]map[string]any, logs string) {
+				assert.Equal(t, "山田", entries["request"]["body"].(map[string]any)["name"])
+				assert.Equal(t, float64(http.StatusInternalServerError), entries["response"]["http_status"])
+			},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config
+			if tt.config != nil {
+				cfg = tt.config(cfg)
+			}
+			var buf bytes.Buffer
+			l := logger.New(logger.Config{Level: logger.InfoLevel, Output: &buf, JSON: true})
+
+			var received string
+			r := gin.New()
+			r.Use(middleware.NewLogging(l, cfg))
+			r.POST("/test", func(c *gin.Context) {
+				// ハンドラーはログのために読まれた後もボディ全体を受け取る
+				b := new(bytes.Buffer)
+				_, _ = b.ReadFrom(c.Request.Body)
+				received = b.String()
+				c.Header("Set-Cookie", "session=abc")
+				c.JSON(tt.status, tt.response)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			req.Header.Set("Authorization", "Bearer token-value")
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.body, received)
+			tt.check(t, logEntries(t, &buf), buf.String())
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/metrics"
+)
+
+// unmatchedRoute labels the requests that matched no route, so unknown paths cannot grow the label set
+const unmatchedRoute = "unmatched"
+
+// NewMetrics records the count and latency of each request by its route template such as /disasters/:id
+func NewMetrics(m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		m.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,38 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/metrics"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+func TestNewMetrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	m := metrics.New()
+	r.Use(middleware.NewMetrics(m))
+	r.GET("/disasters/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/disasters/1", "/disasters/2", "/unknown/1"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		r.ServeHTTP(w, req)
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+	m.Handler().ServeHTTP(w, req)
+
+	// パスの値ではなくルートのテンプレートで集計される
+	assert.Contains(t, w.Body.String(), `http_requests_total{method="GET",route="/disasters/:id",status="200"} 2`)
+	assert.Contains(t, w.Body.String(), `http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, w.Body.String(), `http_request_duration_seconds_count{method="GET",route="/disasters/:id",status="200"} 2`)
+}
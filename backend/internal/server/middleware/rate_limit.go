@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+)
+
+// Route groups that have their own rate limits
+const (
+	// RateLimitGroupAuth is the login and registration routes
+	RateLimitGroupAuth = "auth"
+	// RateLimitGroupList is the GET routes that return collections
+	RateLimitGroupList = "list"
+	// RateLimitGroupDefault is every other route
+	RateLimitGroupDefault = "default"
+)
+
+// rateLimitExemptRoutes are probed by the infrastructure and never limited
+var rateLimitExemptRoutes = map[string]bool{
+	"/livez":  true,
+	"/readyz": true,
+	"/health": true,
+}
+
+// RateLimitConfig holds the limits of the route groups
+type RateLimitConfig struct {
+	// Limits by route group. Requests of a group without a limit are not limited.
+	Limits map[string]model.RateLimit
+	// JWTSecret verifies the access tokens of the users that requests are counted for
+	JWTSecret string
+}
+
+// NewRateLimit limits each client to the token bucket of the route group of the request. Authenticated requests
+// are counted for the user and others for the client IP, which is taken from X-Forwarded-For only when the request
+// came through one of the trusted proxies of the engine. Responses carry the RateLimit-* headers, and requests over
+// the limit are rejected with 429 and Retry-After.
+func NewRateLimit(appLogger *logger.Logger, store domain.RateLimitRepository, cfg RateLimitConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if !RateLimitApplies(route) {
+			c.Next()
+			return
+		}
+
+		group := rateLimitGroup(c.Request.Method, route)
+		limit, ok := cfg.Limits[group]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		key := group + ":" + requestClient(c, cfg.JWTSecret)
+
+		result, err := store.Take(ctx, key, limit)
+		if err != nil {
+			// 制限の保存先の障害でAPI全体を止めないよう、判定できないリクエストは通す
+			appLogger.ErrorContext(ctx, err, "Failed to check rate limit", "rate_limit_group", group)
+			c.Next()
+			return
+		}
+
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.Reset)))
+		c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limit.Requests, ceilSeconds(limit.Period)))
+
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(max(ceilSeconds(result.RetryAfter), 1)))
+			abortWithError(c, myerrors.NewAPIError(
+				myerrors.RateLimitExceededError,
+				myerrors.RateLimitExceededErrorMessage,
+				errors.New("rate limit exceeded"),
+				key,
+			))
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RateLimitApplies reports whether requests to the route template are rate limited
+func RateLimitApplies(route string) bool {
+	return !rateLimitExemptRoutes[route]
+}
+
+// rateLimitGroup returns the route group of a request to the route template
+func rateLimitGroup(method, route string) string {
+	switch {
+	// 同じAPIのバージョン違いは同じグループとして数える
+	case isAuthRoute(route):
+		return RateLimitGroupAuth
+	case method == http.MethodGet && route != "" && !strings.HasPrefix(route[strings.LastIndex(route, "/")+1:], ":"):
+		return RateLimitGroupList
+	default:
+		return RateLimitGroupDefault
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,204 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+const rateLimitTestSecret = "test-secret"
+
+func signedToken(t *testing.T, secret string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": "user-1",
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(secret))
+	assert.NoError(t, err)
+
+	return "Bearer " + token
+}
+
+func TestRateLimit(t *testing.T) {
+	authLimit := model.RateLimit{Requests: 10, Period: time.Minute}
+	listLimit := model.RateLimit{Requests: 120, Period: time.Minute}
+	defaultLimit := model.RateLimit{Requests: 300, Period: time.Minute}
+
+	// Test cases
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		remoteAddr      string
+		header          map[string]string
+		mockSetup       func(mockRepo *mockdomain.MockRateLimitRepository)
+		expectedStatus  int
+		expectedHeaders map[string]string
+	}{
+		{
+			name:       "Auth Route By IP",
+			method:     http.MethodPost,
+			path:       "/auth/register",
+			remoteAddr: "192.0.2.1:1234",
+			mockSetup: func(mockRepo *mockdomain.MockRateLimitRepository) {
+				mockRepo.EXPECT().Take(gomock.Any(), "auth:ip:192.0.2.1", authLimit).
+					Return(authLimit.Result(9, true), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"RateLimit-Limit":     "10",
+				"RateLimit-Remaining": "9",
+				"RateLimit-Reset":     "6",
+				"RateLimit-Policy":    "10;w=60",
+			},
+		},
+		{
+			name:       "Versioned Auth Route",
+			method:     http.MethodGet,
+			path:       "/api/v1/auth/login",
+			remoteAddr: "192.0.2.1:1234",
+			mockSetup: func(mockRepo *mockdomain.MockRateLimitRepository) {
+				mockRepo.EXPECT().Take(gomock.Any(), "auth:ip:192.0.2.1", authLimit).
+					Return(authLimit.Result(9, true), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "Limit Exceeded",
+			method:     http.MethodPost,
+			path:       "/auth/register",
+			remoteAddr: "192.0.2.1:1234",
+			mockSetup: func(mockRepo *mockdomain.MockRateLimitRepository) {
+				mockRepo.EXPECT().Take(gomock.Any(), "auth:ip:192.0.2.1", authLimit).
+					Return(authLimit.Result(0.5, false), nil)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedHeaders: map[string]string{
+				"RateLimit-Remaining": "0",
+				"Retry-After":         "3",
+			},
+		},
+		{
+			name:       "List Route By User",
+			method:     http.MethodGet,
+			path:       "/disasters",
+			remoteAddr: "192.0.2.1:1234",
+			header:     map[string]string{"Authorization": signedToken(t, rateLimitTestSecret)},
+			mockSetup: func(mockRepo *mockdomain.MockRateLimitRepository) {
+				mockRepo.EXPECT().Take(gomock.Any(), "list:user:user-1", listLimit).
+					Return(listLimit.Result(100, true), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "Forged Token Counted By IP",
+			method:     http.MethodGet,
+			path:       "/disasters/1",
+			remoteAddr: "192.0.2.1:1234",
+			header:     map[string]string{"Authorization": signedToken(t, "other-secret")},
+			mockSetup: func(mockRepo *mockdomain.MockRateLimitRepository) {
+				mockRepo.EXPECT().Take(gomock.Any(), "default:ip:192.0.2.1", defaultLimit).
+					Return(defaultLimit.Result(100, true), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "Trusted Proxy",
+			method:     http.MethodGet,
+			path:       "/disasters/1",
+			remoteAddr: "10.0.0.5:1234",
+			header:     map[string]string{"X-Forwarded-For": "203.0.113.7"},
+			mockSetup: func(mockRepo *mockdomain.MockRateLimitRepository) {
+				mockRepo.EXPECT().Take(gomock.Any(), "default:ip:203.0.113.7", defaultLimit).
+					Return(defaultLimit.Result(100, true), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "Untrusted Forwarded Header",
+			method:     http.MethodGet,
+			path:       "/disasters/1",
+			remoteAddr: "192.0.2.1:1234",
+			header:     map[string]string{"X-Forwarded-For": "203.0.113.7"},
+			mockSetup: func(mockRepo *mockdomain.MockRateLimitRepository) {
+				mockRepo.EXPECT().Take(gomock.Any(), "default:ip:192.0.2.1", defaultLimit).
+					Return(defaultLimit.Result(100, true), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Health Check Exempt",
+			method:         http.MethodGet,
+			path:           "/readyz",
+			remoteAddr:     "192.0.2.1:1234",
+			mockSetup:      func(mockRepo *mockdomain.MockRateLimitRepository) {},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "Store Error",
+			method:     http.MethodGet,
+			path:       "/disasters",
+			remoteAddr: "192.0.2.1:1234",
+			mockSetup: func(mockRepo *mockdomain.MockRateLimitRepository) {
+				mockRepo.EXPECT().Take(gomock.Any(), "list:ip:192.0.2.1", listLimit).
+					Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			assert.NoError(t, r.SetTrustedProxies([]string{"10.0.0.0/8"}))
+			ctrl := gomock.NewController(t)
+			mockRepo := mockdomain.NewMockRateLimitRepository(ctrl)
+			l := logger.New(logger.DefaultConfig())
+			r.Use(middleware.NewRateLimit(l, mockRepo, middleware.RateLimitConfig{
+				Limits: map[string]model.RateLimit{
+					middleware.RateLimitGroupAuth:    authLimit,
+					middleware.RateLimitGroupList:    listLimit,
+					middleware.RateLimitGroupDefault: defaultLimit,
+				},
+				JWTSecret: rateLimitTestSecret,
+			}))
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			r.POST("/auth/register", ok)
+			r.GET("/api/v1/auth/login", ok)
+			r.GET("/disasters", ok)
+			r.GET("/disasters/:id", ok)
+			r.GET("/readyz", ok)
+
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			req.RemoteAddr = tt.remoteAddr
+			for key, value := range tt.header {
+				req.Header.Set(key, value)
+			}
+			r.ServeHTTP(w, req)
+
+			// Check results
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			for key, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(key), key)
+			}
+		})
+	}
+}
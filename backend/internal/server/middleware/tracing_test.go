@@ -0,0 +1,111 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+func TestNewTracing(t *testing.T) {
+	// Setup
+	gin.SetMode(gin.TestMode)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	r := gin.New()
+	r.Use(middleware.NewTracing(tp))
+	r.GET("/disasters/:id", func(c *gin.Context) {
+		// ハンドラーのコンテキストにはリクエストのスパンが入っている
+		c.Header("X-Trace-ID", logger.TraceIDFromContext(c.Request.Context()))
+		if c.Param("id") == "broken" {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	// Test cases
+	tests := []struct {
+		name            string
+		path            string
+		traceparent     string
+		expectedName    string
+		expectedTraceID string
+		expectedStatus  codes.Code
+	}{
+		{
+			name:            "Continues Incoming Trace",
+			path:            "/disasters/1",
+			traceparent:     "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			expectedName:    "GET /disasters/:id",
+			expectedTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			expectedStatus:  codes.Unset,
+		},
+		{
+			name:           "Starts New Trace",
+			path:           "/disasters/1",
+			expectedName:   "GET /disasters/:id",
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:           "Server Error",
+			path:           "/disasters/broken",
+			expectedName:   "GET /disasters/:id",
+			expectedStatus: codes.Error,
+		},
+		{
+			name:           "Unmatched Route",
+			path:           "/unknown",
+			expectedName:   "GET",
+			expectedStatus: codes.Unset,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			r.ServeHTTP(w, req)
+
+			spans := exporter.GetSpans()
+			if !assert.Len(t, spans, 1) {
+				return
+			}
+			span := spans[0]
+			assert.Equal(t, tt.expectedName, span.Name)
+			assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+			assert.Equal(t, tt.expectedStatus, span.Status.Code)
+			assert.Contains(t, span.Attributes, attribute.Int("http.response.status_code", w.Code))
+
+			if tt.expectedTraceID != "" {
+				assert.Equal(t, tt.expectedTraceID, span.SpanContext.TraceID().String())
+				assert.Equal(t, "00f067aa0ba902b7", span.Parent.SpanID().String())
+			} else {
+				assert.False(t, span.Parent.IsValid())
+			}
+			if w.Code != http.StatusNotFound {
+				assert.Equal(t, span.SpanContext.TraceID().String(), w.Header().Get("X-Trace-ID"))
+			}
+		})
+	}
+}
@@ -0,0 +1,864 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/handler"
+	"github.com/AI1411/fullstack-react-go/internal/infra/health"
+	"github.com/AI1411/fullstack-react-go/internal/openapi"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+// docsPrefix is the prefix Swagger UI and the OpenAPI document are served under
+const docsPrefix = "/docs"
+
+// Tags of the operations
+const (
+	tagDisasters           = "disasters"
+	tagPrefectures         = "prefectures"
+	tagTimelines           = "timelines"
+	tagSupportApplications = "support-applications"
+	tagDamageLevels        = "damage-levels"
+	tagFacilityEquipment   = "facility-equipment"
+	tagNotifications       = "notifications"
+	tagOrganizations       = "organizations"
+	tagUsers               = "users"
+	tagTrash               = "trash"
+	tagJobs                = "jobs"
+	tagWebhooks            = "webhooks"
+	tagAuth                = "auth"
+	tagHealth              = "health"
+)
+
+const (
+	// securitySchemeBearer is the access token in the Authorization header
+	securitySchemeBearer = "bearerAuth"
+	// securitySchemeCookie is the access token in the auth_token cookie
+	securitySchemeCookie = "cookieAuth"
+
+	etagHeaderDescription   = "更新時に If-Match へ指定するバージョン"
+	ifMatchParamDescription = "取得時のETag。一致しない場合は412、未指定の場合は428を返す"
+)
+
+// trashTypes are the kinds of records in the trash
+var trashTypes = []any{
+	string(model.TrashTypeDisaster),
+	string(model.TrashTypeTimeline),
+	string(model.TrashTypeFacilityEquipment),
+	string(model.TrashTypeGisData),
+	string(model.TrashTypeAssessment),
+	string(model.TrashTypeUser),
+}
+
+// APISpec returns the OpenAPI document of the routes registered by RegisterRoutes
+func APISpec() *openapi.Document {
+	spec := &openapi.Spec{
+		Info: openapi.Info{
+			Title: "農業災害支援システム API",
+			Description: "農業災害の報告と支援申請を管理するためのAPI。\n\n" +
+				"- エラーは共通のエラーレスポンスで返し、メッセージは`Accept-Language`（ja・en、既定はja）の言語で返します\n" +
+				"- 利用者ごとにルートのグループ単位でレート制限があり、超過した場合は429と`Retry-After`ヘッダーを返します\n" +
+				"- POST（認証のルートを除く）は`Idempotency-Key`ヘッダーを指定すると、同じ利用者・パスへの同じキーの再送に保存済みの結果を返します\n" +
+				"- バージョンのないルート（`/disasters`など）は非推奨で、`Deprecation`ヘッダーと後継ルートへの`Link`ヘッダーを返します",
+			Version: "1.0",
+		},
+		Servers: []openapi.Server{{URL: apiV1Prefix}},
+		Tags: []openapi.Tag{
+			{Name: tagDisasters, Description: "災害"},
+			{Name: tagPrefectures, Description: "都道府県・市区町村"},
+			{Name: tagTimelines, Description: "災害のタイムライン"},
+			{Name: tagSupportApplications, Description: "支援申請"},
+			{Name: tagDamageLevels, Description: "被害程度"},
+			{Name: tagFacilityEquipment, Description: "施設設備"},
+			{Name: tagNotifications, Description: "通知と通知配信設定"},
+			{Name: tagOrganizations, Description: "組織"},
+			{Name: tagUsers, Description: "ユーザー"},
+			{Name: tagTrash, Description: "論理削除されたデータの一覧・復元・完全削除"},
+			{Name: tagJobs, Description: "バックグラウンドジョブの管理"},
+			{Name: tagWebhooks, Description: "Webhook購読の管理"},
+			{Name: tagAuth, Description: "認証"},
+			{Name: tagHealth, Description: "ヘルスチェック"},
+		},
+		SecuritySchemes: map[string]*openapi.SecurityScheme{
+			securitySchemeBearer: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			securitySchemeCookie: {
+				Type:        "apiKey",
+				In:          "cookie",
+				Name:        "auth_token",
+				Description: "ログイン・ユーザー登録時に設定されるトークン",
+			},
+		},
+		ErrorBody: myerrors.ErrorResponse{},
+	}
+
+	for _, r := range apiRoutes() {
+		spec.Routes = append(spec.Routes, withMiddlewareErrors(r))
+	}
+
+	spec.Routes = append(spec.Routes, healthRoutes()...)
+
+	doc := spec.Document()
+	for _, item := range doc.Paths {
+		for _, op := range item {
+			if res, ok := op.Responses["429"]; ok {
+				res.Headers = map[string]*openapi.Header{
+					"Retry-After": {Description: "再試行できるまでの秒数", Schema: &openapi.Schema{Type: "integer"}},
+				}
+			}
+		}
+	}
+
+	return doc
+}
+
+// apiRoutes documents the routes registered under the version prefix
+func apiRoutes() []openapi.Route {
+	return slices.Concat(
+		disasterRouteDocs(),
+		prefectureRouteDocs(),
+		timelineRouteDocs(),
+		supportApplicationRouteDocs(),
+		damageLevelRouteDocs(),
+		facilityEquipmentRouteDocs(),
+		notificationRouteDocs(),
+		organizationRouteDocs(),
+		userRouteDocs(),
+		trashRouteDocs(),
+		jobRouteDocs(),
+		webhookRouteDocs(),
+		authRouteDocs(),
+	)
+}
+
+// withMiddlewareErrors adds the parameters and errors of the middleware every API route goes through
+func withMiddlewareErrors(r openapi.Route) openapi.Route {
+	if middleware.IdempotencyApplies(r.Method, r.Path) {
+		r.Params = append(r.Params, openapi.Param{
+			In:          openapi.InHeader,
+			Name:        middleware.IdempotencyKeyHeader,
+			Description: "指定すると同じキーの再送に保存済みの結果を返す。処理中の場合は409、異なるリクエストに使われたキーの場合は422を返す",
+		})
+		r = withErrors(r, http.StatusConflict, http.StatusUnprocessableEntity)
+	}
+
+	if middleware.RateLimitApplies(r.Path) {
+		r = withErrors(r, http.StatusTooManyRequests)
+	}
+
+	return withErrors(r, http.StatusInternalServerError)
+}
+
+// withErrors adds error statuses the route does not document yet
+func withErrors(r openapi.Route, statuses ...int) openapi.Route {
+	r.Errors = slices.Clone(r.Errors)
+	for _, status := range statuses {
+		if !slices.Contains(r.Errors, status) {
+			r.Errors = append(r.Errors, status)
+		}
+	}
+
+	return r
+}
+
+// authenticated documents a route behind middleware.AuthMiddleware
+func authenticated(r openapi.Route) openapi.Route {
+	r.Security = []openapi.SecurityRequirement{{securitySchemeBearer: {}}, {securitySchemeCookie: {}}}
+
+	return withErrors(r, http.StatusUnauthorized)
+}
+
+// adminOnly documents a route behind middleware.AuthMiddleware and middleware.RequireAdmin
+func adminOnly(r openapi.Route) openapi.Route {
+	return withErrors(authenticated(r), http.StatusForbidden)
+}
+
+// pathID documents the id path parameter
+func pathID(description string, typ any) openapi.Param {
+	return openapi.Param{In: openapi.InPath, Name: "id", Description: description, Type: typ}
+}
+
+// ifMatch documents the If-Match header of the updates with optimistic locking
+func ifMatch() openapi.Param {
+	return openapi.Param{In: openapi.InHeader, Name: "If-Match", Description: ifMatchParamDescription, Required: true}
+}
+
+// etag documents the ETag header of a response
+func etag(description string) map[string]*openapi.Header {
+	return map[string]*openapi.Header{
+		"ETag": {Description: description, Schema: &openapi.Schema{Type: "string"}},
+	}
+}
+
+func ok(body any) openapi.RouteResponse {
+	return openapi.RouteResponse{Status: http.StatusOK, Body: body}
+}
+
+func created(body any) openapi.RouteResponse {
+	return openapi.RouteResponse{Status: http.StatusCreated, Body: body}
+}
+
+func noContent() openapi.RouteResponse {
+	return openapi.RouteResponse{Status: http.StatusNoContent}
+}
+
+// healthRoutes documents the health check routes, which are not versioned
+func healthRoutes() []openapi.Route {
+	root := []openapi.Server{{URL: "/"}}
+	readiness := []openapi.RouteResponse{
+		{Status: http.StatusOK, Description: "statusがupまたはdegraded", Body: health.Report{}},
+		{Status: http.StatusServiceUnavailable, Description: "statusがdownまたはdraining", Body: health.Report{}},
+	}
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/livez", OperationID: "Livez", Tags: []string{tagHealth}, Servers: root,
+			Summary:     "生存確認",
+			Description: "プロセスが応答できるかを返す。依存先は確認せず、失敗時はプロセスの再起動を意味する",
+			Responses:   []openapi.RouteResponse{ok(handler.LivenessResponse{})},
+		},
+		{
+			Method: http.MethodGet, Path: "/readyz", OperationID: "Readyz", Tags: []string{tagHealth}, Servers: root,
+			Summary:     "準備状態確認",
+			Description: "依存先（DB・マイグレーション・メール送信・ファイルストレージ）を確認し、リクエストを受け付けられるかを返す",
+			Responses:   readiness,
+		},
+		{
+			Method: http.MethodGet, Path: "/health", OperationID: "Health", Tags: []string{tagHealth}, Servers: root,
+			Summary:     "準備状態確認（互換用）",
+			Description: "既存の監視設定のためにreadyzと同じ内容を返す",
+			Responses:   readiness,
+		},
+	}
+}
+
+func disasterRouteDocs() []openapi.Route {
+	tags := []string{tagDisasters}
+	id := pathID("災害ID", "")
+	update := func(method, operationID, description string) openapi.Route {
+		return openapi.Route{
+			Method: method, Path: "/disasters/:id", OperationID: operationID, Tags: tags,
+			Summary:     "災害更新",
+			Description: description,
+			Params:      []openapi.Param{id, ifMatch()},
+			Body:        handler.UpdateDisasterRequest{},
+			Responses: []openapi.RouteResponse{
+				{Status: http.StatusOK, Body: handler.DisasterResponse{}, Headers: etag("更新後のバージョン")},
+			},
+			Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusPreconditionFailed, http.StatusPreconditionRequired},
+		}
+	}
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/disasters", OperationID: "ListDisasters", Tags: tags,
+			Summary:   "災害一覧取得",
+			Query:     handler.ListDisastersRequest{},
+			Responses: []openapi.RouteResponse{ok(handler.ListDisastersResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		{
+			Method: http.MethodGet, Path: "/disasters/:id", OperationID: "GetDisaster", Tags: tags,
+			Summary: "災害詳細取得",
+			Params:  []openapi.Param{id},
+			Responses: []openapi.RouteResponse{
+				{Status: http.StatusOK, Body: handler.DisasterResponse{}, Headers: etag(etagHeaderDescription)},
+			},
+			Errors: []int{http.StatusNotFound},
+		},
+		{
+			Method: http.MethodPost, Path: "/disasters", OperationID: "CreateDisaster", Tags: tags,
+			Summary:   "災害作成",
+			Body:      handler.CreateDisasterRequest{},
+			Responses: []openapi.RouteResponse{created(handler.DisasterResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		update(http.MethodPut, "UpdateDisaster", "指定した項目のみ更新する"),
+		update(http.MethodPatch, "PatchDisaster", "指定した項目のみ更新する（PUTと同じ）"),
+		{
+			Method: http.MethodDelete, Path: "/disasters/:id", OperationID: "DeleteDisaster", Tags: tags,
+			Summary:     "災害削除",
+			Description: "災害と関連するタイムライン・書類・GISデータ・査定を論理削除する",
+			Params: []openapi.Param{
+				id,
+				{In: openapi.InQuery, Name: "force", Description: "承認済の査定・支払い済みの支援申請があっても削除する", Type: false},
+			},
+			Responses: []openapi.RouteResponse{ok(handler.DeleteDisasterResponse{})},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+		},
+	}
+}
+
+func prefectureRouteDocs() []openapi.Route {
+	tags := []string{tagPrefectures}
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/prefectures", OperationID: "ListPrefectures", Tags: tags,
+			Summary:   "都道府県一覧取得",
+			Responses: []openapi.RouteResponse{ok([]handler.PrefectureResponse{})},
+		},
+		{
+			Method: http.MethodGet, Path: "/prefectures/:code", OperationID: "GetPrefecture", Tags: tags,
+			Summary:   "都道府県詳細取得",
+			Params:    []openapi.Param{{In: openapi.InPath, Name: "code", Description: "都道府県コード"}},
+			Responses: []openapi.RouteResponse{ok(handler.GetPrefectureResponse{})},
+			Errors:    []int{http.StatusNotFound},
+		},
+	}
+}
+
+func timelineRouteDocs() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/disasters/:id/timelines", OperationID: "GetTimelinesByDisasterID",
+			Tags:      []string{tagTimelines},
+			Summary:   "災害のタイムライン取得",
+			Params:    []openapi.Param{pathID("災害ID", "")},
+			Responses: []openapi.RouteResponse{ok(handler.ListTimelinesResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+	}
+}
+
+func supportApplicationRouteDocs() []openapi.Route {
+	tags := []string{tagSupportApplications}
+	id := pathID("申請ID", "")
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/support-applications", OperationID: "ListSupportApplications", Tags: tags,
+			Summary:   "支援申請一覧取得",
+			Responses: []openapi.RouteResponse{ok(handler.ListSupportApplicationsResponse{})},
+		},
+		{
+			Method: http.MethodGet, Path: "/support-applications/:id", OperationID: "GetSupportApplication", Tags: tags,
+			Summary: "支援申請詳細取得",
+			Params:  []openapi.Param{id},
+			Responses: []openapi.RouteResponse{
+				{Status: http.StatusOK, Body: handler.SupportApplicationResponse{}, Headers: etag(etagHeaderDescription)},
+			},
+			Errors: []int{http.StatusNotFound},
+		},
+		{
+			Method: http.MethodPost, Path: "/support-applications", OperationID: "CreateSupportApplication", Tags: tags,
+			Summary:   "支援申請作成",
+			Body:      handler.CreateSupportApplicationRequest{},
+			Responses: []openapi.RouteResponse{created(handler.SupportApplicationResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		// 申請処理担当者と管理者のみ
+		withErrors(authenticated(openapi.Route{
+			Method: http.MethodPut, Path: "/support-applications/:id/status", OperationID: "UpdateSupportApplicationStatus",
+			Tags:    tags,
+			Summary: "支援申請ステータス更新",
+			Description: "申請処理担当者と管理者のみ実行できる。" +
+				"審査中・書類確認中から審査中・書類確認中・承認済・却下へ、承認済から支払処理中へ、支払処理中から完了へのみ変更でき、" +
+				"それ以外は409を返す。承認済になった場合はWebhookでsupport_application.approvedを通知する",
+			Params: []openapi.Param{id, ifMatch()},
+			Body:   handler.UpdateSupportApplicationStatusRequest{},
+			Responses: []openapi.RouteResponse{
+				{Status: http.StatusOK, Body: handler.SupportApplicationResponse{}, Headers: etag("更新後のバージョン")},
+			},
+			Errors: []int{
+				http.StatusBadRequest,
+				http.StatusNotFound,
+				http.StatusConflict,
+				http.StatusPreconditionFailed,
+				http.StatusPreconditionRequired,
+			},
+		}), http.StatusForbidden),
+	}
+}
+
+func damageLevelRouteDocs() []openapi.Route {
+	tags := []string{tagDamageLevels}
+	id := pathID("被害程度ID", int32(0))
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/damage-levels", OperationID: "ListDamageLevels", Tags: tags,
+			Summary:   "被害程度一覧取得",
+			Responses: []openapi.RouteResponse{ok([]handler.DamageLevelResponse{})},
+		},
+		{
+			Method: http.MethodGet, Path: "/damage-levels/:id", OperationID: "GetDamageLevel", Tags: tags,
+			Summary:   "被害程度詳細取得",
+			Params:    []openapi.Param{id},
+			Responses: []openapi.RouteResponse{ok(handler.DamageLevelResponse{})},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method: http.MethodPost, Path: "/damage-levels", OperationID: "CreateDamageLevel", Tags: tags,
+			Summary:   "被害程度作成",
+			Body:      handler.CreateDamageLevelRequest{},
+			Responses: []openapi.RouteResponse{created(handler.DamageLevelResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		{
+			Method: http.MethodPut, Path: "/damage-levels/:id", OperationID: "UpdateDamageLevel", Tags: tags,
+			Summary:   "被害程度更新",
+			Params:    []openapi.Param{id},
+			Body:      handler.UpdateDamageLevelRequest{},
+			Responses: []openapi.RouteResponse{ok(handler.DamageLevelResponse{})},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method: http.MethodDelete, Path: "/damage-levels/:id", OperationID: "DeleteDamageLevel", Tags: tags,
+			Summary:   "被害程度削除",
+			Params:    []openapi.Param{id},
+			Responses: []openapi.RouteResponse{noContent()},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+	}
+}
+
+func facilityEquipmentRouteDocs() []openapi.Route {
+	tags := []string{tagFacilityEquipment}
+	id := pathID("施設設備ID", int32(0))
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/facility-equipment", OperationID: "ListFacilityEquipments", Tags: tags,
+			Summary:   "施設設備一覧取得",
+			Responses: []openapi.RouteResponse{ok([]handler.FacilityEquipmentResponse{})},
+		},
+		{
+			Method: http.MethodGet, Path: "/facility-equipment/:id", OperationID: "GetFacilityEquipment", Tags: tags,
+			Summary: "施設設備詳細取得",
+			Params:  []openapi.Param{id},
+			Responses: []openapi.RouteResponse{
+				{Status: http.StatusOK, Body: handler.FacilityEquipmentResponse{}, Headers: etag(etagHeaderDescription)},
+			},
+			Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method: http.MethodPost, Path: "/facility-equipment", OperationID: "CreateFacilityEquipment", Tags: tags,
+			Summary:   "施設設備作成",
+			Body:      handler.CreateFacilityEquipmentRequest{},
+			Responses: []openapi.RouteResponse{created(handler.FacilityEquipmentResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		{
+			Method: http.MethodPut, Path: "/facility-equipment/:id", OperationID: "UpdateFacilityEquipment", Tags: tags,
+			Summary: "施設設備更新",
+			Params:  []openapi.Param{id, ifMatch()},
+			Body:    handler.UpdateFacilityEquipmentRequest{},
+			Responses: []openapi.RouteResponse{
+				{Status: http.StatusOK, Body: handler.FacilityEquipmentResponse{}, Headers: etag("更新後のバージョン")},
+			},
+			Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusPreconditionFailed, http.StatusPreconditionRequired},
+		},
+		{
+			Method: http.MethodDelete, Path: "/facility-equipment/:id", OperationID: "DeleteFacilityEquipment", Tags: tags,
+			Summary:   "施設設備削除",
+			Params:    []openapi.Param{id},
+			Responses: []openapi.RouteResponse{noContent()},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+	}
+}
+
+func notificationRouteDocs() []openapi.Route {
+	tags := []string{tagNotifications}
+	id := pathID("通知ID", int32(0))
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/notifications", OperationID: "ListNotifications", Tags: tags,
+			Summary:   "通知一覧取得",
+			Responses: []openapi.RouteResponse{ok([]handler.NotificationResponse{})},
+		},
+		authenticated(openapi.Route{
+			Method: http.MethodGet, Path: "/notifications/:id", OperationID: "GetNotification", Tags: tags,
+			Summary:     "通知詳細取得",
+			Description: "ログインユーザー宛ての通知のみ対象で、他のユーザーの通知は404を返す",
+			Params:      []openapi.Param{id},
+			Responses:   []openapi.RouteResponse{ok(handler.NotificationResponse{})},
+			Errors:      []int{http.StatusBadRequest, http.StatusNotFound},
+		}),
+		{
+			Method: http.MethodPost, Path: "/notifications", OperationID: "CreateNotification", Tags: tags,
+			Summary: "通知作成",
+			Description: "宛先ユーザーの配信設定に従ってアプリ内・メール・Webhookで通知を配信する。" +
+				"メールとWebhookはバックグラウンドジョブで送信し、配信結果はqueuedとなる",
+			Body:      handler.CreateNotificationRequest{},
+			Responses: []openapi.RouteResponse{created(handler.CreateNotificationResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		adminOnly(openapi.Route{
+			Method: http.MethodPost, Path: "/notifications/broadcast", OperationID: "BroadcastNotification", Tags: tags,
+			Summary: "一斉通知",
+			Description: "全ユーザー・ロール・組織（配下を含む）・都道府県・地方の有効なユーザーへ通知を一斉配信する（管理者のみ）。" +
+				"target_typeがall以外の場合はtarget_idにロールID・組織ID・都道府県コード・地方農政局の組織IDを指定する。" +
+				"受信者への配信はバックグラウンドジョブで行う。estimated_recipient_countは受付時点の対象ユーザー数で、" +
+				"配信までにユーザーが追加・無効化された場合は実際の受信者数と異なる",
+			Body:      handler.BroadcastNotificationRequest{},
+			Responses: []openapi.RouteResponse{created(handler.BroadcastNotificationResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodPut, Path: "/notifications/:id", OperationID: "UpdateNotification", Tags: tags,
+			Summary:     "通知更新",
+			Description: "ログインユーザー宛ての通知のみ対象で、他のユーザーの通知は404を返す",
+			Params:      []openapi.Param{id},
+			Body:        handler.UpdateNotificationRequest{},
+			Responses:   []openapi.RouteResponse{ok(handler.NotificationResponse{})},
+			Errors:      []int{http.StatusBadRequest, http.StatusNotFound},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodDelete, Path: "/notifications/:id", OperationID: "DeleteNotification", Tags: tags,
+			Summary:     "通知削除",
+			Description: "ログインユーザー宛ての通知のみ対象で、他のユーザーの通知は404を返す",
+			Params:      []openapi.Param{id},
+			Responses:   []openapi.RouteResponse{noContent()},
+			Errors:      []int{http.StatusBadRequest, http.StatusNotFound},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodPut, Path: "/notifications/:id/read", OperationID: "MarkAsRead", Tags: tags,
+			Summary:     "通知を既読にする",
+			Description: "ログインユーザー宛ての通知のみ対象で、他のユーザーの通知は404を返す",
+			Params:      []openapi.Param{id},
+			Responses:   []openapi.RouteResponse{ok(handler.NotificationResponse{})},
+			Errors:      []int{http.StatusBadRequest, http.StatusNotFound},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodGet, Path: "/me/notifications", OperationID: "ListMyNotifications", Tags: tags,
+			Summary:     "ログインユーザーの通知一覧取得",
+			Description: "ログインユーザー宛ての通知一覧を新しい順に取得する",
+			Responses:   []openapi.RouteResponse{ok([]handler.NotificationResponse{})},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodGet, Path: "/me/notifications/unread-count", OperationID: "CountMyUnreadNotifications",
+			Tags:        tags,
+			Summary:     "ログインユーザーの未読通知件数取得",
+			Description: "ログインユーザーの未読通知件数を合計と通知種別ごとに取得する",
+			Responses:   []openapi.RouteResponse{ok(handler.UnreadNotificationCountResponse{})},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodPost, Path: "/me/notifications/read-all", OperationID: "MarkAllMyNotificationsAsRead",
+			Tags:         tags,
+			Summary:      "ログインユーザーの通知一括既読",
+			Description:  "ログインユーザーの未読通知をまとめて既読にする。本文を省略した場合は全件、beforeは作成日時がその時刻以前の通知",
+			Body:         handler.MarkAllNotificationsAsReadRequest{},
+			BodyOptional: true,
+			Responses:    []openapi.RouteResponse{ok(handler.MarkAllNotificationsAsReadResponse{})},
+			Errors:       []int{http.StatusBadRequest},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodPost, Path: "/me/notifications/bulk-delete", OperationID: "DeleteMyNotifications",
+			Tags:    tags,
+			Summary: "ログインユーザーの通知一括削除",
+			Description: "ログインユーザーの通知をまとめて削除する。ids, notification_type, beforeのいずれかが必須で、" +
+				"複数指定した場合はすべてを満たす通知を削除する。すべての通知を削除する場合は条件の代わりにall: trueを指定する",
+			Body:      handler.DeleteNotificationsRequest{},
+			Responses: []openapi.RouteResponse{ok(handler.DeleteNotificationsResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodGet, Path: "/me/notifications/stream", OperationID: "StreamMyNotifications", Tags: tags,
+			Summary: "通知のリアルタイム配信",
+			Description: "ログインユーザー宛ての新着通知をServer-Sent Eventsで配信する。" +
+				"各イベントはevent: notification、idは通知ID、dataは通知のJSON",
+			Params: []openapi.Param{{
+				In:          openapi.InHeader,
+				Name:        "Last-Event-ID",
+				Description: "最後に受信した通知ID。指定した場合はそれ以降の通知から配信を再開する",
+				Type:        int32(0),
+			}},
+			Responses: []openapi.RouteResponse{{
+				Status:      http.StatusOK,
+				Description: "通知のイベントストリーム",
+				Body:        handler.NotificationResponse{},
+				ContentType: openapi.ContentTypeEventStream,
+			}},
+			Errors: []int{http.StatusBadRequest},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodGet, Path: "/me/notification-preferences", OperationID: "ListMyNotificationPreferences",
+			Tags:        tags,
+			Summary:     "通知配信設定取得",
+			Description: "ログインユーザーの通知種別ごとの配信設定を取得する。未設定の種別はアプリ内通知のみ",
+			Responses:   []openapi.RouteResponse{ok(handler.ListNotificationPreferencesResponse{})},
+		}),
+		authenticated(openapi.Route{
+			Method: http.MethodPut, Path: "/me/notification-preferences", OperationID: "UpdateMyNotificationPreferences",
+			Tags:        tags,
+			Summary:     "通知配信設定更新",
+			Description: "ログインユーザーの通知種別ごとの配信チャネル（アプリ内・メール・Webhook）とメール配信頻度を、指定した種別のみ更新する",
+			Body:        handler.UpdateNotificationPreferencesRequest{},
+			Responses:   []openapi.RouteResponse{ok(handler.ListNotificationPreferencesResponse{})},
+			Errors:      []int{http.StatusBadRequest},
+		}),
+	}
+}
+
+func organizationRouteDocs() []openapi.Route {
+	tags := []string{tagOrganizations}
+	id := pathID("組織ID", int32(0))
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/organizations", OperationID: "ListOrganizations", Tags: tags,
+			Summary:   "組織一覧取得",
+			Responses: []openapi.RouteResponse{ok([]handler.OrganizationResponse{})},
+		},
+		{
+			Method: http.MethodGet, Path: "/organizations/:id", OperationID: "GetOrganization", Tags: tags,
+			Summary: "組織詳細取得",
+			Params:  []openapi.Param{id},
+			Responses: []openapi.RouteResponse{
+				{Status: http.StatusOK, Body: handler.OrganizationResponse{}, Headers: etag(etagHeaderDescription)},
+			},
+			Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method: http.MethodPost, Path: "/organizations", OperationID: "CreateOrganization", Tags: tags,
+			Summary:   "組織作成",
+			Body:      handler.CreateOrganizationRequest{},
+			Responses: []openapi.RouteResponse{created(handler.OrganizationResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		{
+			Method: http.MethodPut, Path: "/organizations/:id", OperationID: "UpdateOrganization", Tags: tags,
+			Summary: "組織更新",
+			Params:  []openapi.Param{id, ifMatch()},
+			Body:    handler.UpdateOrganizationRequest{},
+			Responses: []openapi.RouteResponse{
+				{Status: http.StatusOK, Body: handler.OrganizationResponse{}, Headers: etag("更新後のバージョン")},
+			},
+			Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusPreconditionFailed, http.StatusPreconditionRequired},
+		},
+		{
+			Method: http.MethodDelete, Path: "/organizations/:id", OperationID: "DeleteOrganization", Tags: tags,
+			Summary:   "組織削除",
+			Params:    []openapi.Param{id},
+			Responses: []openapi.RouteResponse{noContent()},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+	}
+}
+
+func userRouteDocs() []openapi.Route {
+	tags := []string{tagUsers}
+	id := pathID("ユーザーID", "")
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/users", OperationID: "ListUsers", Tags: tags,
+			Summary:   "ユーザー一覧取得",
+			Responses: []openapi.RouteResponse{ok([]handler.UserResponse{})},
+		},
+		{
+			Method: http.MethodGet, Path: "/users/:id", OperationID: "GetUser", Tags: tags,
+			Summary:   "ユーザー詳細取得",
+			Params:    []openapi.Param{id},
+			Responses: []openapi.RouteResponse{ok(handler.UserResponse{})},
+			Errors:    []int{http.StatusNotFound},
+		},
+		{
+			Method: http.MethodPost, Path: "/users", OperationID: "CreateUser", Tags: tags,
+			Summary:   "ユーザー作成",
+			Body:      handler.CreateUserRequest{},
+			Responses: []openapi.RouteResponse{created(handler.UserResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		{
+			Method: http.MethodPut, Path: "/users/:id", OperationID: "UpdateUser", Tags: tags,
+			Summary:   "ユーザー更新",
+			Params:    []openapi.Param{id},
+			Body:      handler.UpdateUserRequest{},
+			Responses: []openapi.RouteResponse{ok(handler.UserResponse{})},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method: http.MethodDelete, Path: "/users/:id", OperationID: "DeleteUser", Tags: tags,
+			Summary:   "ユーザー削除",
+			Params:    []openapi.Param{pathID("ユーザーID", int32(0))},
+			Responses: []openapi.RouteResponse{noContent()},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+	}
+}
+
+func trashRouteDocs() []openapi.Route {
+	tags := []string{tagTrash}
+	restore := func(path, operationID, summary, description string, errors ...int) openapi.Route {
+		return authenticated(openapi.Route{
+			Method: http.MethodPost, Path: path, OperationID: operationID, Tags: tags,
+			Summary:     summary,
+			Description: description,
+			Params:      []openapi.Param{pathID("ID", "")},
+			Responses:   []openapi.RouteResponse{ok(handler.RestoreTrashResponse{})},
+			Errors:      append([]int{http.StatusBadRequest, http.StatusNotFound}, errors...),
+		})
+	}
+
+	return []openapi.Route{
+		authenticated(openapi.Route{
+			Method: http.MethodGet, Path: "/trash", OperationID: "ListTrash", Tags: tags,
+			Summary: "ゴミ箱一覧取得",
+			Params: []openapi.Param{
+				{In: openapi.InQuery, Name: "type", Description: "種別", Required: true, Enum: trashTypes},
+			},
+			Responses: []openapi.RouteResponse{ok(handler.ListTrashResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		}),
+		adminOnly(openapi.Route{
+			Method: http.MethodDelete, Path: "/trash/:type/:id", OperationID: "PurgeTrash", Tags: tags,
+			Summary:     "ゴミ箱から完全削除",
+			Description: "論理削除されたデータを物理削除する（管理者のみ）",
+			Params: []openapi.Param{
+				{In: openapi.InPath, Name: "type", Description: "種別", Enum: trashTypes},
+				pathID("ID", ""),
+			},
+			Responses: []openapi.RouteResponse{noContent()},
+			Errors:    []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+		}),
+		restore("/disasters/:id/restore", "RestoreDisaster", "災害復元",
+			"削除された災害を、同時に削除されたタイムライン・GISデータ等と合わせて復元する"),
+		restore("/timelines/:id/restore", "RestoreTimeline", "タイムライン復元",
+			"削除されたタイムラインを復元する。災害が削除されている場合は409を返す", http.StatusConflict),
+		restore("/facility-equipment/:id/restore", "RestoreFacilityEquipment", "施設設備復元",
+			"削除された施設設備を復元する"),
+		restore("/gis-data/:id/restore", "RestoreGisData", "GISデータ復元",
+			"削除されたGISデータを復元する。災害が削除されている場合は409を返す", http.StatusConflict),
+		restore("/assessments/:id/restore", "RestoreAssessment", "査定復元",
+			"削除された査定を、同時に削除された査定項目・コメントと合わせて復元する。災害が削除されている場合は409を返す", http.StatusConflict),
+		adminOnly(restore("/users/:id/restore", "RestoreUser", "ユーザー復元",
+			"削除されたユーザーを復元する（管理者のみ）")),
+	}
+}
+
+func jobRouteDocs() []openapi.Route {
+	tags := []string{tagJobs}
+
+	return []openapi.Route{
+		adminOnly(openapi.Route{
+			Method: http.MethodGet, Path: "/admin/jobs", OperationID: "ListJobs", Tags: tags,
+			Summary:     "ジョブ一覧取得",
+			Description: "バックグラウンドジョブの一覧を新しい順に取得する（管理者のみ）。limitの既定は50で、before_idより古いジョブを取得してページングする",
+			Query:       handler.ListJobsRequest{},
+			Responses:   []openapi.RouteResponse{ok(handler.ListJobsResponse{})},
+			Errors:      []int{http.StatusBadRequest},
+		}),
+		adminOnly(openapi.Route{
+			Method: http.MethodPost, Path: "/admin/jobs/:id/retry", OperationID: "RetryJob", Tags: tags,
+			Summary:     "ジョブ再実行",
+			Description: "再試行上限に達した（dead）ジョブを実行回数をリセットしてキューに戻す（管理者のみ）",
+			Params:      []openapi.Param{pathID("ジョブID", int64(0))},
+			Responses:   []openapi.RouteResponse{ok(handler.JobResponse{})},
+			Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+		}),
+	}
+}
+
+func webhookRouteDocs() []openapi.Route {
+	tags := []string{tagWebhooks}
+	id := pathID("購読ID", "")
+
+	return []openapi.Route{
+		adminOnly(openapi.Route{
+			Method: http.MethodGet, Path: "/webhook-subscriptions", OperationID: "ListWebhookSubscriptions", Tags: tags,
+			Summary:   "Webhook購読一覧取得",
+			Responses: []openapi.RouteResponse{ok(handler.ListWebhookSubscriptionsResponse{})},
+		}),
+		adminOnly(openapi.Route{
+			Method: http.MethodPost, Path: "/webhook-subscriptions", OperationID: "CreateWebhookSubscription", Tags: tags,
+			Summary:     "Webhook購読作成",
+			Description: "secretを省略した場合は自動生成され、このレスポンスでのみ返却される",
+			Body:        handler.CreateWebhookSubscriptionRequest{},
+			Responses:   []openapi.RouteResponse{created(handler.CreateWebhookSubscriptionResponse{})},
+			Errors:      []int{http.StatusBadRequest},
+		}),
+		adminOnly(openapi.Route{
+			Method: http.MethodGet, Path: "/webhook-subscriptions/:id", OperationID: "GetWebhookSubscription", Tags: tags,
+			Summary:   "Webhook購読詳細取得",
+			Params:    []openapi.Param{id},
+			Responses: []openapi.RouteResponse{ok(handler.WebhookSubscriptionResponse{})},
+			Errors:    []int{http.StatusNotFound},
+		}),
+		adminOnly(openapi.Route{
+			Method: http.MethodPut, Path: "/webhook-subscriptions/:id", OperationID: "UpdateWebhookSubscription", Tags: tags,
+			Summary:     "Webhook購読更新",
+			Description: "省略した項目は変更しない",
+			Params:      []openapi.Param{id},
+			Body:        handler.UpdateWebhookSubscriptionRequest{},
+			Responses:   []openapi.RouteResponse{ok(handler.WebhookSubscriptionResponse{})},
+			Errors:      []int{http.StatusBadRequest, http.StatusNotFound},
+		}),
+		adminOnly(openapi.Route{
+			Method: http.MethodDelete, Path: "/webhook-subscriptions/:id", OperationID: "DeleteWebhookSubscription",
+			Tags:        tags,
+			Summary:     "Webhook購読削除",
+			Description: "Webhook購読と配信ログを削除する",
+			Params:      []openapi.Param{id},
+			Responses:   []openapi.RouteResponse{noContent()},
+			Errors:      []int{http.StatusNotFound},
+		}),
+		adminOnly(openapi.Route{
+			Method: http.MethodGet, Path: "/webhook-subscriptions/:id/deliveries", OperationID: "ListWebhookDeliveries",
+			Tags:        tags,
+			Summary:     "Webhook配信ログ取得",
+			Description: "Webhook購読の配信ログを新しい順に取得する。limitの既定は50",
+			Params:      []openapi.Param{id},
+			Query:       handler.ListWebhookDeliveriesRequest{},
+			Responses:   []openapi.RouteResponse{ok(handler.ListWebhookDeliveriesResponse{})},
+			Errors:      []int{http.StatusBadRequest, http.StatusNotFound},
+		}),
+		adminOnly(openapi.Route{
+			Method: http.MethodPost, Path: "/webhook-subscriptions/:id/test", OperationID: "SendTestWebhookEvent", Tags: tags,
+			Summary:     "Webhookテスト送信",
+			Description: "webhook.testイベントを即時送信し、配信結果を返す。無効化中の購読にも送信する",
+			Params:      []openapi.Param{id},
+			Responses:   []openapi.RouteResponse{ok(handler.WebhookDeliveryResponse{})},
+			Errors:      []int{http.StatusNotFound},
+		}),
+	}
+}
+
+func authRouteDocs() []openapi.Route {
+	tags := []string{tagAuth}
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/auth/login", OperationID: "Login", Tags: tags,
+			Summary:     "OIDCログイン",
+			Description: "OIDCプロバイダーの認可エンドポイントへリダイレクトする",
+			Responses: []openapi.RouteResponse{{
+				Status:      http.StatusFound,
+				Description: "OIDCプロバイダーへのリダイレクト",
+				Headers: map[string]*openapi.Header{
+					"Location": {Description: "OIDCプロバイダーの認可URL", Schema: &openapi.Schema{Type: "string", Format: "uri"}},
+				},
+			}},
+		},
+		{
+			Method: http.MethodGet, Path: "/auth/callback", OperationID: "Callback", Tags: tags,
+			Summary:     "OIDCコールバック",
+			Description: "OIDCプロバイダーからのコールバックを処理し、トークンを発行してauth_tokenクッキーに設定する",
+			Params: []openapi.Param{
+				{In: openapi.InQuery, Name: "code", Description: "認証コード", Required: true},
+				{In: openapi.InQuery, Name: "state", Description: "ログイン時に発行した状態", Required: true},
+			},
+			Responses: []openapi.RouteResponse{ok(handler.AuthTokenResponse{})},
+			Errors:    []int{http.StatusBadRequest},
+		},
+		{
+			Method: http.MethodPost, Path: "/auth/logout", OperationID: "Logout", Tags: tags,
+			Summary:     "ログアウト",
+			Description: "auth_tokenクッキーを削除する",
+			Responses:   []openapi.RouteResponse{ok(handler.MessageResponse{})},
+		},
+		{
+			Method: http.MethodPost, Path: "/auth/register", OperationID: "Register", Tags: tags,
+			Summary:     "ユーザー登録",
+			Description: "新規ユーザーを登録し、トークンを発行してauth_tokenクッキーに設定する",
+			Body:        handler.RegisterRequest{},
+			Responses:   []openapi.RouteResponse{created(handler.AuthTokenResponse{})},
+			Errors:      []int{http.StatusBadRequest, http.StatusConflict},
+		},
+	}
+}
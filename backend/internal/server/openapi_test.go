@@ -0,0 +1,173 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/openapi"
+	"github.com/AI1411/fullstack-react-go/internal/server"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+// openAPIFile is the committed document the frontend client is generated from
+const openAPIFile = "../../docs/api/openapi.json"
+
+// TestAPISpec_Routes fails when a route is registered without being documented or documented without being
+// registered
+func TestAPISpec_Routes(t *testing.T) {
+	// Setup
+	r := newTestRouter(t)
+
+	var registered []string
+	for _, route := range r.Routes() {
+		// ドキュメント自体のルートは対象外
+		if route.Path == "/docs" || strings.HasPrefix(route.Path, "/docs/") {
+			continue
+		}
+
+		path := strings.TrimPrefix(route.Path, "/api/v1")
+		registered = append(registered, route.Method+" "+openapi.OpenAPIPath(path))
+	}
+
+	var documented []string
+	for path, item := range server.APISpec().Paths {
+		for method := range item {
+			documented = append(documented, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	sort.Strings(registered)
+	sort.Strings(documented)
+
+	// Check results
+	assert.Equal(t, registered, documented)
+}
+
+// TestAPISpec_Middleware fails when the security, roles, If-Match precondition, Idempotency-Key and rate limit
+// documented for a route differ from what the middleware of the registered route does
+func TestAPISpec_Middleware(t *testing.T) {
+	// Setup
+	r := newTestRouter(t)
+	doc := server.APISpec()
+	userToken := testToken(t, regularRoleID)
+	adminToken := testToken(t, model.RoleIDSystemAdmin)
+
+	// Run tests
+	for _, route := range r.Routes() {
+		if route.Path == "/docs" || strings.HasPrefix(route.Path, "/docs/") {
+			continue
+		}
+
+		path := openapi.OpenAPIPath(strings.TrimPrefix(route.Path, "/api/v1"))
+		t.Run(route.Method+" "+path, func(t *testing.T) {
+			op := doc.Paths[path][strings.ToLower(route.Method)]
+			require.NotNil(t, op)
+			url := pathParam.ReplaceAllString(route.Path, "1")
+
+			// Check results
+			w := serve(r, route.Method, url, "", false)
+			assert.Equal(t, len(op.Security) > 0, w.Code == http.StatusUnauthorized, "security")
+			assert.Equal(t, len(op.Security) > 0, op.Responses["401"] != nil, "401 response")
+
+			assert.Equal(t, middleware.IdempotencyApplies(route.Method, route.Path), hasParam(op, openapi.InHeader, middleware.IdempotencyKeyHeader), "Idempotency-Key")
+			assert.Equal(t, middleware.RateLimitApplies(route.Path), op.Responses["429"] != nil, "429 response")
+
+			// 通知ストリームはハンドラーまで届くと応答が終わらない
+			if len(op.Security) == 0 || route.Path == streamRoute {
+				return
+			}
+
+			w = serve(r, route.Method, url, userToken, false)
+			assert.Equal(t, w.Code == http.StatusForbidden, op.Responses["403"] != nil, "403 response")
+
+			w = serve(r, route.Method, url, adminToken, false)
+			preconditionRequired := w.Code == http.StatusPreconditionRequired
+			assert.Equal(t, preconditionRequired, hasRequiredParam(op, openapi.InHeader, "If-Match"), "If-Match")
+			assert.Equal(t, preconditionRequired, op.Responses["428"] != nil, "428 response")
+
+			if preconditionRequired {
+				w = serve(r, route.Method, url, adminToken, true)
+				assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+				assert.NotNil(t, op.Responses["412"], "412 response")
+			}
+		})
+	}
+}
+
+// regularRoleID is a role that is neither a system administrator nor an application processor
+const regularRoleID int16 = 2
+
+// streamRoute is the route of the notification stream
+const streamRoute = "/api/v1/me/notifications/stream"
+
+// pathParam matches the path parameters of a route template
+var pathParam = regexp.MustCompile(`:[^/]+`)
+
+// testToken returns the Authorization header of a user with the role
+func testToken(t *testing.T, roleID int16) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": "00000000-0000-0000-0000-000000000001",
+		"role_id": roleID,
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testJWTSecret))
+	require.NoError(t, err)
+
+	return "Bearer " + token
+}
+
+// serve sends a request without a body to the router. A malformed If-Match header is sent when badIfMatch is true.
+func serve(r *gin.Engine, method, url, authorization string, badIfMatch bool) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	if badIfMatch {
+		req.Header.Set("If-Match", "not-a-version")
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	return w
+}
+
+// hasParam reports whether the operation documents the parameter
+func hasParam(op *openapi.Operation, in, name string) bool {
+	return slices.ContainsFunc(op.Parameters, func(p *openapi.Parameter) bool {
+		return p.In == in && p.Name == name
+	})
+}
+
+// hasRequiredParam reports whether the operation documents the parameter as required
+func hasRequiredParam(op *openapi.Operation, in, name string) bool {
+	return slices.ContainsFunc(op.Parameters, func(p *openapi.Parameter) bool {
+		return p.In == in && p.Name == name && p.Required
+	})
+}
+
+// TestAPISpec_File fails when the committed document is out of date. Run `go run ./cmd/openapi` to update it.
+func TestAPISpec_File(t *testing.T) {
+	// Setup
+	expected, err := openapi.Marshal(server.APISpec())
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(openAPIFile)
+	require.NoError(t, err)
+
+	// Check results
+	assert.Equal(t, string(expected), string(actual), "docs/api/openapi.json is out of date; run `go run ./cmd/openapi`")
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/outbox"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+)
+
+// RegisterOutboxRelay subscribes the in-process handlers to the domain events and runs the relay
+// for the lifetime of the application
+func RegisterOutboxRelay(
+	lc fx.Lifecycle,
+	r *outbox.Relay,
+	webhookEventSubscriber usecase.WebhookEventSubscriber,
+) {
+	outbox.Subscribe(r, model.DomainEventDisasterCreated, webhookEventSubscriber.OnDisasterCreated)
+	outbox.Subscribe(r, model.DomainEventDisasterStatusChanged, webhookEventSubscriber.OnDisasterStatusChanged)
+	outbox.Subscribe(r, model.DomainEventApplicationTransitioned, webhookEventSubscriber.OnApplicationTransitioned)
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			r.Start()
+			return nil
+		},
+		OnStop: r.Stop,
+	})
+}
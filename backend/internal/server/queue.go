@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/fx"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/infra/queue"
+	"github.com/AI1411/fullstack-react-go/internal/infra/webhook"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+)
+
+// RegisterJobWorker registers the background job handlers and runs the workers for the lifetime of the application
+func RegisterJobWorker(
+	lc fx.Lifecycle,
+	w *queue.Worker,
+	userUseCase usecase.UserUseCase,
+	webhookDispatcher usecase.WebhookDispatcher,
+	notificationDispatcher usecase.NotificationDispatcher,
+	notificationUseCase usecase.NotificationUseCase,
+) {
+	queue.Handle(w, model.JobTypeWelcomeEmail, userUseCase.SendWelcomeEmail)
+	queue.Handle(w, model.JobTypeWebhookEvent, webhookDispatcher.FanOut)
+	queue.Handle(w, model.JobTypeWebhookDelivery, func(ctx context.Context, p model.WebhookDeliveryPayload) error {
+		return webhookDispatcher.Deliver(ctx, p.DeliveryID)
+	})
+	queue.Handle(w, model.JobTypeNotificationEmail, notificationDispatcher.SendEmail)
+	queue.Handle(w, model.JobTypeBroadcastNotification, notificationUseCase.ExpandBroadcast)
+	queue.Handle(w, model.JobTypeNotificationWebhook, func(ctx context.Context, p model.NotificationWebhookPayload) error {
+		err := notificationDispatcher.PostWebhook(ctx, p)
+		if errors.Is(err, webhook.ErrForbiddenAddress) {
+			// 送信先が内部ネットワークを指している限り再試行しても届かない
+			return queue.Permanent(err)
+		}
+
+		return err
+	})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			w.Start()
+			return nil
+		},
+		OnStop: w.Stop,
+	})
+}
@@ -2,24 +2,31 @@ package server
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
 
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/env"
 	"github.com/AI1411/fullstack-react-go/internal/handler"
-	"github.com/AI1411/fullstack-react-go/internal/infra/db"
+	"github.com/AI1411/fullstack-react-go/internal/infra/health"
 	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/openapi"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
 )
 
+// apiV1Prefix is the prefix of the routes of version 1 of the API. Links to records in responses use the same prefix.
+const apiV1Prefix = model.APIBasePath
+
+// routes registers the routes of one resource on a version group of the API
+type routes func(rg *gin.RouterGroup)
+
 // RegisterRoutes registers all HTTP routes
 func RegisterRoutes(
 	lc fx.Lifecycle,
 	r *gin.Engine,
 	l *logger.Logger,
-	dbClient db.Client,
+	healthChecker *health.Checker,
 	env *env.Values,
 	disasterHandler handler.Disaster,
 	prefectureHandler handler.Prefecture,
@@ -31,109 +38,225 @@ func RegisterRoutes(
 	organizationHandler handler.Organization,
 	userHandler handler.User,
 	authHandler handler.Auth,
-) {
-	// Context for health check
-	ctx := context.Background()
-	// ヘルスチェックエンドポイント
-	r.GET("/health", func(c *gin.Context) {
-		if err := dbClient.Ping(ctx); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"status": "unhealthy",
-				"error":  "database ping failed",
-			})
-
-			return
+	trashHandler handler.Trash,
+	notificationPreferenceHandler handler.NotificationPreference,
+	jobHandler handler.Job,
+	webhookHandler handler.Webhook,
+) error {
+	// OpenAPIドキュメントはルートの定義から起動時に生成する
+	docs, err := openapi.NewUIHandler(docsPrefix, APISpec())
+	if err != nil {
+		return err
+	}
+
+	// 停止処理の開始とともにキャンセルされ、準備状態の失敗とストリームの切断に使う
+	draining, startDraining := context.WithCancel(context.Background())
+
+	// ヘルスチェックのルート
+	// livezは失敗時にプロセスを再起動させ、readyzは失敗時にトラフィックを止めさせる
+	healthHandler := handler.NewHealthHandler(l, healthChecker, draining)
+	r.GET("/livez", healthHandler.Livez)
+	r.GET("/readyz", healthHandler.Readyz)
+	// 既存の監視設定のためにreadyzと同じ内容を返す
+	r.GET("/health", healthHandler.Readyz)
+
+	// 業務APIのルートはバージョンごとのグループに登録する
+	// リソースごとにルートを分けているため、v2はそのリソースのroutesを/api/v2のグループに登録するだけでよい
+	v1Routes := []routes{
+		disasterRoutes(disasterHandler),
+		prefectureRoutes(prefectureHandler),
+		timelineRoutes(timelineHandler),
+		supportApplicationRoutes(env, supportApplicationHandler),
+		damageLevelRoutes(damageLevelHandler),
+		facilityEquipmentRoutes(facilityEquipmentHandler),
+		notificationRoutes(env, draining, notificationHandler, notificationPreferenceHandler),
+		organizationRoutes(organizationHandler),
+		userRoutes(userHandler),
+		trashRoutes(env, trashHandler),
+		jobRoutes(env, jobHandler),
+		webhookRoutes(env, webhookHandler),
+		authRoutes(authHandler),
+	}
+	v1 := r.Group(apiV1Prefix)
+	for _, register := range v1Routes {
+		register(v1)
+	}
+
+	// 既存のクライアントが移行するまで、バージョンのないルートを非推奨として残す
+	if env.APILegacyRoutes {
+		legacy := r.Group("", middleware.NewDeprecation(middleware.DeprecationConfig{
+			SuccessorPrefix: apiV1Prefix,
+			DeprecatedAt:    env.APILegacyDeprecatedAt,
+			Sunset:          env.APILegacySunset,
+		}))
+		for _, register := range v1Routes {
+			register(legacy)
 		}
+	}
 
-		c.JSON(http.StatusOK, gin.H{
-			"status":   "healthy",
-			"database": "connected",
-		})
-	})
-
-	// 災害関連のルート
-	r.GET("/disasters", disasterHandler.ListDisasters)
-	r.GET("/disasters/:id", disasterHandler.GetDisaster)
-	r.POST("/disasters", disasterHandler.CreateDisaster)
-	r.PUT("/disasters/:id", disasterHandler.UpdateDisaster)
-	r.DELETE("/disasters/:id", disasterHandler.DeleteDisaster)
-
-	// 都道府県関連のルート
-	r.GET("/prefectures", prefectureHandler.ListPrefectures)
-	r.GET("/prefectures/:code", prefectureHandler.GetPrefecture)
-
-	// タイムライン関連のルート
-	r.GET("/disasters/:id/timelines", timelineHandler.GetTimelinesByDisasterID)
-
-	// 支援申請関連のルート
-	r.GET("/support-applications", supportApplicationHandler.ListSupportApplications)
-	r.GET("/support-applications/:id", supportApplicationHandler.GetSupportApplication)
-	r.POST("/support-applications", supportApplicationHandler.CreateSupportApplication)
-
-	// 被害程度関連のルート
-	r.GET("/damage-levels", damageLevelHandler.ListDamageLevels)
-	r.GET("/damage-levels/:id", damageLevelHandler.GetDamageLevel)
-	r.POST("/damage-levels", damageLevelHandler.CreateDamageLevel)
-	r.PUT("/damage-levels/:id", damageLevelHandler.UpdateDamageLevel)
-	r.DELETE("/damage-levels/:id", damageLevelHandler.DeleteDamageLevel)
-
-	// 施設設備関連のルート
-	r.GET("/facility-equipment", facilityEquipmentHandler.ListFacilityEquipments)
-	r.GET("/facility-equipment/:id", facilityEquipmentHandler.GetFacilityEquipment)
-	r.POST("/facility-equipment", facilityEquipmentHandler.CreateFacilityEquipment)
-	r.PUT("/facility-equipment/:id", facilityEquipmentHandler.UpdateFacilityEquipment)
-	r.DELETE("/facility-equipment/:id", facilityEquipmentHandler.DeleteFacilityEquipment)
-
-	// 通知関連のルート
-	r.GET("/notifications", notificationHandler.ListNotifications)
-	r.GET("/notifications/:id", notificationHandler.GetNotification)
-	r.GET("/notifications/user/:user_id", notificationHandler.GetNotificationsByUserID)
-	r.POST("/notifications", notificationHandler.CreateNotification)
-	r.PUT("/notifications/:id", notificationHandler.UpdateNotification)
-	r.DELETE("/notifications/:id", notificationHandler.DeleteNotification)
-	r.PUT("/notifications/:id/read", notificationHandler.MarkAsRead)
-
-	// 組織関連のルート
-	r.GET("/organizations", organizationHandler.ListOrganizations)
-	r.GET("/organizations/:id", organizationHandler.GetOrganization)
-	r.POST("/organizations", organizationHandler.CreateOrganization)
-	r.PUT("/organizations/:id", organizationHandler.UpdateOrganization)
-	r.DELETE("/organizations/:id", organizationHandler.DeleteOrganization)
-
-	// ユーザー関連のルート
-	r.GET("/users", userHandler.ListUsers)
-	r.GET("/users/:id", userHandler.GetUser)
-	r.POST("/users", userHandler.CreateUser)
-	r.PUT("/users/:id", userHandler.UpdateUser)
-	r.DELETE("/users/:id", userHandler.DeleteUser)
-
-	// 認証関連のルート
-	r.GET("/auth/login", authHandler.Login)
-	r.GET("/auth/callback", authHandler.Callback)
-	r.POST("/auth/logout", authHandler.Logout)
-	r.POST("/auth/register", authHandler.Register)
-
-	// Swagger JSON エンドポイント
-	r.GET("/docs", func(c *gin.Context) {
-		c.Header("Content-Type", "application/json")
-		c.File("./docs/api/swagger.json")
-	})
+	// Swagger UIとOpenAPIドキュメント
+	r.GET(docsPrefix, gin.WrapH(docs))
+	r.GET(docsPrefix+"/*filepath", gin.WrapH(docs))
 
 	// Register lifecycle hooks for the HTTP server
-	lc.Append(fx.Hook{
-		OnStart: func(ctx context.Context) error {
-			// Start HTTP server in a goroutine so it doesn't block
-			go func() {
-				l.Info(fmt.Sprintf("Starting server on :%s", env.ServerPort))
-				if err := r.Run(fmt.Sprintf(":%s", env.ServerPort)); err != nil {
-					l.Error("Failed to start server", "error", err)
-				}
-			}()
-			return nil
-		},
-		OnStop: func(ctx context.Context) error {
-			l.Info("Shutting down server")
-			return nil // Add proper cleanup if needed
-		},
-	})
+	serveHTTP(lc, l, env, r, startDraining)
+
+	return nil
+}
+
+// disasterRoutes registers the disaster routes
+func disasterRoutes(h handler.Disaster) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/disasters", h.ListDisasters)
+		rg.GET("/disasters/:id", h.GetDisaster)
+		rg.POST("/disasters", h.CreateDisaster)
+		rg.PUT("/disasters/:id", h.UpdateDisaster)
+		rg.PATCH("/disasters/:id", h.UpdateDisaster)
+		rg.DELETE("/disasters/:id", h.DeleteDisaster)
+	}
+}
+
+// prefectureRoutes registers the prefecture routes
+func prefectureRoutes(h handler.Prefecture) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/prefectures", h.ListPrefectures)
+		rg.GET("/prefectures/:code", h.GetPrefecture)
+	}
+}
+
+// timelineRoutes registers the timeline routes
+func timelineRoutes(h handler.Timeline) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/disasters/:id/timelines", h.GetTimelinesByDisasterID)
+	}
+}
+
+// supportApplicationRoutes registers the support application routes
+func supportApplicationRoutes(env *env.Values, h handler.SupportApplication) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/support-applications", h.ListSupportApplications)
+		rg.GET("/support-applications/:id", h.GetSupportApplication)
+		rg.POST("/support-applications", h.CreateSupportApplication)
+		// 審査結果の登録は申請処理担当者と管理者に限る
+		rg.PUT("/support-applications/:id/status",
+			middleware.AuthMiddleware(env),
+			middleware.RequireRole(model.RoleIDSystemAdmin, model.RoleIDApplicationProcessor),
+			h.UpdateSupportApplicationStatus,
+		)
+	}
+}
+
+// damageLevelRoutes registers the damage level routes
+func damageLevelRoutes(h handler.DamageLevel) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/damage-levels", h.ListDamageLevels)
+		rg.GET("/damage-levels/:id", h.GetDamageLevel)
+		rg.POST("/damage-levels", h.CreateDamageLevel)
+		rg.PUT("/damage-levels/:id", h.UpdateDamageLevel)
+		rg.DELETE("/damage-levels/:id", h.DeleteDamageLevel)
+	}
+}
+
+// facilityEquipmentRoutes registers the facility equipment routes
+func facilityEquipmentRoutes(h handler.FacilityEquipment) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/facility-equipment", h.ListFacilityEquipments)
+		rg.GET("/facility-equipment/:id", h.GetFacilityEquipment)
+		rg.POST("/facility-equipment", h.CreateFacilityEquipment)
+		rg.PUT("/facility-equipment/:id", h.UpdateFacilityEquipment)
+		rg.DELETE("/facility-equipment/:id", h.DeleteFacilityEquipment)
+	}
+}
+
+// notificationRoutes registers the notification routes and the notification routes of the signed-in user.
+// The notification stream ends when draining is done.
+func notificationRoutes(
+	env *env.Values,
+	draining context.Context,
+	h handler.Notification,
+	preferenceHandler handler.NotificationPreference,
+) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/notifications", h.ListNotifications)
+		rg.GET("/notifications/:id", middleware.AuthMiddleware(env), h.GetNotification)
+		rg.POST("/notifications", h.CreateNotification)
+		rg.POST("/notifications/broadcast", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.BroadcastNotification)
+		rg.PUT("/notifications/:id", middleware.AuthMiddleware(env), h.UpdateNotification)
+		rg.DELETE("/notifications/:id", middleware.AuthMiddleware(env), h.DeleteNotification)
+		rg.PUT("/notifications/:id/read", middleware.AuthMiddleware(env), h.MarkAsRead)
+		rg.GET("/me/notifications", middleware.AuthMiddleware(env), h.ListMyNotifications)
+		rg.GET("/me/notifications/unread-count", middleware.AuthMiddleware(env), h.CountMyUnreadNotifications)
+		rg.POST("/me/notifications/read-all", middleware.AuthMiddleware(env), h.MarkAllMyNotificationsAsRead)
+		rg.POST("/me/notifications/bulk-delete", middleware.AuthMiddleware(env), h.DeleteMyNotifications)
+		rg.GET("/me/notifications/stream", middleware.AuthMiddleware(env), middleware.CancelOnDrain(draining), h.StreamMyNotifications)
+		rg.GET("/me/notification-preferences", middleware.AuthMiddleware(env), preferenceHandler.ListMyNotificationPreferences)
+		rg.PUT("/me/notification-preferences", middleware.AuthMiddleware(env), preferenceHandler.UpdateMyNotificationPreferences)
+	}
+}
+
+// organizationRoutes registers the organization routes
+func organizationRoutes(h handler.Organization) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/organizations", h.ListOrganizations)
+		rg.GET("/organizations/:id", h.GetOrganization)
+		rg.POST("/organizations", h.CreateOrganization)
+		rg.PUT("/organizations/:id", h.UpdateOrganization)
+		rg.DELETE("/organizations/:id", h.DeleteOrganization)
+	}
+}
+
+// userRoutes registers the user routes
+func userRoutes(h handler.User) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/users", h.ListUsers)
+		rg.GET("/users/:id", h.GetUser)
+		rg.POST("/users", h.CreateUser)
+		rg.PUT("/users/:id", h.UpdateUser)
+		rg.DELETE("/users/:id", h.DeleteUser)
+	}
+}
+
+// trashRoutes registers the routes that list, restore and purge soft-deleted records
+func trashRoutes(env *env.Values, h handler.Trash) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/trash", middleware.AuthMiddleware(env), h.ListTrash)
+		rg.DELETE("/trash/:type/:id", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.PurgeTrash)
+		rg.POST("/disasters/:id/restore", middleware.AuthMiddleware(env), h.RestoreDisaster)
+		rg.POST("/timelines/:id/restore", middleware.AuthMiddleware(env), h.RestoreTimeline)
+		rg.POST("/facility-equipment/:id/restore", middleware.AuthMiddleware(env), h.RestoreFacilityEquipment)
+		rg.POST("/gis-data/:id/restore", middleware.AuthMiddleware(env), h.RestoreGisData)
+		rg.POST("/assessments/:id/restore", middleware.AuthMiddleware(env), h.RestoreAssessment)
+		rg.POST("/users/:id/restore", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.RestoreUser)
+	}
+}
+
+// jobRoutes registers the background job administration routes
+func jobRoutes(env *env.Values, h handler.Job) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/admin/jobs", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.ListJobs)
+		rg.POST("/admin/jobs/:id/retry", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.RetryJob)
+	}
+}
+
+// webhookRoutes registers the webhook subscription administration routes
+func webhookRoutes(env *env.Values, h handler.Webhook) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/webhook-subscriptions", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.ListWebhookSubscriptions)
+		rg.POST("/webhook-subscriptions", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.CreateWebhookSubscription)
+		rg.GET("/webhook-subscriptions/:id", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.GetWebhookSubscription)
+		rg.PUT("/webhook-subscriptions/:id", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.UpdateWebhookSubscription)
+		rg.DELETE("/webhook-subscriptions/:id", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.DeleteWebhookSubscription)
+		rg.GET("/webhook-subscriptions/:id/deliveries", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.ListWebhookDeliveries)
+		rg.POST("/webhook-subscriptions/:id/test", middleware.AuthMiddleware(env), middleware.RequireAdmin(), h.SendTestWebhookEvent)
+	}
+}
+
+// authRoutes registers the login, logout and registration routes
+func authRoutes(h handler.Auth) routes {
+	return func(rg *gin.RouterGroup) {
+		rg.GET("/auth/login", h.Login)
+		rg.GET("/auth/callback", h.Callback)
+		rg.POST("/auth/logout", h.Logout)
+		rg.POST("/auth/register", h.Register)
+	}
 }
@@ -0,0 +1,110 @@
+package server_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/env"
+	"github.com/AI1411/fullstack-react-go/internal/handler"
+	"github.com/AI1411/fullstack-react-go/internal/infra/logger"
+	"github.com/AI1411/fullstack-react-go/internal/server"
+	"github.com/AI1411/fullstack-react-go/internal/server/middleware"
+)
+
+// testJWTSecret signs the access tokens of the requests to the test router
+const testJWTSecret = "test-secret"
+
+// newTestRouter registers the routes with handlers that have no use cases. The unversioned routes are left
+// out, as they are when API_LEGACY_ROUTES is false. Requests that reach a handler answer 500.
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	// ユースケースがないハンドラーのpanicを500として返す
+	r.Use(gin.RecoveryWithWriter(io.Discard))
+	l := logger.New(logger.DefaultConfig())
+	r.Use(middleware.NewErrorHandler(l))
+	e := &env.Values{API: env.API{APILegacyRoutes: false}, Auth: env.Auth{JWTSecret: testJWTSecret}}
+	authHandler, err := handler.NewAuthHandler(l, e, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = server.RegisterRoutes(
+		fxtest.NewLifecycle(t),
+		r,
+		l,
+		nil,
+		e,
+		handler.NewDisasterHandler(l, nil),
+		handler.NewPrefectureHandler(l, nil),
+		handler.NewTimelineHandler(l, nil),
+		handler.NewSupportApplicationHandler(l, nil),
+		handler.NewDamageLevelHandler(l, nil),
+		handler.NewFacilityEquipmentHandler(l, nil),
+		handler.NewNotificationHandler(l, nil),
+		handler.NewOrganizationHandler(l, nil),
+		handler.NewUserHandler(l, nil),
+		authHandler,
+		handler.NewTrashHandler(l, nil),
+		handler.NewNotificationPreferenceHandler(l, nil),
+		handler.NewJobHandler(l, nil),
+		handler.NewWebhookHandler(l, nil),
+	)
+	require.NoError(t, err)
+
+	return r
+}
+
+// TestRelatedEntityPaths fails when a notification links to a record under a route that is not registered,
+// such as one of the deprecated unversioned routes
+func TestRelatedEntityPaths(t *testing.T) {
+	// Setup
+	r := newTestRouter(t)
+
+	// Run tests
+	for _, entityType := range model.RelatedEntityTypes {
+		t.Run(string(entityType), func(t *testing.T) {
+			path := entityType.PathPrefix() + "1"
+
+			// Check results
+			assert.NotEmpty(t, entityType.PathPrefix())
+			assert.True(t, hasRoute(r, http.MethodGet, path), "no GET route matches %s", path)
+		})
+	}
+}
+
+// hasRoute reports whether a route of the method matches path
+func hasRoute(r *gin.Engine, method, path string) bool {
+	for _, route := range r.Routes() {
+		if route.Method == method && matchesTemplate(route.Path, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesTemplate reports whether path matches a route template whose parameters are :name segments
+func matchesTemplate(template, path string) bool {
+	templateSegments := strings.Split(template, "/")
+	pathSegments := strings.Split(path, "/")
+	if len(templateSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range templateSegments {
+		if !strings.HasPrefix(segment, ":") && segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	"github.com/AI1411/fullstack-react-go/internal/env"
+	"github.com/AI1411/fullstack-react-go/internal/infra/scheduler"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+)
+
+// RegisterScheduler registers the periodic jobs and runs the scheduler for the lifetime of the application
+func RegisterScheduler(
+	lc fx.Lifecycle,
+	env *env.Values,
+	s *scheduler.Scheduler,
+	idempotencyKeyRepo domain.IdempotencyKeyRepository,
+	rateLimitRepo domain.RateLimitRepository,
+	outboxRepo domain.OutboxRepository,
+	dispatcher usecase.NotificationDispatcher,
+	reminderUseCase usecase.ReminderUseCase,
+) error {
+	assessmentReminderSchedule, err := scheduler.ParseSchedule(env.AssessmentReminderSchedule)
+	if err != nil {
+		return err
+	}
+	applicationReminderSchedule, err := scheduler.ParseSchedule(env.ApplicationReminderSchedule)
+	if err != nil {
+		return err
+	}
+
+	s.Add(&scheduler.Job{
+		Name:     "idempotency_key_cleanup",
+		Schedule: scheduler.Every(env.IdempotencyKeyCleanupInterval),
+		Run: func(ctx context.Context, scheduledAt time.Time) (int, error) {
+			deleted, err := idempotencyKeyRepo.DeleteExpired(ctx, scheduledAt)
+			return int(deleted), err
+		},
+	})
+	s.Add(&scheduler.Job{
+		Name:     "rate_limit_cleanup",
+		Schedule: scheduler.Every(env.RateLimitCleanupInterval),
+		Run: func(ctx context.Context, scheduledAt time.Time) (int, error) {
+			deleted, err := rateLimitRepo.DeleteExpired(ctx, scheduledAt)
+			return int(deleted), err
+		},
+	})
+	s.Add(&scheduler.Job{
+		Name:     "outbox_cleanup",
+		Schedule: scheduler.Every(env.OutboxCleanupInterval),
+		Run: func(ctx context.Context, scheduledAt time.Time) (int, error) {
+			deleted, err := outboxRepo.DeletePublishedBefore(ctx, scheduledAt.Add(-env.OutboxRetention))
+			return int(deleted), err
+		},
+	})
+	s.Add(&scheduler.Job{
+		Name:     "notification_digest",
+		Schedule: scheduler.Every(env.NotificationDigestInterval),
+		Run:      dispatcher.SendDigests,
+	})
+	s.Add(&scheduler.Job{
+		Name:     "assessment_reminder",
+		Schedule: assessmentReminderSchedule,
+		Run: func(ctx context.Context, scheduledAt time.Time) (int, error) {
+			return reminderUseCase.RemindStaleAssessments(ctx, scheduledAt, days(env.AssessmentReminderAfterDays))
+		},
+	})
+	s.Add(&scheduler.Job{
+		Name:     "application_reminder",
+		Schedule: applicationReminderSchedule,
+		Run: func(ctx context.Context, scheduledAt time.Time) (int, error) {
+			return reminderUseCase.RemindPendingDocumentApplications(ctx, scheduledAt, days(env.ApplicationReminderAfterDays))
+		},
+	})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			s.Start()
+			return nil
+		},
+		OnStop: s.Stop,
+	})
+
+	return nil
+}
+
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
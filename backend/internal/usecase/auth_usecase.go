@@ -30,6 +30,9 @@ func NewAuthUsecase(
 }
 
 func (a authUsecase) ValidateEmailVarificationToken(ctx context.Context, token string) error {
+	ctx, span := startSpan(ctx, "AuthUsecase.ValidateEmailVarificationToken")
+	defer span.End()
+
 	emailToken, err := a.emailVarificationTokenRepo.FindByToken(ctx, token)
 	if err != nil {
 		return err
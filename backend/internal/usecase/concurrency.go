@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+// checkVersion reports a precondition failure when the stored updated_at differs from the one the client last read
+func checkVersion(current, expected time.Time) error {
+	if current.Equal(expected) {
+		return nil
+	}
+
+	return newPreconditionFailedError(domain.ErrVersionConflict)
+}
+
+// translateVersionConflict maps the repository conflict sentinel to a precondition failure
+func translateVersionConflict(err error) error {
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return newPreconditionFailedError(err)
+	}
+
+	return err
+}
+
+func newPreconditionFailedError(err error) error {
+	return myerrors.NewAPIError(myerrors.PreconditionFailedError, myerrors.PreconditionFailedErrorMessage, err, "version conflict")
+}
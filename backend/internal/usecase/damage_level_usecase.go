@@ -29,6 +29,9 @@ func NewDamageLevelUseCase(
 }
 
 func (u *damageLevelUseCase) ListDamageLevels(ctx context.Context) ([]*model.DamageLevel, error) {
+	ctx, span := startSpan(ctx, "DamageLevelUseCase.ListDamageLevels")
+	defer span.End()
+
 	damageLevels, err := u.damageLevelRepository.Find(ctx)
 	if err != nil {
 		return nil, err
@@ -38,6 +41,9 @@ func (u *damageLevelUseCase) ListDamageLevels(ctx context.Context) ([]*model.Dam
 }
 
 func (u *damageLevelUseCase) GetDamageLevelByID(ctx context.Context, id int32) (*model.DamageLevel, error) {
+	ctx, span := startSpan(ctx, "DamageLevelUseCase.GetDamageLevelByID")
+	defer span.End()
+
 	damageLevel, err := u.damageLevelRepository.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -47,13 +53,22 @@ func (u *damageLevelUseCase) GetDamageLevelByID(ctx context.Context, id int32) (
 }
 
 func (u *damageLevelUseCase) CreateDamageLevel(ctx context.Context, damageLevel *model.DamageLevel) error {
+	ctx, span := startSpan(ctx, "DamageLevelUseCase.CreateDamageLevel")
+	defer span.End()
+
 	return u.damageLevelRepository.Create(ctx, damageLevel)
 }
 
 func (u *damageLevelUseCase) UpdateDamageLevel(ctx context.Context, damageLevel *model.DamageLevel) error {
+	ctx, span := startSpan(ctx, "DamageLevelUseCase.UpdateDamageLevel")
+	defer span.End()
+
 	return u.damageLevelRepository.Update(ctx, damageLevel)
 }
 
 func (u *damageLevelUseCase) DeleteDamageLevel(ctx context.Context, id int32) error {
+	ctx, span := startSpan(ctx, "DamageLevelUseCase.DeleteDamageLevel")
+	defer span.End()
+
 	return u.damageLevelRepository.Delete(ctx, id)
 }
@@ -11,12 +11,12 @@ import (
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
-	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
 )
 
-func setupDamageLevelTest(t *testing.T) (*mockdatastore.MockDamageLevelRepository, usecase.DamageLevelUseCase) {
+func setupDamageLevelTest(t *testing.T) (*mockdomain.MockDamageLevelRepository, usecase.DamageLevelUseCase) {
 	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockDamageLevelRepository(ctrl)
+	mockRepo := mockdomain.NewMockDamageLevelRepository(ctrl)
 	useCase := usecase.NewDamageLevelUseCase(mockRepo)
 	return mockRepo, useCase
 }
@@ -29,13 +29,13 @@ func TestDamageLevelUseCase_ListDamageLevels(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		mockSetup     func(mockRepo *mockdatastore.MockDamageLevelRepository)
+		mockSetup     func(mockRepo *mockdomain.MockDamageLevelRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name: "Success",
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				description1 := "軽微な被害の説明"
 				description2 := "中程度の被害の説明"
 				now := time.Now()
@@ -63,7 +63,7 @@ func TestDamageLevelUseCase_ListDamageLevels(t *testing.T) {
 		},
 		{
 			name: "Error",
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				mockRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
@@ -102,13 +102,13 @@ func TestDamageLevelUseCase_GetDamageLevelByID(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockDamageLevelRepository)
+		mockSetup     func(mockRepo *mockdomain.MockDamageLevelRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				description := "軽微な被害の説明"
 				now := time.Now()
 
@@ -126,7 +126,7 @@ func TestDamageLevelUseCase_GetDamageLevelByID(t *testing.T) {
 		{
 			name: "Not Found",
 			id:   999,
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				mockRepo.EXPECT().FindByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
 			},
 			expectedError: true,
@@ -164,7 +164,7 @@ func TestDamageLevelUseCase_CreateDamageLevel(t *testing.T) {
 	tests := []struct {
 		name          string
 		damageLevel   *model.DamageLevel
-		mockSetup     func(mockRepo *mockdatastore.MockDamageLevelRepository)
+		mockSetup     func(mockRepo *mockdomain.MockDamageLevelRepository)
 		expectedError bool
 	}{
 		{
@@ -176,7 +176,7 @@ func TestDamageLevelUseCase_CreateDamageLevel(t *testing.T) {
 					Description: &description,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedError: false,
@@ -190,7 +190,7 @@ func TestDamageLevelUseCase_CreateDamageLevel(t *testing.T) {
 					Description: &description,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
@@ -225,7 +225,7 @@ func TestDamageLevelUseCase_UpdateDamageLevel(t *testing.T) {
 	tests := []struct {
 		name          string
 		damageLevel   *model.DamageLevel
-		mockSetup     func(mockRepo *mockdatastore.MockDamageLevelRepository)
+		mockSetup     func(mockRepo *mockdomain.MockDamageLevelRepository)
 		expectedError bool
 	}{
 		{
@@ -238,7 +238,7 @@ func TestDamageLevelUseCase_UpdateDamageLevel(t *testing.T) {
 					Description: &description,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedError: false,
@@ -253,7 +253,7 @@ func TestDamageLevelUseCase_UpdateDamageLevel(t *testing.T) {
 					Description: &description,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
@@ -288,13 +288,13 @@ func TestDamageLevelUseCase_DeleteDamageLevel(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockDamageLevelRepository)
+		mockSetup     func(mockRepo *mockdomain.MockDamageLevelRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(nil)
 			},
 			expectedError: false,
@@ -302,7 +302,7 @@ func TestDamageLevelUseCase_DeleteDamageLevel(t *testing.T) {
 		{
 			name: "Error",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockDamageLevelRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockDamageLevelRepository) {
 				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(errors.New("database error"))
 			},
 			expectedError: true,
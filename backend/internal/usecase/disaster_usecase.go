@@ -3,8 +3,15 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/datastore"
 )
 
@@ -12,23 +19,70 @@ type DisasterUseCase interface {
 	ListDisasters(ctx context.Context, params *datastore.DisasterSearchParams) ([]*model.Disaster, error)
 	GetDisasterByID(ctx context.Context, id string) (*model.Disaster, error)
 	CreateDisaster(ctx context.Context, disaster *model.Disaster) error
-	UpdateDisaster(ctx context.Context, disaster *model.Disaster) error
-	DeleteDisaster(ctx context.Context, id string) error
+	UpdateDisaster(ctx context.Context, id string, params *UpdateDisasterParams) (*model.Disaster, error)
+	// DeleteDisaster soft-deletes the disaster and its children. Approved assessments and paid
+	// support applications block the delete unless force is true.
+	DeleteDisaster(ctx context.Context, id string, force bool) (*model.DisasterDeleteSummary, error)
+}
+
+var (
+	// blockingAssessmentStatuses are the assessment statuses that block deleting their disaster
+	blockingAssessmentStatuses = []string{"承認済"}
+	// paidApplicationStatuses are the support application statuses where payment has started or finished
+	paidApplicationStatuses = []string{"支払処理中", "完了"}
+)
+
+// UpdateDisasterParams holds a partial update for a disaster.
+// nil fields are left unchanged.
+type UpdateDisasterParams struct {
+	// ExpectedUpdatedAt is the updated_at the client last read (from If-Match)
+	ExpectedUpdatedAt time.Time
+
+	Name                  *string
+	MunicipalityID        *int32
+	OccurredAt            *time.Time
+	Summary               *string
+	WorkCategoryID        *int64
+	Status                *string
+	AffectedAreaSize      *float64
+	EstimatedDamageAmount *float64
+	Latitude              *float64
+	Longitude             *float64
+	Address               *string
+	PlaceID               *string
 }
 
 type disasterUseCase struct {
-	disasterRepository datastore.DisasterRepository
+	disasterRepository           datastore.DisasterRepository
+	municipalityRepository       domain.MunicipalityRepository
+	workCategoryRepository       domain.WorkCategoryRepository
+	assessmentRepository         domain.AssessmentRepository
+	supportApplicationRepository domain.SupportApplicationRepository
+	transactor                   domain.Transactor
 }
 
 func NewDisasterUseCase(
 	disasterRepository datastore.DisasterRepository,
+	municipalityRepository domain.MunicipalityRepository,
+	workCategoryRepository domain.WorkCategoryRepository,
+	assessmentRepository domain.AssessmentRepository,
+	supportApplicationRepository domain.SupportApplicationRepository,
+	transactor domain.Transactor,
 ) DisasterUseCase {
 	return &disasterUseCase{
-		disasterRepository: disasterRepository,
+		disasterRepository:           disasterRepository,
+		municipalityRepository:       municipalityRepository,
+		workCategoryRepository:       workCategoryRepository,
+		assessmentRepository:         assessmentRepository,
+		supportApplicationRepository: supportApplicationRepository,
+		transactor:                   transactor,
 	}
 }
 
 func (u *disasterUseCase) ListDisasters(ctx context.Context, params *datastore.DisasterSearchParams) ([]*model.Disaster, error) {
+	ctx, span := startSpan(ctx, "DisasterUseCase.ListDisasters")
+	defer span.End()
+
 	disasters, err := u.disasterRepository.Find(ctx, params)
 	if err != nil {
 		return nil, err
@@ -38,8 +92,15 @@ func (u *disasterUseCase) ListDisasters(ctx context.Context, params *datastore.D
 }
 
 func (u *disasterUseCase) GetDisasterByID(ctx context.Context, id string) (*model.Disaster, error) {
+	ctx, span := startSpan(ctx, "DisasterUseCase.GetDisasterByID")
+	defer span.End()
+
 	disaster, err := u.disasterRepository.FindByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, myerrors.NewAPIError(myerrors.DisasterNotFoundError, myerrors.DisasterNotFoundErrorMessage, err, "disaster not found")
+		}
+
 		return nil, err
 	}
 
@@ -47,13 +108,257 @@ func (u *disasterUseCase) GetDisasterByID(ctx context.Context, id string) (*mode
 }
 
 func (u *disasterUseCase) CreateDisaster(ctx context.Context, disaster *model.Disaster) error {
-	return u.disasterRepository.Create(ctx, disaster)
+	ctx, span := startSpan(ctx, "DisasterUseCase.CreateDisaster")
+	defer span.End()
+
+	municipality, workCategory, err := u.validateReferences(ctx, &disaster.MunicipalityID, &disaster.WorkCategoryID)
+	if err != nil {
+		return err
+	}
+
+	// イベントに載せるためIDは登録前に採番する
+	if disaster.ID == "" {
+		disaster.ID = uuid.NewString()
+	}
+
+	event, err := model.NewOutboxEvent(model.DomainEventDisasterCreated, model.AggregateDisaster, disaster.ID, &model.DisasterCreatedEvent{
+		ID:             disaster.ID,
+		Name:           disaster.Name,
+		Status:         disaster.Status,
+		MunicipalityID: disaster.MunicipalityID,
+		WorkCategoryID: disaster.WorkCategoryID,
+		OccurredAt:     disaster.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := u.disasterRepository.Create(ctx, disaster, event); err != nil {
+		return err
+	}
+
+	// 関連はCreate後に設定する（GORMによる関連テーブルへの書き込みを避けるため）
+	disaster.Municipality = *municipality
+	disaster.WorkCategory = *workCategory
+
+	return nil
 }
 
-func (u *disasterUseCase) UpdateDisaster(ctx context.Context, disaster *model.Disaster) error {
-	return u.disasterRepository.Update(ctx, disaster)
+func (u *disasterUseCase) UpdateDisaster(ctx context.Context, id string, params *UpdateDisasterParams) (*model.Disaster, error) {
+	ctx, span := startSpan(ctx, "DisasterUseCase.UpdateDisaster")
+	defer span.End()
+
+	disaster, err := u.GetDisasterByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVersion(disaster.UpdatedAt, params.ExpectedUpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := u.validateReferences(ctx, params.MunicipalityID, params.WorkCategoryID); err != nil {
+		return nil, err
+	}
+
+	previousStatus := disaster.Status
+	applyDisasterParams(disaster, params)
+	disaster.UpdatedAt = time.Now()
+
+	var events []*model.OutboxEvent
+	if disaster.Status != previousStatus {
+		event, err := model.NewOutboxEvent(model.DomainEventDisasterStatusChanged, model.AggregateDisaster, disaster.ID, &model.DisasterStatusChangedEvent{
+			ID:             disaster.ID,
+			Name:           disaster.Name,
+			Status:         disaster.Status,
+			PreviousStatus: previousStatus,
+			MunicipalityID: disaster.MunicipalityID,
+			WorkCategoryID: disaster.WorkCategoryID,
+			OccurredAt:     disaster.OccurredAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err := u.disasterRepository.Update(ctx, disaster, params.ExpectedUpdatedAt, events...); err != nil {
+		return nil, translateVersionConflict(err)
+	}
+
+	return u.disasterRepository.FindByID(ctx, id)
 }
 
-func (u *disasterUseCase) DeleteDisaster(ctx context.Context, id string) error {
-	return u.disasterRepository.Delete(ctx, id)
+func (u *disasterUseCase) DeleteDisaster(ctx context.Context, id string, force bool) (*model.DisasterDeleteSummary, error) {
+	ctx, span := startSpan(ctx, "DisasterUseCase.DeleteDisaster")
+	defer span.End()
+
+	var summary *model.DisasterDeleteSummary
+
+	// 確認から削除までの間に子レコードが追加・承認されないよう、確認と削除を1つのトランザクションで行う
+	err := u.transactor.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.disasterRepository.LockByID(ctx, id); err != nil {
+			return err
+		}
+
+		approvedAssessments, err := u.assessmentRepository.CountByDisasterIDForUpdate(ctx, id, blockingAssessmentStatuses)
+		if err != nil {
+			return err
+		}
+
+		paidApplications, err := u.supportApplicationRepository.CountByDisasterIDForUpdate(ctx, id, paidApplicationStatuses)
+		if err != nil {
+			return err
+		}
+
+		blocked := approvedAssessments > 0 || paidApplications > 0
+		if blocked && !force {
+			return newDisasterDeleteBlockedError(approvedAssessments, paidApplications)
+		}
+
+		deleted, err := u.disasterRepository.SoftDelete(ctx, id, disasterChildren)
+		if err != nil {
+			return err
+		}
+
+		summary = &model.DisasterDeleteSummary{
+			DisasterID:          id,
+			Forced:              blocked,
+			ApprovedAssessments: approvedAssessments,
+			PaidApplications:    paidApplications,
+			DeletedChildren:     deleted,
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, myerrors.NewAPIError(myerrors.DisasterNotFoundError, myerrors.DisasterNotFoundErrorMessage, err, "disaster not found")
+		}
+
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// newDisasterDeleteBlockedError lists the children that prevent deleting a disaster
+func newDisasterDeleteBlockedError(approvedAssessments, paidApplications int64) error {
+	var fields []myerrors.FieldError
+	if approvedAssessments > 0 {
+		fields = append(fields, myerrors.NewFieldError("approved_assessments", myerrors.FieldApprovedAssessments, approvedAssessments))
+	}
+
+	if paidApplications > 0 {
+		fields = append(fields, myerrors.NewFieldError("paid_applications", myerrors.FieldPaidApplications, paidApplications))
+	}
+
+	apiErr := myerrors.NewAPIError(
+		myerrors.DisasterDeleteBlockedError,
+		myerrors.DisasterDeleteBlockedErrorMessage,
+		errors.New("disaster has blocking children"),
+		"delete blocked",
+	)
+	apiErr.Fields = fields
+
+	return apiErr
+}
+
+// validateReferences checks that the referenced municipality and work category exist and are active.
+// nil IDs are skipped so that partial updates only validate the fields being changed.
+func (u *disasterUseCase) validateReferences(
+	ctx context.Context,
+	municipalityID *int32,
+	workCategoryID *int64,
+) (*model.Municipality, *model.WorkCategory, error) {
+	var (
+		fields       []myerrors.FieldError
+		municipality *model.Municipality
+		workCategory *model.WorkCategory
+	)
+
+	if municipalityID != nil {
+		m, err := u.municipalityRepository.FindByID(ctx, *municipalityID)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			fields = append(fields, myerrors.NewFieldError("municipality_id", myerrors.FieldReferenceNotFound))
+		case err != nil:
+			return nil, nil, err
+		case !m.IsActive:
+			fields = append(fields, myerrors.NewFieldError("municipality_id", myerrors.FieldReferenceInactive))
+		default:
+			municipality = m
+		}
+	}
+
+	if workCategoryID != nil {
+		// 範囲外のIDを切り詰めると別の工種区分に一致するため、そのまま検索して見つからないものとして扱う
+		wc, err := u.workCategoryRepository.FindByID(ctx, *workCategoryID)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			fields = append(fields, myerrors.NewFieldError("work_category_id", myerrors.FieldReferenceNotFound))
+		case err != nil:
+			return nil, nil, err
+		case !wc.IsActive:
+			fields = append(fields, myerrors.NewFieldError("work_category_id", myerrors.FieldReferenceInactive))
+		default:
+			workCategory = wc
+		}
+	}
+
+	if len(fields) > 0 {
+		return nil, nil, myerrors.NewValidationError(fields...)
+	}
+
+	return municipality, workCategory, nil
+}
+
+func applyDisasterParams(disaster *model.Disaster, params *UpdateDisasterParams) {
+	if params.Name != nil {
+		disaster.Name = *params.Name
+	}
+
+	if params.MunicipalityID != nil {
+		disaster.MunicipalityID = *params.MunicipalityID
+	}
+
+	if params.OccurredAt != nil {
+		disaster.OccurredAt = *params.OccurredAt
+	}
+
+	if params.Summary != nil {
+		disaster.Summary = *params.Summary
+	}
+
+	if params.WorkCategoryID != nil {
+		disaster.WorkCategoryID = *params.WorkCategoryID
+	}
+
+	if params.Status != nil {
+		disaster.Status = *params.Status
+	}
+
+	if params.AffectedAreaSize != nil {
+		disaster.AffectedAreaSize = params.AffectedAreaSize
+	}
+
+	if params.EstimatedDamageAmount != nil {
+		disaster.EstimatedDamageAmount = params.EstimatedDamageAmount
+	}
+
+	if params.Latitude != nil {
+		disaster.Latitude = params.Latitude
+	}
+
+	if params.Longitude != nil {
+		disaster.Longitude = params.Longitude
+	}
+
+	if params.Address != nil {
+		disaster.Address = params.Address
+	}
+
+	if params.PlaceID != nil {
+		disaster.PlaceID = params.PlaceID
+	}
 }
@@ -2,73 +2,112 @@ package usecase_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/infra/datastore"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
 	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
 )
 
-func setupDisasterTest(t *testing.T) (*mockdatastore.MockDisasterRepository, usecase.DisasterUseCase) {
+type disasterTestMocks struct {
+	disasterRepo           *mockdatastore.MockDisasterRepository
+	municipalityRepo       *mockdomain.MockMunicipalityRepository
+	workCategoryRepo       *mockdomain.MockWorkCategoryRepository
+	assessmentRepo         *mockdomain.MockAssessmentRepository
+	supportApplicationRepo *mockdomain.MockSupportApplicationRepository
+	transactor             *mockdomain.MockTransactor
+}
+
+func setupDisasterTest(t *testing.T) (*disasterTestMocks, usecase.DisasterUseCase) {
 	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockDisasterRepository(ctrl)
-	useCase := usecase.NewDisasterUseCase(mockRepo)
-	return mockRepo, useCase
+	mocks := &disasterTestMocks{
+		disasterRepo:           mockdatastore.NewMockDisasterRepository(ctrl),
+		municipalityRepo:       mockdomain.NewMockMunicipalityRepository(ctrl),
+		workCategoryRepo:       mockdomain.NewMockWorkCategoryRepository(ctrl),
+		assessmentRepo:         mockdomain.NewMockAssessmentRepository(ctrl),
+		supportApplicationRepo: mockdomain.NewMockSupportApplicationRepository(ctrl),
+		transactor:             mockdomain.NewMockTransactor(ctrl),
+	}
+	useCase := usecase.NewDisasterUseCase(
+		mocks.disasterRepo,
+		mocks.municipalityRepo,
+		mocks.workCategoryRepo,
+		mocks.assessmentRepo,
+		mocks.supportApplicationRepo,
+		mocks.transactor,
+	)
+	return mocks, useCase
+}
+
+// disasterVersion is the updated_at of disasters returned by newDisasterFixture
+var disasterVersion = time.Date(2024, 1, 1, 9, 0, 0, 123456000, time.UTC)
+
+func newDisasterFixture(id, name string) *model.Disaster {
+	now := time.Now()
+
+	return &model.Disaster{
+		ID:             id,
+		Name:           name,
+		MunicipalityID: 131016,
+		OccurredAt:     now,
+		Summary:        "東京で発生した地震",
+		WorkCategoryID: 1,
+		Status:         "in_progress",
+		CreatedAt:      now,
+		UpdatedAt:      disasterVersion,
+	}
+}
+
+// assertValidationFields checks that err is a validation APIError naming exactly the given fields
+func assertValidationFields(t *testing.T, err error, expected []string) {
+	t.Helper()
+
+	var apiErr *myerrors.APIError
+	if !assert.True(t, errors.As(err, &apiErr)) {
+		return
+	}
+	assert.Equal(t, myerrors.ValidationError, apiErr.Code)
+
+	var fields []string
+	for _, f := range apiErr.Fields {
+		fields = append(fields, f.Field)
+	}
+	assert.ElementsMatch(t, expected, fields)
 }
 
 func TestDisasterUseCase_ListDisasters(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupDisasterTest(t)
+	mocks, useCase := setupDisasterTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
 		name          string
 		params        *datastore.DisasterSearchParams
-		mockSetup     func(mockRepo *mockdatastore.MockDisasterRepository)
+		mockSetup     func(m *disasterTestMocks)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name:   "Success with no params",
 			params: nil,
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				now := time.Now()
+			mockSetup: func(m *disasterTestMocks) {
 				disasters := []*model.Disaster{
-					{
-						ID:           "1",
-						DisasterCode: "D2024-001",
-						Name:         "東京地震",
-						PrefectureID: 13,
-						OccurredAt:   now,
-						Summary:      "東京で発生した地震",
-						DisasterType: "地震",
-						Status:       "in_progress",
-						ImpactLevel:  "中程度",
-						CreatedAt:    now,
-						UpdatedAt:    now,
-					},
-					{
-						ID:           "2",
-						DisasterCode: "D2024-002",
-						Name:         "大阪洪水",
-						PrefectureID: 27,
-						OccurredAt:   now,
-						Summary:      "大阪で発生した洪水",
-						DisasterType: "洪水",
-						Status:       "completed",
-						ImpactLevel:  "深刻",
-						CreatedAt:    now,
-						UpdatedAt:    now,
-					},
+					newDisasterFixture("1", "東京地震"),
+					newDisasterFixture("2", "大阪洪水"),
 				}
-				mockRepo.EXPECT().Find(gomock.Any(), nil).Return(disasters, nil)
+				m.disasterRepo.EXPECT().Find(gomock.Any(), nil).Return(disasters, nil)
 			},
 			expectedError: false,
 			expectedLen:   2,
@@ -76,27 +115,14 @@ func TestDisasterUseCase_ListDisasters(t *testing.T) {
 		{
 			name: "Success with params",
 			params: &datastore.DisasterSearchParams{
-				DisasterType: "地震",
-				Status:       "in_progress",
+				WorkCategoryID: 1,
+				Status:         "in_progress",
 			},
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				now := time.Now()
+			mockSetup: func(m *disasterTestMocks) {
 				disasters := []*model.Disaster{
-					{
-						ID:           "1",
-						DisasterCode: "D2024-001",
-						Name:         "東京地震",
-						PrefectureID: 13,
-						OccurredAt:   now,
-						Summary:      "東京で発生した地震",
-						DisasterType: "地震",
-						Status:       "in_progress",
-						ImpactLevel:  "中程度",
-						CreatedAt:    now,
-						UpdatedAt:    now,
-					},
+					newDisasterFixture("1", "東京地震"),
 				}
-				mockRepo.EXPECT().Find(gomock.Any(), gomock.Any()).Return(disasters, nil)
+				m.disasterRepo.EXPECT().Find(gomock.Any(), gomock.Any()).Return(disasters, nil)
 			},
 			expectedError: false,
 			expectedLen:   1,
@@ -104,11 +130,10 @@ func TestDisasterUseCase_ListDisasters(t *testing.T) {
 		{
 			name:   "Error",
 			params: nil,
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				mockRepo.EXPECT().Find(gomock.Any(), nil).Return(nil, errors.New("database error"))
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().Find(gomock.Any(), nil).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
-			expectedLen:   0,
 		},
 	}
 
@@ -116,7 +141,7 @@ func TestDisasterUseCase_ListDisasters(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks)
 
 			// Call the method
 			disasters, err := useCase.ListDisasters(ctx, tt.params)
@@ -127,8 +152,7 @@ func TestDisasterUseCase_ListDisasters(t *testing.T) {
 				assert.Nil(t, disasters)
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, disasters)
-				assert.Equal(t, tt.expectedLen, len(disasters))
+				assert.Len(t, disasters, tt.expectedLen)
 			}
 		})
 	}
@@ -136,43 +160,39 @@ func TestDisasterUseCase_ListDisasters(t *testing.T) {
 
 func TestDisasterUseCase_GetDisasterByID(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupDisasterTest(t)
+	mocks, useCase := setupDisasterTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
 		name          string
 		id            string
-		mockSetup     func(mockRepo *mockdatastore.MockDisasterRepository)
+		mockSetup     func(m *disasterTestMocks)
 		expectedError bool
+		expectedCode  myerrors.ErrorCode
 	}{
 		{
 			name: "Success",
 			id:   "1",
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				now := time.Now()
-				disaster := &model.Disaster{
-					ID:           "1",
-					DisasterCode: "D2024-001",
-					Name:         "東京地震",
-					PrefectureID: 13,
-					OccurredAt:   now,
-					Summary:      "東京で発生した地震",
-					DisasterType: "地震",
-					Status:       "in_progress",
-					ImpactLevel:  "中程度",
-					CreatedAt:    now,
-					UpdatedAt:    now,
-				}
-				mockRepo.EXPECT().FindByID(gomock.Any(), "1").Return(disaster, nil)
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
 			},
 			expectedError: false,
 		},
 		{
 			name: "Not Found",
 			id:   "999",
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				mockRepo.EXPECT().FindByID(gomock.Any(), "999").Return(nil, errors.New("not found"))
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "999").Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedError: true,
+			expectedCode:  myerrors.DisasterNotFoundError,
+		},
+		{
+			name: "Database Error",
+			id:   "1",
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
 		},
@@ -182,7 +202,7 @@ func TestDisasterUseCase_GetDisasterByID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks)
 
 			// Call the method
 			disaster, err := useCase.GetDisasterByID(ctx, tt.id)
@@ -191,9 +211,16 @@ func TestDisasterUseCase_GetDisasterByID(t *testing.T) {
 			if tt.expectedError {
 				assert.Error(t, err)
 				assert.Nil(t, disaster)
+
+				var apiErr *myerrors.APIError
+				if tt.expectedCode != "" {
+					assert.True(t, errors.As(err, &apiErr))
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				} else {
+					assert.False(t, errors.As(err, &apiErr))
+				}
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, disaster)
 				assert.Equal(t, tt.id, disaster.ID)
 			}
 		})
@@ -202,47 +229,70 @@ func TestDisasterUseCase_GetDisasterByID(t *testing.T) {
 
 func TestDisasterUseCase_CreateDisaster(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupDisasterTest(t)
+	mocks, useCase := setupDisasterTest(t)
 	ctx := context.Background()
 
+	activeMunicipality := &model.Municipality{ID: 131016, MunicipalityNameKanji: "千代田区", IsActive: true}
+	activeWorkCategory := &model.WorkCategory{ID: 1, CategoryName: "農地", IsActive: true}
+
 	// Test cases
 	tests := []struct {
-		name          string
-		disaster      *model.Disaster
-		mockSetup     func(mockRepo *mockdatastore.MockDisasterRepository)
-		expectedError bool
+		name           string
+		mockSetup      func(m *disasterTestMocks)
+		expectedError  bool
+		expectedFields []string
 	}{
 		{
 			name: "Success",
-			disaster: &model.Disaster{
-				DisasterCode: "D2024-003",
-				Name:         "福岡台風",
-				PrefectureID: 40,
-				OccurredAt:   time.Now(),
-				Summary:      "福岡で発生した台風",
-				DisasterType: "台風",
-				Status:       "pending",
-				ImpactLevel:  "軽微",
-			},
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mockSetup: func(m *disasterTestMocks) {
+				m.municipalityRepo.EXPECT().FindByID(gomock.Any(), int32(131016)).Return(activeMunicipality, nil)
+				m.workCategoryRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(activeWorkCategory, nil)
+				m.disasterRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *model.Disaster, events ...*model.OutboxEvent) error {
+						// 災害とDisasterCreatedイベントを同じ呼び出しで書き込む
+						assert.NotEmpty(t, d.ID)
+						if assert.Len(t, events, 1) {
+							assert.Equal(t, model.DomainEventDisasterCreated, events[0].EventType)
+							assert.Equal(t, d.ID, events[0].AggregateID)
+							assert.JSONEq(t, `"`+d.ID+`"`, jsonField(t, []byte(events[0].Payload), "id"))
+						}
+						return nil
+					})
 			},
 			expectedError: false,
 		},
 		{
-			name: "Error",
-			disaster: &model.Disaster{
-				DisasterCode: "D2024-003",
-				Name:         "福岡台風",
-				PrefectureID: 40,
-				OccurredAt:   time.Now(),
-				Summary:      "福岡で発生した台風",
-				DisasterType: "台風",
-				Status:       "pending",
-				ImpactLevel:  "軽微",
+			name: "Unknown References",
+			mockSetup: func(m *disasterTestMocks) {
+				m.municipalityRepo.EXPECT().FindByID(gomock.Any(), int32(131016)).Return(nil, gorm.ErrRecordNotFound)
+				m.workCategoryRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(nil, gorm.ErrRecordNotFound)
 			},
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			expectedError:  true,
+			expectedFields: []string{"municipality_id", "work_category_id"},
+		},
+		{
+			name: "Inactive Municipality",
+			mockSetup: func(m *disasterTestMocks) {
+				m.municipalityRepo.EXPECT().FindByID(gomock.Any(), int32(131016)).
+					Return(&model.Municipality{ID: 131016, IsActive: false}, nil)
+				m.workCategoryRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(activeWorkCategory, nil)
+			},
+			expectedError:  true,
+			expectedFields: []string{"municipality_id"},
+		},
+		{
+			name: "Lookup Error",
+			mockSetup: func(m *disasterTestMocks) {
+				m.municipalityRepo.EXPECT().FindByID(gomock.Any(), int32(131016)).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "Create Error",
+			mockSetup: func(m *disasterTestMocks) {
+				m.municipalityRepo.EXPECT().FindByID(gomock.Any(), int32(131016)).Return(activeMunicipality, nil)
+				m.workCategoryRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(activeWorkCategory, nil)
+				m.disasterRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
 		},
@@ -252,16 +302,22 @@ func TestDisasterUseCase_CreateDisaster(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks)
 
 			// Call the method
-			err := useCase.CreateDisaster(ctx, tt.disaster)
+			disaster := newDisasterFixture("", "東京地震")
+			err := useCase.CreateDisaster(ctx, disaster)
 
 			// Check results
 			if tt.expectedError {
 				assert.Error(t, err)
+				if tt.expectedFields != nil {
+					assertValidationFields(t, err, tt.expectedFields)
+				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, "千代田区", disaster.Municipality.MunicipalityNameKanji)
+				assert.Equal(t, "農地", disaster.WorkCategory.CategoryName)
 			}
 		})
 	}
@@ -269,49 +325,151 @@ func TestDisasterUseCase_CreateDisaster(t *testing.T) {
 
 func TestDisasterUseCase_UpdateDisaster(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupDisasterTest(t)
+	mocks, useCase := setupDisasterTest(t)
 	ctx := context.Background()
 
+	newName := "更新された地震"
+	newStatus := "completed"
+	newMunicipalityID := int32(271004)
+	newWorkCategoryID := int64(2)
+	// int32に切り詰めると1になるID
+	overflowWorkCategoryID := int64(1<<32 + 1)
+
 	// Test cases
 	tests := []struct {
-		name          string
-		disaster      *model.Disaster
-		mockSetup     func(mockRepo *mockdatastore.MockDisasterRepository)
-		expectedError bool
+		name           string
+		id             string
+		params         *usecase.UpdateDisasterParams
+		mockSetup      func(m *disasterTestMocks)
+		expectedError  bool
+		expectedCode   myerrors.ErrorCode
+		expectedFields []string
 	}{
 		{
-			name: "Success",
-			disaster: &model.Disaster{
-				ID:           "1",
-				DisasterCode: "D2024-001",
-				Name:         "東京地震（更新）",
-				PrefectureID: 13,
-				OccurredAt:   time.Now(),
-				Summary:      "東京で発生した地震（更新）",
-				DisasterType: "地震",
-				Status:       "completed",
-				ImpactLevel:  "深刻",
+			name:   "Partial Update",
+			id:     "1",
+			params: &usecase.UpdateDisasterParams{ExpectedUpdatedAt: disasterVersion, Name: &newName},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
+				m.disasterRepo.EXPECT().Update(gomock.Any(), gomock.Any(), disasterVersion).
+					DoAndReturn(func(_ context.Context, d *model.Disaster, _ time.Time, events ...*model.OutboxEvent) error {
+						assert.Empty(t, events)
+						assert.Equal(t, newName, d.Name)
+						assert.Equal(t, "東京で発生した地震", d.Summary)
+						assert.Equal(t, int32(131016), d.MunicipalityID)
+						return nil
+					})
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", newName), nil)
 			},
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			expectedError: false,
+		},
+		{
+			name:   "Status Change Writes Event",
+			id:     "1",
+			params: &usecase.UpdateDisasterParams{ExpectedUpdatedAt: disasterVersion, Status: &newStatus},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
+				m.disasterRepo.EXPECT().Update(gomock.Any(), gomock.Any(), disasterVersion, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *model.Disaster, _ time.Time, events ...*model.OutboxEvent) error {
+						if assert.Len(t, events, 1) {
+							assert.Equal(t, model.DomainEventDisasterStatusChanged, events[0].EventType)
+							assert.JSONEq(t, `"in_progress"`, jsonField(t, []byte(events[0].Payload), "previous_status"))
+							assert.JSONEq(t, `"completed"`, jsonField(t, []byte(events[0].Payload), "status"))
+						}
+						return nil
+					})
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
 			},
 			expectedError: false,
 		},
 		{
-			name: "Error",
-			disaster: &model.Disaster{
-				ID:           "1",
-				DisasterCode: "D2024-001",
-				Name:         "東京地震（更新）",
-				PrefectureID: 13,
-				OccurredAt:   time.Now(),
-				Summary:      "東京で発生した地震（更新）",
-				DisasterType: "地震",
-				Status:       "completed",
-				ImpactLevel:  "深刻",
+			name: "Change References",
+			id:   "1",
+			params: &usecase.UpdateDisasterParams{
+				ExpectedUpdatedAt: disasterVersion,
+				MunicipalityID:    &newMunicipalityID,
+				WorkCategoryID:    &newWorkCategoryID,
+			},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
+				m.municipalityRepo.EXPECT().FindByID(gomock.Any(), newMunicipalityID).
+					Return(&model.Municipality{ID: newMunicipalityID, IsActive: true}, nil)
+				m.workCategoryRepo.EXPECT().FindByID(gomock.Any(), newWorkCategoryID).
+					Return(&model.WorkCategory{ID: 2, IsActive: true}, nil)
+				m.disasterRepo.EXPECT().Update(gomock.Any(), gomock.Any(), disasterVersion).
+					DoAndReturn(func(_ context.Context, d *model.Disaster, _ time.Time, _ ...*model.OutboxEvent) error {
+						assert.Equal(t, newMunicipalityID, d.MunicipalityID)
+						assert.Equal(t, newWorkCategoryID, d.WorkCategoryID)
+						return nil
+					})
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
 			},
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			expectedError: false,
+		},
+		{
+			name:   "Inactive Work Category",
+			id:     "1",
+			params: &usecase.UpdateDisasterParams{ExpectedUpdatedAt: disasterVersion, WorkCategoryID: &newWorkCategoryID},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
+				m.workCategoryRepo.EXPECT().FindByID(gomock.Any(), newWorkCategoryID).
+					Return(&model.WorkCategory{ID: 2, IsActive: false}, nil)
+			},
+			expectedError:  true,
+			expectedFields: []string{"work_category_id"},
+		},
+		{
+			name:   "Work Category ID Out Of Range",
+			id:     "1",
+			params: &usecase.UpdateDisasterParams{ExpectedUpdatedAt: disasterVersion, WorkCategoryID: &overflowWorkCategoryID},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
+				m.workCategoryRepo.EXPECT().FindByID(gomock.Any(), overflowWorkCategoryID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedError:  true,
+			expectedFields: []string{"work_category_id"},
+		},
+		{
+			name:   "Not Found",
+			id:     "999",
+			params: &usecase.UpdateDisasterParams{ExpectedUpdatedAt: disasterVersion, Name: &newName},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "999").Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedError: true,
+			expectedCode:  myerrors.DisasterNotFoundError,
+		},
+		{
+			name: "Stale Version",
+			id:   "1",
+			params: &usecase.UpdateDisasterParams{
+				ExpectedUpdatedAt: disasterVersion.Add(-time.Minute),
+				Name:              &newName,
+			},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
+			},
+			expectedError: true,
+			expectedCode:  myerrors.PreconditionFailedError,
+		},
+		{
+			name:   "Concurrent Modification",
+			id:     "1",
+			params: &usecase.UpdateDisasterParams{ExpectedUpdatedAt: disasterVersion, Name: &newName},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
+				m.disasterRepo.EXPECT().Update(gomock.Any(), gomock.Any(), disasterVersion).Return(domain.ErrVersionConflict)
+			},
+			expectedError: true,
+			expectedCode:  myerrors.PreconditionFailedError,
+		},
+		{
+			name:   "Update Error",
+			id:     "1",
+			params: &usecase.UpdateDisasterParams{ExpectedUpdatedAt: disasterVersion, Name: &newName},
+			mockSetup: func(m *disasterTestMocks) {
+				m.disasterRepo.EXPECT().FindByID(gomock.Any(), "1").Return(newDisasterFixture("1", "東京地震"), nil)
+				m.disasterRepo.EXPECT().Update(gomock.Any(), gomock.Any(), disasterVersion).Return(errors.New("database error"))
 			},
 			expectedError: true,
 		},
@@ -321,16 +479,28 @@ func TestDisasterUseCase_UpdateDisaster(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks)
 
 			// Call the method
-			err := useCase.UpdateDisaster(ctx, tt.disaster)
+			disaster, err := useCase.UpdateDisaster(ctx, tt.id, tt.params)
 
 			// Check results
 			if tt.expectedError {
 				assert.Error(t, err)
+				assert.Nil(t, disaster)
+
+				if tt.expectedFields != nil {
+					assertValidationFields(t, err, tt.expectedFields)
+				}
+
+				if tt.expectedCode != "" {
+					var apiErr *myerrors.APIError
+					assert.True(t, errors.As(err, &apiErr))
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.id, disaster.ID)
 			}
 		})
 	}
@@ -338,29 +508,92 @@ func TestDisasterUseCase_UpdateDisaster(t *testing.T) {
 
 func TestDisasterUseCase_DeleteDisaster(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupDisasterTest(t)
+	mocks, useCase := setupDisasterTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
-		name          string
-		id            string
-		mockSetup     func(mockRepo *mockdatastore.MockDisasterRepository)
-		expectedError bool
+		name            string
+		id              string
+		force           bool
+		mockSetup       func(m *disasterTestMocks)
+		expectedCode    myerrors.ErrorCode
+		expectedError   bool
+		expectedSummary *model.DisasterDeleteSummary
 	}{
 		{
 			name: "Success",
 			id:   "1",
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				mockRepo.EXPECT().Delete(gomock.Any(), "1").Return(nil)
+			mockSetup: func(m *disasterTestMocks) {
+				expectTransaction(m.transactor)
+				m.disasterRepo.EXPECT().LockByID(gomock.Any(), "1").Return(nil)
+				m.assessmentRepo.EXPECT().CountByDisasterIDForUpdate(gomock.Any(), "1", []string{"承認済"}).Return(int64(0), nil)
+				m.supportApplicationRepo.EXPECT().
+					CountByDisasterIDForUpdate(gomock.Any(), "1", []string{"支払処理中", "完了"}).
+					Return(int64(0), nil)
+				m.disasterRepo.EXPECT().SoftDelete(gomock.Any(), "1", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, children []model.SoftDeleteRelation) (map[string]int64, error) {
+						tables := make([]string, 0, len(children))
+						for _, child := range children {
+							tables = append(tables, child.Table)
+						}
+						assert.Equal(t, []string{"timelines", "disaster_documents", "gis_data", "assessments"}, tables)
+						return map[string]int64{"timelines": 3, "gis_data": 2}, nil
+					})
+			},
+			expectedSummary: &model.DisasterDeleteSummary{
+				DisasterID:      "1",
+				DeletedChildren: map[string]int64{"timelines": 3, "gis_data": 2},
+			},
+		},
+		{
+			name: "Blocked By Approved Assessment And Paid Application",
+			id:   "1",
+			mockSetup: func(m *disasterTestMocks) {
+				expectTransaction(m.transactor)
+				m.disasterRepo.EXPECT().LockByID(gomock.Any(), "1").Return(nil)
+				m.assessmentRepo.EXPECT().CountByDisasterIDForUpdate(gomock.Any(), "1", gomock.Any()).Return(int64(1), nil)
+				m.supportApplicationRepo.EXPECT().CountByDisasterIDForUpdate(gomock.Any(), "1", gomock.Any()).Return(int64(2), nil)
+			},
+			expectedCode: myerrors.DisasterDeleteBlockedError,
+		},
+		{
+			name:  "Forced Despite Blocking Children",
+			id:    "1",
+			force: true,
+			mockSetup: func(m *disasterTestMocks) {
+				expectTransaction(m.transactor)
+				m.disasterRepo.EXPECT().LockByID(gomock.Any(), "1").Return(nil)
+				m.assessmentRepo.EXPECT().CountByDisasterIDForUpdate(gomock.Any(), "1", gomock.Any()).Return(int64(1), nil)
+				m.supportApplicationRepo.EXPECT().CountByDisasterIDForUpdate(gomock.Any(), "1", gomock.Any()).Return(int64(0), nil)
+				m.disasterRepo.EXPECT().SoftDelete(gomock.Any(), "1", gomock.Any()).
+					Return(map[string]int64{"assessments": 1, "assessment_items": 4}, nil)
+			},
+			expectedSummary: &model.DisasterDeleteSummary{
+				DisasterID:          "1",
+				Forced:              true,
+				ApprovedAssessments: 1,
+				DeletedChildren:     map[string]int64{"assessments": 1, "assessment_items": 4},
 			},
-			expectedError: false,
+		},
+		{
+			name: "Not Found",
+			id:   "999",
+			mockSetup: func(m *disasterTestMocks) {
+				expectTransaction(m.transactor)
+				m.disasterRepo.EXPECT().LockByID(gomock.Any(), "999").Return(gorm.ErrRecordNotFound)
+			},
+			expectedCode: myerrors.DisasterNotFoundError,
 		},
 		{
 			name: "Error",
 			id:   "1",
-			mockSetup: func(mockRepo *mockdatastore.MockDisasterRepository) {
-				mockRepo.EXPECT().Delete(gomock.Any(), "1").Return(errors.New("database error"))
+			mockSetup: func(m *disasterTestMocks) {
+				expectTransaction(m.transactor)
+				m.disasterRepo.EXPECT().LockByID(gomock.Any(), "1").Return(nil)
+				m.assessmentRepo.EXPECT().CountByDisasterIDForUpdate(gomock.Any(), "1", gomock.Any()).Return(int64(0), nil)
+				m.supportApplicationRepo.EXPECT().CountByDisasterIDForUpdate(gomock.Any(), "1", gomock.Any()).Return(int64(0), nil)
+				m.disasterRepo.EXPECT().SoftDelete(gomock.Any(), "1", gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
 		},
@@ -370,17 +603,36 @@ func TestDisasterUseCase_DeleteDisaster(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks)
 
 			// Call the method
-			err := useCase.DeleteDisaster(ctx, tt.id)
+			summary, err := useCase.DeleteDisaster(ctx, tt.id, tt.force)
 
 			// Check results
-			if tt.expectedError {
+			switch {
+			case tt.expectedCode != "":
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
+				assert.Nil(t, summary)
+			case tt.expectedError:
 				assert.Error(t, err)
-			} else {
+				assert.Nil(t, summary)
+			default:
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSummary, summary)
 			}
 		})
 	}
 }
+
+// jsonField returns the raw JSON of the top-level field of the object b
+func jsonField(t *testing.T, b []byte, field string) string {
+	t.Helper()
+
+	var fields map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(b, &fields))
+
+	return string(fields[field])
+}
@@ -26,6 +26,9 @@ func NewEmailVarificationTokenUsecase(
 }
 
 func (e *emailVarificationTokenUsecase) SaveEmailVarificationToken(ctx context.Context, token *model.EmailVerificationToken) error {
+	ctx, span := startSpan(ctx, "EmailVarificationTokenUsecase.SaveEmailVarificationToken")
+	defer span.End()
+
 	if err := e.emailVarificationTokenRepo.Save(ctx, token); err != nil {
 		return err
 	}
@@ -34,6 +37,9 @@ func (e *emailVarificationTokenUsecase) SaveEmailVarificationToken(ctx context.C
 }
 
 func (e *emailVarificationTokenUsecase) FindEmailVarificationTokenByTokenAndUserID(ctx context.Context, token string) (*model.EmailVerificationToken, error) {
+	ctx, span := startSpan(ctx, "EmailVarificationTokenUsecase.FindEmailVarificationTokenByTokenAndUserID")
+	defer span.End()
+
 	verificationToken, err := e.emailVarificationTokenRepo.FindByToken(ctx, token)
 	if err != nil {
 		return nil, err
@@ -43,6 +49,9 @@ func (e *emailVarificationTokenUsecase) FindEmailVarificationTokenByTokenAndUser
 }
 
 func (e *emailVarificationTokenUsecase) MarkEmailVarificationTokenAsUsed(ctx context.Context, tokenID string) error {
+	ctx, span := startSpan(ctx, "EmailVarificationTokenUsecase.MarkEmailVarificationTokenAsUsed")
+	defer span.End()
+
 	if err := e.emailVarificationTokenRepo.MarkAsUsed(ctx, tokenID); err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
@@ -12,7 +13,7 @@ type FacilityEquipmentUseCase interface {
 	ListFacilityEquipments(ctx context.Context) ([]*model.FacilityEquipment, error)
 	GetFacilityEquipmentByID(ctx context.Context, id int32) (*model.FacilityEquipment, error)
 	CreateFacilityEquipment(ctx context.Context, facilityEquipment *model.FacilityEquipment) error
-	UpdateFacilityEquipment(ctx context.Context, facilityEquipment *model.FacilityEquipment) error
+	UpdateFacilityEquipment(ctx context.Context, facilityEquipment *model.FacilityEquipment, expectedUpdatedAt time.Time) (*model.FacilityEquipment, error)
 	DeleteFacilityEquipment(ctx context.Context, id int32) error
 }
 
@@ -29,6 +30,9 @@ func NewFacilityEquipmentUseCase(
 }
 
 func (u *facilityEquipmentUseCase) ListFacilityEquipments(ctx context.Context) ([]*model.FacilityEquipment, error) {
+	ctx, span := startSpan(ctx, "FacilityEquipmentUseCase.ListFacilityEquipments")
+	defer span.End()
+
 	facilityEquipments, err := u.facilityEquipmentRepository.Find(ctx)
 	if err != nil {
 		return nil, err
@@ -38,6 +42,9 @@ func (u *facilityEquipmentUseCase) ListFacilityEquipments(ctx context.Context) (
 }
 
 func (u *facilityEquipmentUseCase) GetFacilityEquipmentByID(ctx context.Context, id int32) (*model.FacilityEquipment, error) {
+	ctx, span := startSpan(ctx, "FacilityEquipmentUseCase.GetFacilityEquipmentByID")
+	defer span.End()
+
 	facilityEquipment, err := u.facilityEquipmentRepository.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -47,13 +54,36 @@ func (u *facilityEquipmentUseCase) GetFacilityEquipmentByID(ctx context.Context,
 }
 
 func (u *facilityEquipmentUseCase) CreateFacilityEquipment(ctx context.Context, facilityEquipment *model.FacilityEquipment) error {
+	ctx, span := startSpan(ctx, "FacilityEquipmentUseCase.CreateFacilityEquipment")
+	defer span.End()
+
 	return u.facilityEquipmentRepository.Create(ctx, facilityEquipment)
 }
 
-func (u *facilityEquipmentUseCase) UpdateFacilityEquipment(ctx context.Context, facilityEquipment *model.FacilityEquipment) error {
-	return u.facilityEquipmentRepository.Update(ctx, facilityEquipment)
+// UpdateFacilityEquipment saves facilityEquipment if it has not been modified since expectedUpdatedAt and returns the stored record
+func (u *facilityEquipmentUseCase) UpdateFacilityEquipment(
+	ctx context.Context,
+	facilityEquipment *model.FacilityEquipment,
+	expectedUpdatedAt time.Time,
+) (*model.FacilityEquipment, error) {
+	ctx, span := startSpan(ctx, "FacilityEquipmentUseCase.UpdateFacilityEquipment")
+	defer span.End()
+
+	if err := checkVersion(facilityEquipment.UpdatedAt, expectedUpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if err := u.facilityEquipmentRepository.Update(ctx, facilityEquipment, expectedUpdatedAt); err != nil {
+		return nil, translateVersionConflict(err)
+	}
+
+	// updated_atはDB側の精度で保存されるため、ETag算出用に再取得する
+	return u.facilityEquipmentRepository.FindByID(ctx, facilityEquipment.ID)
 }
 
 func (u *facilityEquipmentUseCase) DeleteFacilityEquipment(ctx context.Context, id int32) error {
+	ctx, span := startSpan(ctx, "FacilityEquipmentUseCase.DeleteFacilityEquipment")
+	defer span.End()
+
 	return u.facilityEquipmentRepository.Delete(ctx, id)
 }
@@ -10,13 +10,15 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
-	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
 )
 
-func setupFacilityEquipmentTest(t *testing.T) (*mockdatastore.MockFacilityEquipmentRepository, usecase.FacilityEquipmentUseCase) {
+func setupFacilityEquipmentTest(t *testing.T) (*mockdomain.MockFacilityEquipmentRepository, usecase.FacilityEquipmentUseCase) {
 	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockFacilityEquipmentRepository(ctrl)
+	mockRepo := mockdomain.NewMockFacilityEquipmentRepository(ctrl)
 	useCase := usecase.NewFacilityEquipmentUseCase(mockRepo)
 	return mockRepo, useCase
 }
@@ -29,13 +31,13 @@ func TestFacilityEquipmentUseCase_ListFacilityEquipments(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		mockSetup     func(mockRepo *mockdatastore.MockFacilityEquipmentRepository)
+		mockSetup     func(mockRepo *mockdomain.MockFacilityEquipmentRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name: "Success",
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
 				now := time.Now()
 				modelNumber1 := "ABC-123"
 				manufacturer1 := "メーカーA"
@@ -86,7 +88,7 @@ func TestFacilityEquipmentUseCase_ListFacilityEquipments(t *testing.T) {
 		},
 		{
 			name: "Error",
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
 				mockRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
@@ -125,13 +127,13 @@ func TestFacilityEquipmentUseCase_GetFacilityEquipmentByID(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockFacilityEquipmentRepository)
+		mockSetup     func(mockRepo *mockdomain.MockFacilityEquipmentRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
 				now := time.Now()
 				modelNumber := "ABC-123"
 				manufacturer := "メーカーA"
@@ -161,7 +163,7 @@ func TestFacilityEquipmentUseCase_GetFacilityEquipmentByID(t *testing.T) {
 		{
 			name: "Not Found",
 			id:   999,
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
 				mockRepo.EXPECT().FindByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
 			},
 			expectedError: true,
@@ -199,7 +201,7 @@ func TestFacilityEquipmentUseCase_CreateFacilityEquipment(t *testing.T) {
 	tests := []struct {
 		name              string
 		facilityEquipment *model.FacilityEquipment
-		mockSetup         func(mockRepo *mockdatastore.MockFacilityEquipmentRepository)
+		mockSetup         func(mockRepo *mockdomain.MockFacilityEquipmentRepository)
 		expectedError     bool
 	}{
 		{
@@ -222,7 +224,7 @@ func TestFacilityEquipmentUseCase_CreateFacilityEquipment(t *testing.T) {
 					Notes:               &notes,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
 				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedError: false,
@@ -236,7 +238,7 @@ func TestFacilityEquipmentUseCase_CreateFacilityEquipment(t *testing.T) {
 					Status:         "稼働中",
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
 				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
@@ -266,13 +268,16 @@ func TestFacilityEquipmentUseCase_UpdateFacilityEquipment(t *testing.T) {
 	// Setup
 	mockRepo, useCase := setupFacilityEquipmentTest(t)
 	ctx := context.Background()
+	version := time.Date(2024, 1, 1, 9, 0, 0, 123456000, time.UTC)
 
 	// Test cases
 	tests := []struct {
 		name              string
 		facilityEquipment *model.FacilityEquipment
-		mockSetup         func(mockRepo *mockdatastore.MockFacilityEquipmentRepository)
+		mockSetup         func(mockRepo *mockdomain.MockFacilityEquipmentRepository)
+		expectedUpdatedAt time.Time
 		expectedError     bool
+		expectedCode      myerrors.ErrorCode
 	}{
 		{
 			name: "Success",
@@ -283,6 +288,7 @@ func TestFacilityEquipmentUseCase_UpdateFacilityEquipment(t *testing.T) {
 				notes := "定期点検済み（更新）"
 
 				return &model.FacilityEquipment{
+					UpdatedAt:           version,
 					ID:                  1,
 					Name:                "空調設備（更新）",
 					FacilityTypeID:      1,
@@ -293,25 +299,47 @@ func TestFacilityEquipmentUseCase_UpdateFacilityEquipment(t *testing.T) {
 					Notes:               &notes,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), version).Return(nil)
+				mockRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(&model.FacilityEquipment{ID: 1, UpdatedAt: version.Add(time.Second)}, nil)
 			},
-			expectedError: false,
+			expectedUpdatedAt: version,
+			expectedError:     false,
 		},
 		{
 			name: "Error",
 			facilityEquipment: func() *model.FacilityEquipment {
 				return &model.FacilityEquipment{
+					UpdatedAt:      version,
 					ID:             1,
 					Name:           "空調設備（更新）",
 					FacilityTypeID: 1,
 					Status:         "メンテナンス中",
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), version).Return(errors.New("database error"))
 			},
-			expectedError: true,
+			expectedUpdatedAt: version,
+			expectedError:     true,
+		},
+		{
+			name:              "Stale Version",
+			facilityEquipment: &model.FacilityEquipment{ID: 1, Name: "空調設備（更新）", FacilityTypeID: 1, Status: "メンテナンス中", UpdatedAt: version.Add(time.Minute)},
+			mockSetup:         func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {},
+			expectedUpdatedAt: version,
+			expectedError:     true,
+			expectedCode:      myerrors.PreconditionFailedError,
+		},
+		{
+			name:              "Concurrent Modification",
+			facilityEquipment: &model.FacilityEquipment{ID: 1, Name: "空調設備（更新）", FacilityTypeID: 1, Status: "メンテナンス中", UpdatedAt: version},
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), version).Return(domain.ErrVersionConflict)
+			},
+			expectedUpdatedAt: version,
+			expectedError:     true,
+			expectedCode:      myerrors.PreconditionFailedError,
 		},
 	}
 
@@ -322,13 +350,21 @@ func TestFacilityEquipmentUseCase_UpdateFacilityEquipment(t *testing.T) {
 			tt.mockSetup(mockRepo)
 
 			// Call the method
-			err := useCase.UpdateFacilityEquipment(ctx, tt.facilityEquipment)
+			updated, err := useCase.UpdateFacilityEquipment(ctx, tt.facilityEquipment, tt.expectedUpdatedAt)
 
 			// Check results
 			if tt.expectedError {
 				assert.Error(t, err)
+				assert.Nil(t, updated)
+
+				if tt.expectedCode != "" {
+					var apiErr *myerrors.APIError
+					assert.True(t, errors.As(err, &apiErr))
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, version.Add(time.Second), updated.UpdatedAt)
 			}
 		})
 	}
@@ -343,13 +379,13 @@ func TestFacilityEquipmentUseCase_DeleteFacilityEquipment(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockFacilityEquipmentRepository)
+		mockSetup     func(mockRepo *mockdomain.MockFacilityEquipmentRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
 				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(nil)
 			},
 			expectedError: false,
@@ -357,7 +393,7 @@ func TestFacilityEquipmentUseCase_DeleteFacilityEquipment(t *testing.T) {
 		{
 			name: "Error",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockFacilityEquipmentRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockFacilityEquipmentRepository) {
 				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(errors.New("database error"))
 			},
 			expectedError: true,
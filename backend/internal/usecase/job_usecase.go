@@ -0,0 +1,88 @@
+//go:generate mockgen -source=job_usecase.go -destination=../../tests/mock/usecase/job_usecase.mock.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+const (
+	defaultJobListLimit = 50
+	maxJobListLimit     = 200
+)
+
+// JobUseCase lets administrators inspect the background job queue and requeue failed jobs
+type JobUseCase interface {
+	// ListJobs returns jobs matching the filter, newest first
+	ListJobs(ctx context.Context, filter model.JobFilter) ([]*model.Job, error)
+	// RetryJob puts a dead job back in the queue with its attempts reset
+	RetryJob(ctx context.Context, id int64) (*model.Job, error)
+}
+
+type jobUseCase struct {
+	jobRepository domain.JobRepository
+}
+
+func NewJobUseCase(jobRepository domain.JobRepository) JobUseCase {
+	return &jobUseCase{
+		jobRepository: jobRepository,
+	}
+}
+
+func (u *jobUseCase) ListJobs(ctx context.Context, filter model.JobFilter) ([]*model.Job, error) {
+	ctx, span := startSpan(ctx, "JobUseCase.ListJobs")
+	defer span.End()
+
+	if err := validateJobFilter(filter); err != nil {
+		return nil, err
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultJobListLimit
+	}
+
+	return u.jobRepository.Find(ctx, filter)
+}
+
+func (u *jobUseCase) RetryJob(ctx context.Context, id int64) (*model.Job, error) {
+	ctx, span := startSpan(ctx, "JobUseCase.RetryJob")
+	defer span.End()
+
+	job, err := u.jobRepository.Retry(ctx, id, time.Now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, myerrors.NewAPIError(myerrors.JobNotRetryableError, myerrors.JobNotRetryableErrorMessage, err, "dead job not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func validateJobFilter(filter model.JobFilter) error {
+	var fields []myerrors.FieldError
+	if filter.Status != "" && !slices.Contains(model.JobStatuses, filter.Status) {
+		fields = append(fields, myerrors.NewFieldError("status", myerrors.FieldOneOf, strings.Join(model.JobStatuses, ", ")))
+	}
+	if filter.Limit < 0 || filter.Limit > maxJobListLimit {
+		fields = append(fields, myerrors.NewFieldError("limit", myerrors.FieldRange, 1, maxJobListLimit))
+	}
+	if filter.BeforeID < 0 {
+		fields = append(fields, myerrors.NewFieldError("before_id", myerrors.FieldMin, 1))
+	}
+
+	if len(fields) > 0 {
+		return myerrors.NewValidationError(fields...)
+	}
+
+	return nil
+}
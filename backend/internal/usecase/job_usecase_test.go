@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+func setupJobTest(t *testing.T) (*mockdomain.MockJobRepository, usecase.JobUseCase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockJobRepository(ctrl)
+	useCase := usecase.NewJobUseCase(mockRepo)
+	return mockRepo, useCase
+}
+
+func TestJobUseCase_ListJobs(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupJobTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name         string
+		filter       model.JobFilter
+		mockSetup    func(mockRepo *mockdomain.MockJobRepository)
+		expectedCode myerrors.ErrorCode
+	}{
+		{
+			name:   "Default Limit",
+			filter: model.JobFilter{Status: model.JobStatusDead},
+			mockSetup: func(mockRepo *mockdomain.MockJobRepository) {
+				mockRepo.EXPECT().Find(gomock.Any(), model.JobFilter{Status: model.JobStatusDead, Limit: 50}).
+					Return([]*model.Job{{ID: 1}}, nil)
+			},
+		},
+		{
+			name:         "Invalid Status",
+			filter:       model.JobFilter{Status: "failed"},
+			mockSetup:    func(mockRepo *mockdomain.MockJobRepository) {},
+			expectedCode: myerrors.ValidationError,
+		},
+		{
+			name:         "Limit Too Large",
+			filter:       model.JobFilter{Limit: 1000},
+			mockSetup:    func(mockRepo *mockdomain.MockJobRepository) {},
+			expectedCode: myerrors.ValidationError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockRepo)
+
+			jobs, err := useCase.ListJobs(ctx, tt.filter)
+
+			if tt.expectedCode != "" {
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, jobs, 1)
+		})
+	}
+}
+
+func TestJobUseCase_RetryJob(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupJobTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mockRepo *mockdomain.MockJobRepository)
+		expectedCode  myerrors.ErrorCode
+		expectedError bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockRepo *mockdomain.MockJobRepository) {
+				mockRepo.EXPECT().Retry(gomock.Any(), int64(1), gomock.Any()).
+					Return(&model.Job{ID: 1, Status: model.JobStatusPending}, nil)
+			},
+		},
+		{
+			name: "Not Dead",
+			mockSetup: func(mockRepo *mockdomain.MockJobRepository) {
+				mockRepo.EXPECT().Retry(gomock.Any(), int64(1), gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedCode: myerrors.JobNotRetryableError,
+		},
+		{
+			name: "Repository Error",
+			mockSetup: func(mockRepo *mockdomain.MockJobRepository) {
+				mockRepo.EXPECT().Retry(gomock.Any(), int64(1), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockRepo)
+
+			job, err := useCase.RetryJob(ctx, 1)
+
+			switch {
+			case tt.expectedCode != "":
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
+			case tt.expectedError:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, model.JobStatusPending, job.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+//go:generate mockgen -source=notification_dispatcher.go -destination=../../tests/mock/usecase/notification_dispatcher.mock.go
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+)
+
+const (
+	notificationEmailType       = "notification"
+	notificationDigestEmailType = "notification_digest"
+	notificationEmailSubject    = "【農業災害支援システム】%s"
+	notificationDigestSubject   = "【農業災害支援システム】新着通知のまとめ（%d件）"
+)
+
+// NotificationDispatcher delivers notifications over the channels each user chose for the notification type
+type NotificationDispatcher interface {
+	// Dispatch stores the in-app notification and queues the email and the webhook per the recipient's preference.
+	// A failure to queue the email or webhook does not fail the dispatch; it is reported in the result.
+	Dispatch(ctx context.Context, notification *model.Notification) (*model.NotificationDelivery, error)
+	// SendEmail sends a notification email queued by Dispatch and records it in email_histories.
+	// It runs as a background job.
+	SendEmail(ctx context.Context, payload model.NotificationEmailPayload) error
+	// PostWebhook posts a notification queued by Dispatch to the recipient's webhook. It runs as a background job.
+	PostWebhook(ctx context.Context, payload model.NotificationWebhookPayload) error
+	// SendDigests sends one email per user for the queued daily digest entries and returns the number of emails sent
+	SendDigests(ctx context.Context, now time.Time) (int, error)
+}
+
+type notificationDispatcher struct {
+	notificationRepository           domain.NotificationRepository
+	notificationPreferenceRepository domain.NotificationPreferenceRepository
+	notificationDigestRepository     domain.NotificationDigestRepository
+	userRepository                   domain.UserRepository
+	emailHistoryRepository           domain.EmailHistoryRepository
+	mailer                           domain.Mailer
+	webhookSender                    domain.WebhookSender
+	jobRepository                    domain.JobRepository
+}
+
+func NewNotificationDispatcher(
+	notificationRepository domain.NotificationRepository,
+	notificationPreferenceRepository domain.NotificationPreferenceRepository,
+	notificationDigestRepository domain.NotificationDigestRepository,
+	userRepository domain.UserRepository,
+	emailHistoryRepository domain.EmailHistoryRepository,
+	mailer domain.Mailer,
+	webhookSender domain.WebhookSender,
+	jobRepository domain.JobRepository,
+) NotificationDispatcher {
+	return &notificationDispatcher{
+		notificationRepository:           notificationRepository,
+		notificationPreferenceRepository: notificationPreferenceRepository,
+		notificationDigestRepository:     notificationDigestRepository,
+		userRepository:                   userRepository,
+		emailHistoryRepository:           emailHistoryRepository,
+		mailer:                           mailer,
+		webhookSender:                    webhookSender,
+		jobRepository:                    jobRepository,
+	}
+}
+
+func (d *notificationDispatcher) Dispatch(ctx context.Context, notification *model.Notification) (*model.NotificationDelivery, error) {
+	ctx, span := startSpan(ctx, "NotificationDispatcher.Dispatch")
+	defer span.End()
+
+	preference, err := d.notificationPreferenceRepository.FindByUserIDAndType(ctx, notification.UserID, notification.NotificationType)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		preference = model.NewDefaultNotificationPreference(notification.UserID, notification.NotificationType)
+	} else if err != nil {
+		return nil, err
+	}
+
+	delivery := &model.NotificationDelivery{
+		InApp:   model.DeliveryStatusDisabled,
+		Email:   model.DeliveryStatusDisabled,
+		Webhook: model.DeliveryStatusDisabled,
+	}
+
+	if preference.InApp {
+		if err := d.notificationRepository.Create(ctx, notification); err != nil {
+			return nil, err
+		}
+		delivery.InApp = model.DeliveryStatusSent
+	}
+
+	if preference.Email {
+		if preference.EmailFrequency == model.EmailFrequencyDailyDigest {
+			if err := d.notificationDigestRepository.Create(ctx, &model.NotificationDigestEntry{
+				UserID:           notification.UserID,
+				NotificationType: notification.NotificationType,
+				Title:            notification.Title,
+				Message:          notification.Message,
+			}); err != nil {
+				return nil, err
+			}
+			delivery.Email = model.DeliveryStatusQueued
+		} else {
+			// 送信の失敗を再試行できるようジョブで送る
+			delivery.Email = model.DeliveryStatusQueued
+			if err := d.enqueue(ctx, model.JobTypeNotificationEmail, &model.NotificationEmailPayload{
+				UserID:  notification.UserID,
+				Title:   notification.Title,
+				Message: notification.Message,
+			}); err != nil {
+				delivery.Email = model.DeliveryStatusFailed
+				delivery.Failures = append(delivery.Failures, fmt.Errorf("email: %w", err))
+			}
+		}
+	}
+
+	if preference.Webhook && preference.WebhookURL != nil {
+		// 送信先の応答を待たず、失敗時に再送できるようジョブで送る
+		delivery.Webhook = model.DeliveryStatusQueued
+		if err := d.enqueueWebhook(ctx, *preference.WebhookURL, notification); err != nil {
+			delivery.Webhook = model.DeliveryStatusFailed
+			delivery.Failures = append(delivery.Failures, fmt.Errorf("webhook: %w", err))
+		}
+	}
+
+	return delivery, nil
+}
+
+func (d *notificationDispatcher) SendDigests(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := startSpan(ctx, "NotificationDispatcher.SendDigests")
+	defer span.End()
+
+	entries, err := d.notificationDigestRepository.FindPending(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// FindPendingはユーザー順に返すので、連続する同一ユーザーの項目をまとめる
+	var (
+		sent int
+		errs []error
+	)
+	for start := 0; start < len(entries); {
+		end := start + 1
+		for end < len(entries) && entries[end].UserID == entries[start].UserID {
+			end++
+		}
+
+		if err := d.sendDigest(ctx, entries[start:end], now); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", entries[start].UserID, err))
+		} else {
+			sent++
+		}
+
+		start = end
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+func (d *notificationDispatcher) SendEmail(ctx context.Context, payload model.NotificationEmailPayload) error {
+	ctx, span := startSpan(ctx, "NotificationDispatcher.SendEmail")
+	defer span.End()
+
+	user, err := d.userRepository.FindByID(ctx, payload.UserID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// キューに入った後でユーザーが削除された
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf(notificationEmailSubject, payload.Title)
+	body := fmt.Sprintf(
+		"<html><body><h2>%s</h2><p>%s</p></body></html>",
+		html.EscapeString(payload.Title),
+		strings.ReplaceAll(html.EscapeString(payload.Message), "\n", "<br>"),
+	)
+
+	return d.sendEmail(ctx, user, subject, body, notificationEmailType)
+}
+
+func (d *notificationDispatcher) sendDigest(ctx context.Context, entries []*model.NotificationDigestEntry, now time.Time) error {
+	user, err := d.userRepository.FindByID(ctx, entries[0].UserID)
+	if err != nil {
+		return err
+	}
+
+	var body strings.Builder
+	body.WriteString("<html><body><p>")
+	body.WriteString(html.EscapeString(user.Name))
+	body.WriteString(" 様</p><p>前回のお知らせ以降に届いた通知です。</p><ul>")
+
+	ids := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		fmt.Fprintf(&body, "<li><strong>[%s] %s</strong><br>%s</li>",
+			html.EscapeString(entry.NotificationType),
+			html.EscapeString(entry.Title),
+			strings.ReplaceAll(html.EscapeString(entry.Message), "\n", "<br>"),
+		)
+		ids = append(ids, entry.ID)
+	}
+	body.WriteString("</ul></body></html>")
+
+	subject := fmt.Sprintf(notificationDigestSubject, len(entries))
+	if err := d.sendEmail(ctx, user, subject, body.String(), notificationDigestEmailType); err != nil {
+		// 送信済みにしないので、次回のまとめに含めて再送される
+		return err
+	}
+
+	return d.notificationDigestRepository.MarkSent(ctx, ids, now)
+}
+
+// sendEmail sends the email and records the result in email_histories
+func (d *notificationDispatcher) sendEmail(ctx context.Context, user *model.User, subject, body, emailType string) error {
+	sendErr := d.mailer.Send(ctx, user.Email, subject, body)
+
+	history := &model.EmailHistory{
+		UserID:    user.ID,
+		Email:     user.Email,
+		Subject:   subject,
+		EmailType: emailType,
+		Provider:  d.mailer.Provider(),
+		Status:    model.DeliveryStatusSent,
+		SentAt:    time.Now(),
+	}
+	if sendErr != nil {
+		errorMessage := sendErr.Error()
+		history.Status = model.DeliveryStatusFailed
+		history.ErrorMessage = &errorMessage
+	}
+
+	if err := d.emailHistoryRepository.SaveEmailHistory(ctx, history); err != nil {
+		return errors.Join(sendErr, fmt.Errorf("failed to save email history: %w", err))
+	}
+
+	return sendErr
+}
+
+type notificationWebhookPayload struct {
+	ID                int32     `json:"id,omitempty"`
+	UserID            string    `json:"user_id"`
+	Title             string    `json:"title"`
+	Message           string    `json:"message"`
+	NotificationType  string    `json:"notification_type"`
+	RelatedEntityType *string   `json:"related_entity_type,omitempty"`
+	RelatedEntityID   *string   `json:"related_entity_id,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+func (d *notificationDispatcher) enqueueWebhook(ctx context.Context, url string, notification *model.Notification) error {
+	createdAt := notification.CreatedAt
+	if createdAt.IsZero() {
+		// アプリ内通知を作成していない場合はレコードの作成日時がない
+		createdAt = time.Now()
+	}
+
+	body, err := json.Marshal(&notificationWebhookPayload{
+		ID:                notification.ID,
+		UserID:            notification.UserID,
+		Title:             notification.Title,
+		Message:           notification.Message,
+		NotificationType:  notification.NotificationType,
+		RelatedEntityType: notification.RelatedEntityType,
+		RelatedEntityID:   notification.RelatedEntityID,
+		CreatedAt:         createdAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.enqueue(ctx, model.JobTypeNotificationWebhook, &model.NotificationWebhookPayload{URL: url, Body: body})
+}
+
+func (d *notificationDispatcher) enqueue(ctx context.Context, jobType string, payload any) error {
+	job, err := model.NewJob(jobType, payload)
+	if err != nil {
+		return err
+	}
+
+	return d.jobRepository.Enqueue(ctx, job)
+}
+
+func (d *notificationDispatcher) PostWebhook(ctx context.Context, payload model.NotificationWebhookPayload) error {
+	ctx, span := startSpan(ctx, "NotificationDispatcher.PostWebhook")
+	defer span.End()
+
+	return d.webhookSender.Post(ctx, payload.URL, payload.Body)
+}
@@ -0,0 +1,423 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+const dispatchUserID = "5d1e8a3c-2b7f-4c9e-8a61-0f3b2d4c6e81"
+
+type dispatcherTestMocks struct {
+	notificationRepo *mockdomain.MockNotificationRepository
+	preferenceRepo   *mockdomain.MockNotificationPreferenceRepository
+	digestRepo       *mockdomain.MockNotificationDigestRepository
+	userRepo         *mockdomain.MockUserRepository
+	emailHistoryRepo *mockdomain.MockEmailHistoryRepository
+	mailer           *mockdomain.MockMailer
+	webhookSender    *mockdomain.MockWebhookSender
+	jobRepo          *mockdomain.MockJobRepository
+}
+
+func setupNotificationDispatcherTest(t *testing.T) (*dispatcherTestMocks, usecase.NotificationDispatcher) {
+	ctrl := gomock.NewController(t)
+	mocks := &dispatcherTestMocks{
+		notificationRepo: mockdomain.NewMockNotificationRepository(ctrl),
+		preferenceRepo:   mockdomain.NewMockNotificationPreferenceRepository(ctrl),
+		digestRepo:       mockdomain.NewMockNotificationDigestRepository(ctrl),
+		userRepo:         mockdomain.NewMockUserRepository(ctrl),
+		emailHistoryRepo: mockdomain.NewMockEmailHistoryRepository(ctrl),
+		mailer:           mockdomain.NewMockMailer(ctrl),
+		webhookSender:    mockdomain.NewMockWebhookSender(ctrl),
+		jobRepo:          mockdomain.NewMockJobRepository(ctrl),
+	}
+	dispatcher := usecase.NewNotificationDispatcher(
+		mocks.notificationRepo,
+		mocks.preferenceRepo,
+		mocks.digestRepo,
+		mocks.userRepo,
+		mocks.emailHistoryRepo,
+		mocks.mailer,
+		mocks.webhookSender,
+		mocks.jobRepo,
+	)
+	return mocks, dispatcher
+}
+
+func TestNotificationDispatcher_Dispatch(t *testing.T) {
+	// Setup
+	mocks, dispatcher := setupNotificationDispatcherTest(t)
+	ctx := context.Background()
+
+	webhookURL := "https://hooks.example.com/notify"
+
+	// Test cases
+	tests := []struct {
+		name             string
+		mockSetup        func(m *dispatcherTestMocks)
+		expectedError    bool
+		expectedDelivery *model.NotificationDelivery
+		expectedFailures int
+	}{
+		{
+			name: "Default Preference Is In-App Only",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.preferenceRepo.EXPECT().FindByUserIDAndType(gomock.Any(), dispatchUserID, model.NotificationTypeAssessment).
+					Return(nil, gorm.ErrRecordNotFound)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedDelivery: &model.NotificationDelivery{
+				InApp:   model.DeliveryStatusSent,
+				Email:   model.DeliveryStatusDisabled,
+				Webhook: model.DeliveryStatusDisabled,
+			},
+		},
+		{
+			name: "Immediate Email Is Queued",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.preferenceRepo.EXPECT().FindByUserIDAndType(gomock.Any(), dispatchUserID, model.NotificationTypeAssessment).
+					Return(&model.NotificationPreference{InApp: true, Email: true, EmailFrequency: model.EmailFrequencyImmediate}, nil)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, job *model.Job) error {
+						assert.Equal(t, model.JobTypeNotificationEmail, job.JobType)
+
+						var payload model.NotificationEmailPayload
+						assert.NoError(t, json.Unmarshal([]byte(job.Payload), &payload))
+						assert.Equal(t, model.NotificationEmailPayload{
+							UserID:  dispatchUserID,
+							Title:   "査定が完了しました",
+							Message: "令和6年豪雨の査定が完了しました。",
+						}, payload)
+						return nil
+					})
+			},
+			expectedDelivery: &model.NotificationDelivery{
+				InApp:   model.DeliveryStatusSent,
+				Email:   model.DeliveryStatusQueued,
+				Webhook: model.DeliveryStatusDisabled,
+			},
+		},
+		{
+			name: "Email Enqueue Failure Does Not Fail Dispatch",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.preferenceRepo.EXPECT().FindByUserIDAndType(gomock.Any(), dispatchUserID, model.NotificationTypeAssessment).
+					Return(&model.NotificationPreference{Email: true, EmailFrequency: model.EmailFrequencyImmediate}, nil)
+				m.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedDelivery: &model.NotificationDelivery{
+				InApp:   model.DeliveryStatusDisabled,
+				Email:   model.DeliveryStatusFailed,
+				Webhook: model.DeliveryStatusDisabled,
+			},
+			expectedFailures: 1,
+		},
+		{
+			name: "Daily Digest Queues Entry",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.preferenceRepo.EXPECT().FindByUserIDAndType(gomock.Any(), dispatchUserID, model.NotificationTypeAssessment).
+					Return(&model.NotificationPreference{InApp: true, Email: true, EmailFrequency: model.EmailFrequencyDailyDigest}, nil)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.digestRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, entry *model.NotificationDigestEntry) error {
+						assert.Equal(t, dispatchUserID, entry.UserID)
+						assert.Equal(t, "査定が完了しました", entry.Title)
+						return nil
+					})
+			},
+			expectedDelivery: &model.NotificationDelivery{
+				InApp:   model.DeliveryStatusSent,
+				Email:   model.DeliveryStatusQueued,
+				Webhook: model.DeliveryStatusDisabled,
+			},
+		},
+		{
+			name: "Webhook Is Queued",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.preferenceRepo.EXPECT().FindByUserIDAndType(gomock.Any(), dispatchUserID, model.NotificationTypeAssessment).
+					Return(&model.NotificationPreference{InApp: true, Webhook: true, WebhookURL: &webhookURL}, nil)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, job *model.Job) error {
+						assert.Equal(t, model.JobTypeNotificationWebhook, job.JobType)
+
+						var payload model.NotificationWebhookPayload
+						assert.NoError(t, json.Unmarshal([]byte(job.Payload), &payload))
+						assert.Equal(t, webhookURL, payload.URL)
+						assert.Contains(t, string(payload.Body), `"title":"査定が完了しました"`)
+						return nil
+					})
+			},
+			expectedDelivery: &model.NotificationDelivery{
+				InApp:   model.DeliveryStatusSent,
+				Email:   model.DeliveryStatusDisabled,
+				Webhook: model.DeliveryStatusQueued,
+			},
+		},
+		{
+			name: "Webhook Enqueue Failure Is Reported",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.preferenceRepo.EXPECT().FindByUserIDAndType(gomock.Any(), dispatchUserID, model.NotificationTypeAssessment).
+					Return(&model.NotificationPreference{InApp: true, Webhook: true, WebhookURL: &webhookURL}, nil)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedDelivery: &model.NotificationDelivery{
+				InApp:   model.DeliveryStatusSent,
+				Email:   model.DeliveryStatusDisabled,
+				Webhook: model.DeliveryStatusFailed,
+			},
+			expectedFailures: 1,
+		},
+		{
+			name: "In-App Error",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.preferenceRepo.EXPECT().FindByUserIDAndType(gomock.Any(), dispatchUserID, model.NotificationTypeAssessment).
+					Return(nil, gorm.ErrRecordNotFound)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks)
+
+			delivery, err := dispatcher.Dispatch(ctx, &model.Notification{
+				UserID:           dispatchUserID,
+				Title:            "査定が完了しました",
+				Message:          "令和6年豪雨の査定が完了しました。",
+				NotificationType: model.NotificationTypeAssessment,
+			})
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, delivery)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, delivery.Failures, tt.expectedFailures)
+			delivery.Failures = nil
+			assert.Equal(t, tt.expectedDelivery, delivery)
+		})
+	}
+}
+
+func TestNotificationDispatcher_SendEmail(t *testing.T) {
+	// Setup
+	mocks, dispatcher := setupNotificationDispatcherTest(t)
+	ctx := context.Background()
+
+	user := &model.User{ID: dispatchUserID, Name: "山田太郎", Email: "yamada@example.com"}
+	payload := model.NotificationEmailPayload{
+		UserID:  dispatchUserID,
+		Title:   "査定が完了しました",
+		Message: "<b>令和6年豪雨</b>の査定が完了しました。",
+	}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(m *dispatcherTestMocks)
+		expectedError bool
+	}{
+		{
+			name: "Sent And Recorded",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), dispatchUserID).Return(user, nil)
+				m.mailer.EXPECT().Send(gomock.Any(), "yamada@example.com", "【農業災害支援システム】査定が完了しました", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _, body string) error {
+						assert.Contains(t, body, "&lt;b&gt;令和6年豪雨&lt;/b&gt;")
+						return nil
+					})
+				m.mailer.EXPECT().Provider().Return("smtp")
+				m.emailHistoryRepo.EXPECT().SaveEmailHistory(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, history *model.EmailHistory) error {
+						assert.Equal(t, "notification", history.EmailType)
+						assert.Equal(t, model.DeliveryStatusSent, history.Status)
+						assert.Nil(t, history.ErrorMessage)
+						return nil
+					})
+			},
+		},
+		{
+			// ジョブの再試行に任せるため、履歴を残してエラーを返す
+			name: "Failure Is Recorded And Returned",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), dispatchUserID).Return(user, nil)
+				m.mailer.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+				m.mailer.EXPECT().Provider().Return("smtp")
+				m.emailHistoryRepo.EXPECT().SaveEmailHistory(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, history *model.EmailHistory) error {
+						assert.Equal(t, model.DeliveryStatusFailed, history.Status)
+						assert.Equal(t, "connection refused", *history.ErrorMessage)
+						return nil
+					})
+			},
+			expectedError: true,
+		},
+		{
+			name: "User Deleted",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), dispatchUserID).Return(nil, gorm.ErrRecordNotFound)
+			},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks)
+
+			err := dispatcher.SendEmail(ctx, payload)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationDispatcher_PostWebhook(t *testing.T) {
+	// Setup
+	mocks, dispatcher := setupNotificationDispatcherTest(t)
+	ctx := context.Background()
+
+	payload := model.NotificationWebhookPayload{
+		URL:  "https://hooks.example.com/notify",
+		Body: json.RawMessage(`{"title":"査定が完了しました"}`),
+	}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(m *dispatcherTestMocks)
+		expectedError bool
+	}{
+		{
+			name: "Posted",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.webhookSender.EXPECT().Post(gomock.Any(), payload.URL, []byte(payload.Body)).Return(nil)
+			},
+		},
+		{
+			// ジョブの再試行に任せるためエラーを返す
+			name: "Failure Is Returned",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.webhookSender.EXPECT().Post(gomock.Any(), payload.URL, gomock.Any()).Return(errors.New("status 500"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks)
+
+			err := dispatcher.PostWebhook(ctx, payload)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationDispatcher_SendDigests(t *testing.T) {
+	// Setup
+	mocks, dispatcher := setupNotificationDispatcherTest(t)
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+
+	otherUserID := "9a4c2e7b-1d3f-4b8a-b5c6-7e8f9a0b1c2d"
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(m *dispatcherTestMocks)
+		expectedSent  int
+		expectedError bool
+	}{
+		{
+			name: "One Email Per User",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.digestRepo.EXPECT().FindPending(gomock.Any()).Return([]*model.NotificationDigestEntry{
+					{ID: 1, UserID: dispatchUserID, NotificationType: model.NotificationTypeAssessment, Title: "査定完了", Message: "査定が完了しました"},
+					{ID: 2, UserID: dispatchUserID, NotificationType: model.NotificationTypeReminder, Title: "期限間近", Message: "<b>明日</b>が期限です"},
+					{ID: 3, UserID: otherUserID, NotificationType: model.NotificationTypeSystem, Title: "メンテナンス", Message: "定期メンテナンスのお知らせ"},
+				}, nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), dispatchUserID).
+					Return(&model.User{ID: dispatchUserID, Name: "山田太郎", Email: "yamada@example.com"}, nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), otherUserID).
+					Return(&model.User{ID: otherUserID, Name: "佐藤花子", Email: "sato@example.com"}, nil)
+				m.mailer.EXPECT().Send(gomock.Any(), "yamada@example.com", "【農業災害支援システム】新着通知のまとめ（2件）", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _, body string) error {
+						assert.Contains(t, body, "&lt;b&gt;明日&lt;/b&gt;")
+						return nil
+					})
+				m.mailer.EXPECT().Send(gomock.Any(), "sato@example.com", "【農業災害支援システム】新着通知のまとめ（1件）", gomock.Any()).Return(nil)
+				m.mailer.EXPECT().Provider().Return("smtp").Times(2)
+				m.emailHistoryRepo.EXPECT().SaveEmailHistory(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				m.digestRepo.EXPECT().MarkSent(gomock.Any(), []int64{1, 2}, now).Return(nil)
+				m.digestRepo.EXPECT().MarkSent(gomock.Any(), []int64{3}, now).Return(nil)
+			},
+			expectedSent: 2,
+		},
+		{
+			name: "Failed Email Stays Pending",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.digestRepo.EXPECT().FindPending(gomock.Any()).Return([]*model.NotificationDigestEntry{
+					{ID: 4, UserID: dispatchUserID, NotificationType: model.NotificationTypeAssessment, Title: "査定完了", Message: "査定が完了しました"},
+				}, nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), dispatchUserID).
+					Return(&model.User{ID: dispatchUserID, Name: "山田太郎", Email: "yamada@example.com"}, nil)
+				m.mailer.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+				m.mailer.EXPECT().Provider().Return("smtp")
+				m.emailHistoryRepo.EXPECT().SaveEmailHistory(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedSent:  0,
+			expectedError: true,
+		},
+		{
+			name: "Nothing Pending",
+			mockSetup: func(m *dispatcherTestMocks) {
+				m.digestRepo.EXPECT().FindPending(gomock.Any()).Return(nil, nil)
+			},
+			expectedSent: 0,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks)
+
+			sent, err := dispatcher.SendDigests(ctx, now)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedSent, sent)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+//go:generate mockgen -source=notification_preference_usecase.go -destination=../../tests/mock/usecase/notification_preference_usecase.mock.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+type NotificationPreferenceUseCase interface {
+	// ListPreferences returns the preference of every notification type, filling unconfigured types with the default
+	ListPreferences(ctx context.Context, userID string) ([]*model.NotificationPreference, error)
+	// UpdatePreferences saves the given types and returns the preferences of every type
+	UpdatePreferences(ctx context.Context, userID string, preferences []*model.NotificationPreference) ([]*model.NotificationPreference, error)
+}
+
+type notificationPreferenceUseCase struct {
+	notificationPreferenceRepository domain.NotificationPreferenceRepository
+}
+
+func NewNotificationPreferenceUseCase(
+	notificationPreferenceRepository domain.NotificationPreferenceRepository,
+) NotificationPreferenceUseCase {
+	return &notificationPreferenceUseCase{
+		notificationPreferenceRepository: notificationPreferenceRepository,
+	}
+}
+
+func (u *notificationPreferenceUseCase) ListPreferences(ctx context.Context, userID string) ([]*model.NotificationPreference, error) {
+	ctx, span := startSpan(ctx, "NotificationPreferenceUseCase.ListPreferences")
+	defer span.End()
+
+	configured, err := u.notificationPreferenceRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[string]*model.NotificationPreference, len(configured))
+	for _, preference := range configured {
+		byType[preference.NotificationType] = preference
+	}
+
+	preferences := make([]*model.NotificationPreference, 0, len(model.NotificationTypes))
+	for _, notificationType := range model.NotificationTypes {
+		if preference, ok := byType[notificationType]; ok {
+			preferences = append(preferences, preference)
+			continue
+		}
+
+		preferences = append(preferences, model.NewDefaultNotificationPreference(userID, notificationType))
+	}
+
+	return preferences, nil
+}
+
+func (u *notificationPreferenceUseCase) UpdatePreferences(
+	ctx context.Context,
+	userID string,
+	preferences []*model.NotificationPreference,
+) ([]*model.NotificationPreference, error) {
+	ctx, span := startSpan(ctx, "NotificationPreferenceUseCase.UpdatePreferences")
+	defer span.End()
+
+	if err := validateNotificationPreferences(preferences); err != nil {
+		return nil, err
+	}
+
+	for _, preference := range preferences {
+		preference.UserID = userID
+		if preference.EmailFrequency == "" {
+			preference.EmailFrequency = model.EmailFrequencyImmediate
+		}
+		if !preference.Webhook {
+			preference.WebhookURL = nil
+		}
+	}
+
+	if err := u.notificationPreferenceRepository.Upsert(ctx, preferences); err != nil {
+		return nil, err
+	}
+
+	return u.ListPreferences(ctx, userID)
+}
+
+func validateNotificationPreferences(preferences []*model.NotificationPreference) error {
+	var fieldErrors []myerrors.FieldError
+
+	seen := make(map[string]bool, len(preferences))
+	for i, preference := range preferences {
+		field := fmt.Sprintf("preferences[%d]", i)
+
+		switch {
+		case !model.IsValidNotificationType(preference.NotificationType):
+			fieldErrors = append(fieldErrors, myerrors.NewFieldError(
+				field+".notification_type",
+				myerrors.FieldOneOf,
+				"システム, 災害情報, 査定, 申請, リマインダー, その他",
+			))
+		case seen[preference.NotificationType]:
+			fieldErrors = append(fieldErrors, myerrors.NewFieldError(field+".notification_type", myerrors.FieldDuplicated))
+		}
+		seen[preference.NotificationType] = true
+
+		switch preference.EmailFrequency {
+		case "", model.EmailFrequencyImmediate, model.EmailFrequencyDailyDigest:
+		default:
+			fieldErrors = append(fieldErrors, myerrors.NewFieldError(field+".email_frequency", myerrors.FieldOneOf, "immediate, daily_digest"))
+		}
+
+		if preference.Webhook && !isHTTPURL(preference.WebhookURL) {
+			fieldErrors = append(fieldErrors, myerrors.NewFieldError(field+".webhook_url", myerrors.FieldWebhookURLRequired))
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return myerrors.NewValidationError(fieldErrors...)
+	}
+
+	return nil
+}
+
+func isHTTPURL(raw *string) bool {
+	if raw == nil {
+		return false
+	}
+
+	u, err := url.Parse(*raw)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
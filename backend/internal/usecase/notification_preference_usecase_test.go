@@ -0,0 +1,140 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+func setupNotificationPreferenceTest(t *testing.T) (*mockdomain.MockNotificationPreferenceRepository, usecase.NotificationPreferenceUseCase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockNotificationPreferenceRepository(ctrl)
+	useCase := usecase.NewNotificationPreferenceUseCase(mockRepo)
+	return mockRepo, useCase
+}
+
+func TestNotificationPreferenceUseCase_ListPreferences(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupNotificationPreferenceTest(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByUserID(gomock.Any(), dispatchUserID).Return([]*model.NotificationPreference{
+		{UserID: dispatchUserID, NotificationType: model.NotificationTypeAssessment, Email: true, EmailFrequency: model.EmailFrequencyDailyDigest},
+	}, nil)
+
+	preferences, err := useCase.ListPreferences(ctx, dispatchUserID)
+
+	assert.NoError(t, err)
+	if assert.Len(t, preferences, len(model.NotificationTypes)) {
+		for i, notificationType := range model.NotificationTypes {
+			assert.Equal(t, notificationType, preferences[i].NotificationType)
+		}
+
+		// 設定済みの種別はそのまま、未設定の種別はアプリ内通知のみ
+		assert.False(t, preferences[2].InApp)
+		assert.True(t, preferences[2].Email)
+		assert.Equal(t, model.EmailFrequencyDailyDigest, preferences[2].EmailFrequency)
+		assert.True(t, preferences[0].InApp)
+		assert.False(t, preferences[0].Email)
+	}
+}
+
+func TestNotificationPreferenceUseCase_UpdatePreferences(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupNotificationPreferenceTest(t)
+	ctx := context.Background()
+
+	webhookURL := "https://hooks.example.com/notify"
+	invalidURL := "ftp://hooks.example.com"
+
+	// Test cases
+	tests := []struct {
+		name           string
+		preferences    []*model.NotificationPreference
+		mockSetup      func(mockRepo *mockdomain.MockNotificationPreferenceRepository)
+		expectedCode   myerrors.ErrorCode
+		expectedFields []string
+		expectedError  bool
+	}{
+		{
+			name: "Success",
+			preferences: []*model.NotificationPreference{
+				{NotificationType: model.NotificationTypeDisaster, InApp: true, Email: true},
+				{NotificationType: model.NotificationTypeReminder, Webhook: true, WebhookURL: &webhookURL},
+			},
+			mockSetup: func(mockRepo *mockdomain.MockNotificationPreferenceRepository) {
+				mockRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, preferences []*model.NotificationPreference) error {
+						for _, p := range preferences {
+							assert.Equal(t, dispatchUserID, p.UserID)
+							assert.Equal(t, model.EmailFrequencyImmediate, p.EmailFrequency)
+						}
+						return nil
+					})
+				mockRepo.EXPECT().FindByUserID(gomock.Any(), dispatchUserID).Return(nil, nil)
+			},
+		},
+		{
+			name: "Invalid Values",
+			preferences: []*model.NotificationPreference{
+				{NotificationType: "イベント"},
+				{NotificationType: model.NotificationTypeSystem, EmailFrequency: "weekly"},
+				{NotificationType: model.NotificationTypeSystem, Webhook: true, WebhookURL: &invalidURL},
+			},
+			mockSetup:    func(mockRepo *mockdomain.MockNotificationPreferenceRepository) {},
+			expectedCode: myerrors.ValidationError,
+			expectedFields: []string{
+				"preferences[0].notification_type",
+				"preferences[1].email_frequency",
+				"preferences[2].notification_type",
+				"preferences[2].webhook_url",
+			},
+		},
+		{
+			name: "Repository Error",
+			preferences: []*model.NotificationPreference{
+				{NotificationType: model.NotificationTypeDisaster, InApp: true},
+			},
+			mockSetup: func(mockRepo *mockdomain.MockNotificationPreferenceRepository) {
+				mockRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockRepo)
+
+			preferences, err := useCase.UpdatePreferences(ctx, dispatchUserID, tt.preferences)
+
+			switch {
+			case tt.expectedCode != "":
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+
+					fields := make([]string, 0, len(apiErr.Fields))
+					for _, f := range apiErr.Fields {
+						fields = append(fields, f.Field)
+					}
+					assert.Equal(t, tt.expectedFields, fields)
+				}
+			case tt.expectedError:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+				assert.Len(t, preferences, len(model.NotificationTypes))
+			}
+		})
+	}
+}
@@ -3,34 +3,92 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"strconv"
+
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 )
 
+var prefectureCodePattern = regexp.MustCompile(`^[0-9]{2}$`)
+
 type NotificationUseCase interface {
 	ListNotifications(ctx context.Context) ([]*model.Notification, error)
-	GetNotificationByID(ctx context.Context, id int32) (*model.Notification, error)
-	GetNotificationsByUserID(ctx context.Context, userID int32) ([]*model.Notification, error)
-	CreateNotification(ctx context.Context, notification *model.Notification) error
+	// GetNotificationByID returns the notification of the user. A notification of another user is reported as not found
+	// so that its existence is not revealed.
+	GetNotificationByID(ctx context.Context, userID string, id int32) (*model.Notification, error)
+	GetNotificationsByUserID(ctx context.Context, userID string) ([]*model.Notification, error)
+	// CreateNotification validates the related entity and delivers the notification through the dispatcher
+	// according to the recipient's preferences
+	CreateNotification(ctx context.Context, notification *model.Notification) (*model.NotificationDelivery, error)
 	UpdateNotification(ctx context.Context, notification *model.Notification) error
 	DeleteNotification(ctx context.Context, id int32) error
 	MarkAsRead(ctx context.Context, id int32) error
+	// MarkAllAsRead marks the user's unread notifications matching the filter as read and returns how many were updated
+	MarkAllAsRead(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error)
+	// DeleteNotifications deletes the user's notifications matching the filter. At least one condition is required;
+	// deleting every notification needs filter.All.
+	DeleteNotifications(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error)
+	// CountUnread returns the user's unread notification counts for every notification type, including zeros
+	CountUnread(ctx context.Context, userID string) (map[string]int64, error)
+	// ListNotificationsAfter returns the user's notifications whose ID is greater than afterID, oldest first
+	ListNotificationsAfter(ctx context.Context, userID string, afterID int32) ([]*model.Notification, error)
+	// LatestNotificationID returns the newest notification ID of the user, or 0 if there are none
+	LatestNotificationID(ctx context.Context, userID string) (int32, error)
+	// SubscribeNotifications returns a channel signalled when notifications may have been created for the user.
+	// The returned function must be called to unsubscribe.
+	SubscribeNotifications(userID string) (<-chan struct{}, func())
+	// BroadcastNotification stores the broadcast once and queues a job that gives every active user in the target
+	// their own read state. RecipientCount is set to the number of users in the target at that time, which is only an
+	// estimate of the recipients until ExpandBroadcast has run.
+	BroadcastNotification(ctx context.Context, broadcast *model.BroadcastNotification) error
+	// ExpandBroadcast creates the read states of the recipients of a broadcast queued by BroadcastNotification.
+	// It runs as a background job.
+	ExpandBroadcast(ctx context.Context, payload model.BroadcastNotificationPayload) error
+	// ResolveRelatedEntities resolves the registered related entities of the notifications, keyed by reference.
+	// Notifications without a related entity or with an unregistered type have no entry.
+	ResolveRelatedEntities(ctx context.Context, notifications []*model.Notification) (map[model.RelatedEntityRef]*model.RelatedEntityLink, error)
 }
 
 type notificationUseCase struct {
 	notificationRepository domain.NotificationRepository
+	notificationStream     domain.NotificationStream
+	notificationDispatcher NotificationDispatcher
+
+	broadcastNotificationRepository domain.BroadcastNotificationRepository
+	relatedEntityRepository         domain.RelatedEntityRepository
+	jobRepository                   domain.JobRepository
+	transactor                      domain.Transactor
 }
 
 func NewNotificationUseCase(
 	notificationRepository domain.NotificationRepository,
+	notificationStream domain.NotificationStream,
+	notificationDispatcher NotificationDispatcher,
+	broadcastNotificationRepository domain.BroadcastNotificationRepository,
+	relatedEntityRepository domain.RelatedEntityRepository,
+	jobRepository domain.JobRepository,
+	transactor domain.Transactor,
 ) NotificationUseCase {
 	return &notificationUseCase{
-		notificationRepository: notificationRepository,
+		notificationRepository:          notificationRepository,
+		notificationStream:              notificationStream,
+		notificationDispatcher:          notificationDispatcher,
+		broadcastNotificationRepository: broadcastNotificationRepository,
+		relatedEntityRepository:         relatedEntityRepository,
+		jobRepository:                   jobRepository,
+		transactor:                      transactor,
 	}
 }
 
 func (u *notificationUseCase) ListNotifications(ctx context.Context) ([]*model.Notification, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.ListNotifications")
+	defer span.End()
+
 	notifications, err := u.notificationRepository.Find(ctx)
 	if err != nil {
 		return nil, err
@@ -39,16 +97,25 @@ func (u *notificationUseCase) ListNotifications(ctx context.Context) ([]*model.N
 	return notifications, nil
 }
 
-func (u *notificationUseCase) GetNotificationByID(ctx context.Context, id int32) (*model.Notification, error) {
+func (u *notificationUseCase) GetNotificationByID(ctx context.Context, userID string, id int32) (*model.Notification, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.GetNotificationByID")
+	defer span.End()
+
 	notification, err := u.notificationRepository.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if notification.UserID != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
 
 	return notification, nil
 }
 
-func (u *notificationUseCase) GetNotificationsByUserID(ctx context.Context, userID int32) ([]*model.Notification, error) {
+func (u *notificationUseCase) GetNotificationsByUserID(ctx context.Context, userID string) ([]*model.Notification, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.GetNotificationsByUserID")
+	defer span.End()
+
 	notifications, err := u.notificationRepository.FindByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -57,18 +124,259 @@ func (u *notificationUseCase) GetNotificationsByUserID(ctx context.Context, user
 	return notifications, nil
 }
 
-func (u *notificationUseCase) CreateNotification(ctx context.Context, notification *model.Notification) error {
-	return u.notificationRepository.Create(ctx, notification)
+func (u *notificationUseCase) CreateNotification(ctx context.Context, notification *model.Notification) (*model.NotificationDelivery, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.CreateNotification")
+	defer span.End()
+
+	if err := u.validateRelatedEntity(ctx, notification.RelatedEntityType, notification.RelatedEntityID); err != nil {
+		return nil, err
+	}
+
+	return u.notificationDispatcher.Dispatch(ctx, notification)
 }
 
 func (u *notificationUseCase) UpdateNotification(ctx context.Context, notification *model.Notification) error {
+	ctx, span := startSpan(ctx, "NotificationUseCase.UpdateNotification")
+	defer span.End()
+
+	if err := u.validateRelatedEntity(ctx, notification.RelatedEntityType, notification.RelatedEntityID); err != nil {
+		return err
+	}
+
 	return u.notificationRepository.Update(ctx, notification)
 }
 
 func (u *notificationUseCase) DeleteNotification(ctx context.Context, id int32) error {
+	ctx, span := startSpan(ctx, "NotificationUseCase.DeleteNotification")
+	defer span.End()
+
 	return u.notificationRepository.Delete(ctx, id)
 }
 
 func (u *notificationUseCase) MarkAsRead(ctx context.Context, id int32) error {
+	ctx, span := startSpan(ctx, "NotificationUseCase.MarkAsRead")
+	defer span.End()
+
 	return u.notificationRepository.MarkAsRead(ctx, id)
 }
+
+func (u *notificationUseCase) MarkAllAsRead(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.MarkAllAsRead")
+	defer span.End()
+
+	if err := validateNotificationFilter(filter); err != nil {
+		return 0, err
+	}
+
+	return u.notificationRepository.MarkAllAsReadByUserID(ctx, userID, filter)
+}
+
+func (u *notificationUseCase) DeleteNotifications(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.DeleteNotifications")
+	defer span.End()
+
+	if filter == nil || (filter.IsEmpty() && !filter.All) {
+		// 条件の指定漏れで全件削除されないようにする
+		return 0, myerrors.NewValidationError(
+			myerrors.NewFieldError("ids", myerrors.FieldAnyOfRequired, "ids, notification_type, before, all"),
+		)
+	}
+
+	if err := validateNotificationFilter(filter); err != nil {
+		return 0, err
+	}
+
+	return u.notificationRepository.DeleteByUserID(ctx, userID, filter)
+}
+
+func (u *notificationUseCase) CountUnread(ctx context.Context, userID string) (map[string]int64, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.CountUnread")
+	defer span.End()
+
+	counts, err := u.notificationRepository.CountUnreadByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int64, len(model.NotificationTypes))
+	for _, notificationType := range model.NotificationTypes {
+		result[notificationType] = 0
+	}
+	for notificationType, count := range counts {
+		result[notificationType] = count
+	}
+
+	return result, nil
+}
+
+func validateNotificationFilter(filter *model.NotificationFilter) error {
+	if filter == nil || filter.NotificationType == nil || model.IsValidNotificationType(*filter.NotificationType) {
+		return nil
+	}
+
+	return myerrors.NewValidationError(
+		myerrors.NewFieldError("notification_type", myerrors.FieldOneOf, "システム, 災害情報, 査定, 申請, リマインダー, その他"),
+	)
+}
+
+func (u *notificationUseCase) ListNotificationsAfter(ctx context.Context, userID string, afterID int32) ([]*model.Notification, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.ListNotificationsAfter")
+	defer span.End()
+
+	return u.notificationRepository.FindByUserIDAfter(ctx, userID, afterID)
+}
+
+func (u *notificationUseCase) LatestNotificationID(ctx context.Context, userID string) (int32, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.LatestNotificationID")
+	defer span.End()
+
+	return u.notificationRepository.FindLatestIDByUserID(ctx, userID)
+}
+
+func (u *notificationUseCase) SubscribeNotifications(userID string) (<-chan struct{}, func()) {
+	return u.notificationStream.Subscribe(userID)
+}
+
+func (u *notificationUseCase) BroadcastNotification(ctx context.Context, broadcast *model.BroadcastNotification) error {
+	ctx, span := startSpan(ctx, "NotificationUseCase.BroadcastNotification")
+	defer span.End()
+
+	if err := validateBroadcastNotification(broadcast); err != nil {
+		return err
+	}
+
+	if err := u.validateRelatedEntity(ctx, broadcast.RelatedEntityType, broadcast.RelatedEntityID); err != nil {
+		return err
+	}
+
+	if broadcast.TargetType == model.BroadcastTargetAll {
+		broadcast.TargetID = nil
+	}
+
+	// 受信者の展開は対象のユーザー数に比例して時間がかかるため、ジョブで行う
+	err := u.transactor.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.broadcastNotificationRepository.Create(ctx, broadcast); err != nil {
+			return err
+		}
+
+		job, err := model.NewJob(model.JobTypeBroadcastNotification, &model.BroadcastNotificationPayload{BroadcastID: broadcast.ID})
+		if err != nil {
+			return err
+		}
+
+		return u.jobRepository.Enqueue(ctx, job)
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return myerrors.NewValidationError(myerrors.NewFieldError("target_id", myerrors.FieldReferenceNotFound))
+	}
+
+	return err
+}
+
+func (u *notificationUseCase) ExpandBroadcast(ctx context.Context, payload model.BroadcastNotificationPayload) error {
+	ctx, span := startSpan(ctx, "NotificationUseCase.ExpandBroadcast")
+	defer span.End()
+
+	err := u.broadcastNotificationRepository.ExpandRecipients(ctx, payload.BroadcastID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// キューに入った後で一斉通知か配信対象が削除された
+		return nil
+	}
+
+	return err
+}
+
+func (u *notificationUseCase) ResolveRelatedEntities(
+	ctx context.Context,
+	notifications []*model.Notification,
+) (map[model.RelatedEntityRef]*model.RelatedEntityLink, error) {
+	ctx, span := startSpan(ctx, "NotificationUseCase.ResolveRelatedEntities")
+	defer span.End()
+
+	var refs []model.RelatedEntityRef
+	for _, notification := range notifications {
+		if ref := model.NewRelatedEntityRef(notification.RelatedEntityType, notification.RelatedEntityID); ref != nil {
+			refs = append(refs, *ref)
+		}
+	}
+
+	if len(refs) == 0 {
+		return map[model.RelatedEntityRef]*model.RelatedEntityLink{}, nil
+	}
+
+	return u.relatedEntityRepository.Resolve(ctx, refs)
+}
+
+// validateRelatedEntity requires the related entity, when given, to be a registered type and an existing record
+func (u *notificationUseCase) validateRelatedEntity(ctx context.Context, entityType, id *string) error {
+	switch {
+	case entityType == nil && id == nil:
+		return nil
+	case entityType == nil:
+		return myerrors.NewValidationError(myerrors.NewFieldError("related_entity_type", myerrors.FieldRequiredWith, "related_entity_id"))
+	case id == nil:
+		return myerrors.NewValidationError(myerrors.NewFieldError("related_entity_id", myerrors.FieldRequiredWith, "related_entity_type"))
+	}
+
+	ref := model.NewRelatedEntityRef(entityType, id)
+	if ref == nil {
+		return myerrors.NewValidationError(myerrors.NewFieldError("related_entity_type", myerrors.FieldOneOf, "災害, 査定, 支援申請"))
+	}
+
+	links, err := u.relatedEntityRepository.Resolve(ctx, []model.RelatedEntityRef{*ref})
+	if err != nil {
+		return err
+	}
+
+	if link, ok := links[*ref]; !ok || link.Deleted {
+		return myerrors.NewValidationError(myerrors.NewFieldError("related_entity_id", myerrors.FieldReferenceNotFound))
+	}
+
+	return nil
+}
+
+func validateBroadcastNotification(broadcast *model.BroadcastNotification) error {
+	var fieldErrors []myerrors.FieldError
+
+	if !model.IsValidNotificationType(broadcast.NotificationType) {
+		fieldErrors = append(fieldErrors, myerrors.NewFieldError(
+			"notification_type",
+			myerrors.FieldOneOf,
+			"システム, 災害情報, 査定, 申請, リマインダー, その他",
+		))
+	}
+
+	targetID := ""
+	if broadcast.TargetID != nil {
+		targetID = *broadcast.TargetID
+	}
+
+	switch broadcast.TargetType {
+	case model.BroadcastTargetAll:
+	case model.BroadcastTargetRole:
+		// users.role_id は smallint
+		if _, err := strconv.ParseUint(targetID, 10, 15); err != nil {
+			fieldErrors = append(fieldErrors, myerrors.NewFieldError("target_id", myerrors.FieldNumeric))
+		}
+	case model.BroadcastTargetOrganization, model.BroadcastTargetRegion:
+		if _, err := strconv.ParseUint(targetID, 10, 31); err != nil {
+			fieldErrors = append(fieldErrors, myerrors.NewFieldError("target_id", myerrors.FieldNumeric))
+		}
+	case model.BroadcastTargetPrefecture:
+		if !prefectureCodePattern.MatchString(targetID) {
+			fieldErrors = append(fieldErrors, myerrors.NewFieldError("target_id", myerrors.FieldPrefectureCode))
+		}
+	default:
+		fieldErrors = append(fieldErrors, myerrors.NewFieldError(
+			"target_type",
+			myerrors.FieldOneOf,
+			"all, role, organization, prefecture, region",
+		))
+	}
+
+	if len(fieldErrors) > 0 {
+		return myerrors.NewValidationError(fieldErrors...)
+	}
+
+	return nil
+}
@@ -8,34 +8,63 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
-	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
 )
 
-func setupNotificationTest(t *testing.T) (*mockdatastore.MockNotificationRepository, usecase.NotificationUseCase) {
+type notificationTestMocks struct {
+	notificationRepo       *mockdomain.MockNotificationRepository
+	notificationStream     *mockdomain.MockNotificationStream
+	notificationDispatcher *mockusecase.MockNotificationDispatcher
+	broadcastRepo          *mockdomain.MockBroadcastNotificationRepository
+	relatedEntityRepo      *mockdomain.MockRelatedEntityRepository
+	jobRepo                *mockdomain.MockJobRepository
+	transactor             *mockdomain.MockTransactor
+}
+
+func setupNotificationTest(t *testing.T) (*notificationTestMocks, usecase.NotificationUseCase) {
 	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockNotificationRepository(ctrl)
-	useCase := usecase.NewNotificationUseCase(mockRepo)
-	return mockRepo, useCase
+	mocks := &notificationTestMocks{
+		notificationRepo:       mockdomain.NewMockNotificationRepository(ctrl),
+		notificationStream:     mockdomain.NewMockNotificationStream(ctrl),
+		notificationDispatcher: mockusecase.NewMockNotificationDispatcher(ctrl),
+		broadcastRepo:          mockdomain.NewMockBroadcastNotificationRepository(ctrl),
+		relatedEntityRepo:      mockdomain.NewMockRelatedEntityRepository(ctrl),
+		jobRepo:                mockdomain.NewMockJobRepository(ctrl),
+		transactor:             mockdomain.NewMockTransactor(ctrl),
+	}
+	useCase := usecase.NewNotificationUseCase(
+		mocks.notificationRepo,
+		mocks.notificationStream,
+		mocks.notificationDispatcher,
+		mocks.broadcastRepo,
+		mocks.relatedEntityRepo,
+		mocks.jobRepo,
+		mocks.transactor,
+	)
+	return mocks, useCase
 }
 
 func TestNotificationUseCase_ListNotifications(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupNotificationTest(t)
+	mocks, useCase := setupNotificationTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
 		name          string
-		mockSetup     func(mockRepo *mockdatastore.MockNotificationRepository)
+		mockSetup     func(mockRepo *mockdomain.MockNotificationRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name: "Success",
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				now := time.Now()
 				relatedEntityType1 := "disaster"
 				relatedEntityID1 := "1"
@@ -47,7 +76,7 @@ func TestNotificationUseCase_ListNotifications(t *testing.T) {
 				notifications := []*model.Notification{
 					{
 						ID:                1,
-						UserID:            1,
+						UserID:            "1",
 						Title:             "災害情報更新",
 						Message:           "東京地震の状況が更新されました。",
 						NotificationType:  "disaster_update",
@@ -60,7 +89,7 @@ func TestNotificationUseCase_ListNotifications(t *testing.T) {
 					},
 					{
 						ID:                2,
-						UserID:            1,
+						UserID:            "1",
 						Title:             "支援申請受付",
 						Message:           "あなたの支援申請が受け付けられました。",
 						NotificationType:  "application_received",
@@ -79,7 +108,7 @@ func TestNotificationUseCase_ListNotifications(t *testing.T) {
 		},
 		{
 			name: "Error",
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				mockRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
@@ -91,7 +120,7 @@ func TestNotificationUseCase_ListNotifications(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks.notificationRepo)
 
 			// Call the method
 			notifications, err := useCase.ListNotifications(ctx)
@@ -111,20 +140,20 @@ func TestNotificationUseCase_ListNotifications(t *testing.T) {
 
 func TestNotificationUseCase_GetNotificationByID(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupNotificationTest(t)
+	mocks, useCase := setupNotificationTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
 		name          string
 		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockNotificationRepository)
+		mockSetup     func(mockRepo *mockdomain.MockNotificationRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				now := time.Now()
 				relatedEntityType := "disaster"
 				relatedEntityID := "1"
@@ -132,7 +161,7 @@ func TestNotificationUseCase_GetNotificationByID(t *testing.T) {
 
 				notification := &model.Notification{
 					ID:                1,
-					UserID:            1,
+					UserID:            "1",
 					Title:             "災害情報更新",
 					Message:           "東京地震の状況が更新されました。",
 					NotificationType:  "disaster_update",
@@ -147,10 +176,18 @@ func TestNotificationUseCase_GetNotificationByID(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "Other User's Notification",
+			id:   2,
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), int32(2)).Return(&model.Notification{ID: 2, UserID: "2"}, nil)
+			},
+			expectedError: true,
+		},
 		{
 			name: "Not Found",
 			id:   999,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				mockRepo.EXPECT().FindByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
 			},
 			expectedError: true,
@@ -161,10 +198,10 @@ func TestNotificationUseCase_GetNotificationByID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks.notificationRepo)
 
 			// Call the method
-			notification, err := useCase.GetNotificationByID(ctx, tt.id)
+			notification, err := useCase.GetNotificationByID(ctx, "1", tt.id)
 
 			// Check results
 			if tt.expectedError {
@@ -181,21 +218,21 @@ func TestNotificationUseCase_GetNotificationByID(t *testing.T) {
 
 func TestNotificationUseCase_GetNotificationsByUserID(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupNotificationTest(t)
+	mocks, useCase := setupNotificationTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
 		name          string
-		userID        int32
-		mockSetup     func(mockRepo *mockdatastore.MockNotificationRepository)
+		userID        string
+		mockSetup     func(mockRepo *mockdomain.MockNotificationRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name:   "Success",
-			userID: 1,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			userID: "1",
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				now := time.Now()
 				relatedEntityType1 := "disaster"
 				relatedEntityID1 := "1"
@@ -207,7 +244,7 @@ func TestNotificationUseCase_GetNotificationsByUserID(t *testing.T) {
 				notifications := []*model.Notification{
 					{
 						ID:                1,
-						UserID:            1,
+						UserID:            "1",
 						Title:             "災害情報更新",
 						Message:           "東京地震の状況が更新されました。",
 						NotificationType:  "disaster_update",
@@ -220,7 +257,7 @@ func TestNotificationUseCase_GetNotificationsByUserID(t *testing.T) {
 					},
 					{
 						ID:                2,
-						UserID:            1,
+						UserID:            "1",
 						Title:             "支援申請受付",
 						Message:           "あなたの支援申請が受け付けられました。",
 						NotificationType:  "application_received",
@@ -232,25 +269,25 @@ func TestNotificationUseCase_GetNotificationsByUserID(t *testing.T) {
 						UpdatedAt:         now.Add(-30 * time.Minute),
 					},
 				}
-				mockRepo.EXPECT().FindByUserID(gomock.Any(), int32(1)).Return(notifications, nil)
+				mockRepo.EXPECT().FindByUserID(gomock.Any(), "1").Return(notifications, nil)
 			},
 			expectedError: false,
 			expectedLen:   2,
 		},
 		{
 			name:   "No Notifications",
-			userID: 2,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
-				mockRepo.EXPECT().FindByUserID(gomock.Any(), int32(2)).Return([]*model.Notification{}, nil)
+			userID: "2",
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().FindByUserID(gomock.Any(), "2").Return([]*model.Notification{}, nil)
 			},
 			expectedError: false,
 			expectedLen:   0,
 		},
 		{
 			name:   "Error",
-			userID: 1,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
-				mockRepo.EXPECT().FindByUserID(gomock.Any(), int32(1)).Return(nil, errors.New("database error"))
+			userID: "1",
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().FindByUserID(gomock.Any(), "1").Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
 			expectedLen:   0,
@@ -261,7 +298,7 @@ func TestNotificationUseCase_GetNotificationsByUserID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks.notificationRepo)
 
 			// Call the method
 			notifications, err := useCase.GetNotificationsByUserID(ctx, tt.userID)
@@ -283,50 +320,124 @@ func TestNotificationUseCase_GetNotificationsByUserID(t *testing.T) {
 
 func TestNotificationUseCase_CreateNotification(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupNotificationTest(t)
+	mocks, useCase := setupNotificationTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
-		name          string
-		notification  *model.Notification
-		mockSetup     func(mockRepo *mockdatastore.MockNotificationRepository)
-		expectedError bool
+		name             string
+		notification     *model.Notification
+		mockSetup        func(mocks *notificationTestMocks)
+		expectedFields   []string
+		expectedError    bool
+		expectedDelivery *model.NotificationDelivery
 	}{
 		{
 			name: "Success",
 			notification: func() *model.Notification {
-				relatedEntityType := "facility_equipment"
-				relatedEntityID := "3"
+				relatedEntityType := "災害"
+				relatedEntityID := "6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"
 
 				return &model.Notification{
-					UserID:            2,
+					UserID:            "2",
 					Title:             "設備点検通知",
 					Message:           "設備の定期点検が予定されています。",
-					NotificationType:  "maintenance_scheduled",
+					NotificationType:  "システム",
 					RelatedEntityType: &relatedEntityType,
 					RelatedEntityID:   &relatedEntityID,
 					IsRead:            false,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
-				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mockSetup: func(mocks *notificationTestMocks) {
+				ref := model.RelatedEntityRef{Type: model.RelatedEntityDisaster, ID: "6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"}
+				mocks.relatedEntityRepo.EXPECT().Resolve(gomock.Any(), []model.RelatedEntityRef{ref}).
+					Return(map[model.RelatedEntityRef]*model.RelatedEntityLink{
+						ref: {Type: ref.Type, ID: ref.ID, Path: "/disasters/" + ref.ID, Label: "2025年青森県豪雨", Status: "対応中"},
+					}, nil)
+				mocks.notificationDispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(&model.NotificationDelivery{
+					InApp:   model.DeliveryStatusSent,
+					Email:   model.DeliveryStatusQueued,
+					Webhook: model.DeliveryStatusDisabled,
+				}, nil)
 			},
 			expectedError: false,
+			expectedDelivery: &model.NotificationDelivery{
+				InApp:   model.DeliveryStatusSent,
+				Email:   model.DeliveryStatusQueued,
+				Webhook: model.DeliveryStatusDisabled,
+			},
+		},
+		{
+			name: "Unregistered Related Entity Type",
+			notification: func() *model.Notification {
+				relatedEntityType := "イベント"
+				relatedEntityID := "training-2025-06"
+
+				return &model.Notification{
+					UserID:            "2",
+					Title:             "研修会のお知らせ",
+					Message:           "次回の査定員研修会を開催します。",
+					NotificationType:  "その他",
+					RelatedEntityType: &relatedEntityType,
+					RelatedEntityID:   &relatedEntityID,
+				}
+			}(),
+			mockSetup:      func(mocks *notificationTestMocks) {},
+			expectedFields: []string{"related_entity_type"},
+		},
+		{
+			name: "Deleted Related Entity",
+			notification: func() *model.Notification {
+				relatedEntityType := "支援申請"
+				relatedEntityID := "A999"
+
+				return &model.Notification{
+					UserID:            "2",
+					Title:             "申請ステータス更新",
+					Message:           "支援申請のステータスが更新されました。",
+					NotificationType:  "申請",
+					RelatedEntityType: &relatedEntityType,
+					RelatedEntityID:   &relatedEntityID,
+				}
+			}(),
+			mockSetup: func(mocks *notificationTestMocks) {
+				ref := model.RelatedEntityRef{Type: model.RelatedEntitySupportApplication, ID: "A999"}
+				mocks.relatedEntityRepo.EXPECT().Resolve(gomock.Any(), gomock.Any()).
+					Return(map[model.RelatedEntityRef]*model.RelatedEntityLink{
+						ref: {Type: ref.Type, ID: ref.ID, Deleted: true},
+					}, nil)
+			},
+			expectedFields: []string{"related_entity_id"},
+		},
+		{
+			name: "Missing Related Entity ID",
+			notification: func() *model.Notification {
+				relatedEntityType := "査定"
+
+				return &model.Notification{
+					UserID:            "2",
+					Title:             "査定が割り当てられました",
+					Message:           "査定担当者として割り当てられました。",
+					NotificationType:  "査定",
+					RelatedEntityType: &relatedEntityType,
+				}
+			}(),
+			mockSetup:      func(mocks *notificationTestMocks) {},
+			expectedFields: []string{"related_entity_id"},
 		},
 		{
 			name: "Error",
 			notification: func() *model.Notification {
 				return &model.Notification{
-					UserID:           2,
+					UserID:           "2",
 					Title:            "設備点検通知",
 					Message:          "設備の定期点検が予定されています。",
-					NotificationType: "maintenance_scheduled",
+					NotificationType: "システム",
 					IsRead:           false,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
-				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			mockSetup: func(mocks *notificationTestMocks) {
+				mocks.notificationDispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
 		},
@@ -336,16 +447,24 @@ func TestNotificationUseCase_CreateNotification(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks)
 
 			// Call the method
-			err := useCase.CreateNotification(ctx, tt.notification)
+			delivery, err := useCase.CreateNotification(ctx, tt.notification)
 
 			// Check results
-			if tt.expectedError {
+			switch {
+			case tt.expectedFields != nil:
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, myerrors.ValidationError, apiErr.Code)
+					assert.Equal(t, tt.expectedFields[0], apiErr.Fields[0].Field)
+				}
+			case tt.expectedError:
 				assert.Error(t, err)
-			} else {
+			default:
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedDelivery, delivery)
 			}
 		})
 	}
@@ -353,25 +472,25 @@ func TestNotificationUseCase_CreateNotification(t *testing.T) {
 
 func TestNotificationUseCase_UpdateNotification(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupNotificationTest(t)
+	mocks, useCase := setupNotificationTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
 		name          string
 		notification  *model.Notification
-		mockSetup     func(mockRepo *mockdatastore.MockNotificationRepository)
+		mockSetup     func(mocks *notificationTestMocks)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			notification: func() *model.Notification {
-				relatedEntityType := "disaster"
+				relatedEntityType := "査定"
 				relatedEntityID := "1"
 
 				return &model.Notification{
 					ID:                1,
-					UserID:            1,
+					UserID:            "1",
 					Title:             "災害情報更新（修正）",
 					Message:           "東京地震の状況が更新されました。詳細をご確認ください。",
 					NotificationType:  "disaster_update",
@@ -380,8 +499,13 @@ func TestNotificationUseCase_UpdateNotification(t *testing.T) {
 					IsRead:            false,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			mockSetup: func(mocks *notificationTestMocks) {
+				ref := model.RelatedEntityRef{Type: model.RelatedEntityAssessment, ID: "1"}
+				mocks.relatedEntityRepo.EXPECT().Resolve(gomock.Any(), []model.RelatedEntityRef{ref}).
+					Return(map[model.RelatedEntityRef]*model.RelatedEntityLink{
+						ref: {Type: ref.Type, ID: ref.ID, Path: "/disasters/6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f", Label: "現地査定", Status: "進行中"},
+					}, nil)
+				mocks.notificationRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedError: false,
 		},
@@ -390,15 +514,15 @@ func TestNotificationUseCase_UpdateNotification(t *testing.T) {
 			notification: func() *model.Notification {
 				return &model.Notification{
 					ID:               1,
-					UserID:           1,
+					UserID:           "1",
 					Title:            "災害情報更新（修正）",
 					Message:          "東京地震の状況が更新されました。詳細をご確認ください。",
 					NotificationType: "disaster_update",
 					IsRead:           false,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			mockSetup: func(mocks *notificationTestMocks) {
+				mocks.notificationRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
 		},
@@ -408,7 +532,7 @@ func TestNotificationUseCase_UpdateNotification(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks)
 
 			// Call the method
 			err := useCase.UpdateNotification(ctx, tt.notification)
@@ -425,20 +549,20 @@ func TestNotificationUseCase_UpdateNotification(t *testing.T) {
 
 func TestNotificationUseCase_DeleteNotification(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupNotificationTest(t)
+	mocks, useCase := setupNotificationTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
 		name          string
 		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockNotificationRepository)
+		mockSetup     func(mockRepo *mockdomain.MockNotificationRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(nil)
 			},
 			expectedError: false,
@@ -446,7 +570,7 @@ func TestNotificationUseCase_DeleteNotification(t *testing.T) {
 		{
 			name: "Error",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(errors.New("database error"))
 			},
 			expectedError: true,
@@ -457,7 +581,7 @@ func TestNotificationUseCase_DeleteNotification(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks.notificationRepo)
 
 			// Call the method
 			err := useCase.DeleteNotification(ctx, tt.id)
@@ -474,20 +598,20 @@ func TestNotificationUseCase_DeleteNotification(t *testing.T) {
 
 func TestNotificationUseCase_MarkAsRead(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupNotificationTest(t)
+	mocks, useCase := setupNotificationTest(t)
 	ctx := context.Background()
 
 	// Test cases
 	tests := []struct {
 		name          string
 		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockNotificationRepository)
+		mockSetup     func(mockRepo *mockdomain.MockNotificationRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   2,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				mockRepo.EXPECT().MarkAsRead(gomock.Any(), int32(2)).Return(nil)
 			},
 			expectedError: false,
@@ -495,7 +619,7 @@ func TestNotificationUseCase_MarkAsRead(t *testing.T) {
 		{
 			name: "Error",
 			id:   2,
-			mockSetup: func(mockRepo *mockdatastore.MockNotificationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
 				mockRepo.EXPECT().MarkAsRead(gomock.Any(), int32(2)).Return(errors.New("database error"))
 			},
 			expectedError: true,
@@ -506,7 +630,7 @@ func TestNotificationUseCase_MarkAsRead(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mocks.notificationRepo)
 
 			// Call the method
 			err := useCase.MarkAsRead(ctx, tt.id)
@@ -520,3 +644,478 @@ func TestNotificationUseCase_MarkAsRead(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationUseCase_ListNotificationsAfter(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		userID        string
+		afterID       int32
+		mockSetup     func(mockRepo *mockdomain.MockNotificationRepository)
+		expectedError bool
+		expectedIDs   []int32
+	}{
+		{
+			name:    "Success",
+			userID:  "user-1",
+			afterID: 10,
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().FindByUserIDAfter(gomock.Any(), "user-1", int32(10)).Return([]*model.Notification{
+					{ID: 11, UserID: "user-1", Title: "査定完了"},
+					{ID: 12, UserID: "user-1", Title: "申請承認"},
+				}, nil)
+			},
+			expectedIDs: []int32{11, 12},
+		},
+		{
+			name:    "Error",
+			userID:  "user-1",
+			afterID: 10,
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().FindByUserIDAfter(gomock.Any(), "user-1", int32(10)).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks.notificationRepo)
+
+			notifications, err := useCase.ListNotificationsAfter(ctx, tt.userID, tt.afterID)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			ids := make([]int32, 0, len(notifications))
+			for _, n := range notifications {
+				ids = append(ids, n.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestNotificationUseCase_LatestNotificationID(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+	ctx := context.Background()
+
+	mocks.notificationRepo.EXPECT().FindLatestIDByUserID(gomock.Any(), "user-1").Return(int32(42), nil)
+
+	id, err := useCase.LatestNotificationID(ctx, "user-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(42), id)
+}
+
+func TestNotificationUseCase_SubscribeNotifications(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+
+	signals := make(chan struct{}, 1)
+	unsubscribed := false
+	mocks.notificationStream.EXPECT().Subscribe("user-1").Return((<-chan struct{})(signals), func() { unsubscribed = true })
+
+	ch, unsubscribe := useCase.SubscribeNotifications("user-1")
+	signals <- struct{}{}
+	unsubscribe()
+
+	_, ok := <-ch
+	assert.True(t, ok)
+	assert.True(t, unsubscribed)
+}
+
+func TestNotificationUseCase_BroadcastNotification(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+	ctx := context.Background()
+
+	targetID := func(id string) *string { return &id }
+
+	// Test cases
+	tests := []struct {
+		name              string
+		broadcast         *model.BroadcastNotification
+		mockSetup         func(m *notificationTestMocks)
+		expectedFields    []string
+		expectedError     bool
+		expectedRecipient int32
+	}{
+		{
+			name: "Success All Users",
+			broadcast: &model.BroadcastNotification{
+				Title: "メンテナンスのお知らせ", Message: "本日22時から停止します", NotificationType: model.NotificationTypeSystem,
+				TargetType: model.BroadcastTargetAll, TargetID: targetID("1"),
+			},
+			mockSetup: func(m *notificationTestMocks) {
+				expectTransaction(m.transactor)
+				m.broadcastRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, b *model.BroadcastNotification) error {
+						// 全ユーザー宛ての場合は対象IDを保存しない
+						assert.Nil(t, b.TargetID)
+						b.ID = 5
+						b.RecipientCount = 120
+						return nil
+					})
+				m.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, job *model.Job) error {
+						assert.Equal(t, model.JobTypeBroadcastNotification, job.JobType)
+						assert.JSONEq(t, `{"broadcast_id":5}`, job.Payload)
+						return nil
+					})
+			},
+			expectedRecipient: 120,
+		},
+		{
+			name: "Success Prefecture",
+			broadcast: &model.BroadcastNotification{
+				Title: "大雨警報", Message: "被害状況を報告してください", NotificationType: model.NotificationTypeDisaster,
+				TargetType: model.BroadcastTargetPrefecture, TargetID: targetID("13"),
+			},
+			mockSetup: func(m *notificationTestMocks) {
+				expectTransaction(m.transactor)
+				m.broadcastRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, b *model.BroadcastNotification) error {
+						b.RecipientCount = 8
+						return nil
+					})
+				m.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedRecipient: 8,
+		},
+		{
+			name: "Invalid Values",
+			broadcast: &model.BroadcastNotification{
+				Title: "お知らせ", Message: "本文", NotificationType: "イベント",
+				TargetType: model.BroadcastTargetPrefecture, TargetID: targetID("東京"),
+			},
+			mockSetup:      func(m *notificationTestMocks) {},
+			expectedFields: []string{"notification_type", "target_id"},
+		},
+		{
+			name: "Missing Target ID",
+			broadcast: &model.BroadcastNotification{
+				Title: "お知らせ", Message: "本文", NotificationType: model.NotificationTypeSystem,
+				TargetType: model.BroadcastTargetRole,
+			},
+			mockSetup:      func(m *notificationTestMocks) {},
+			expectedFields: []string{"target_id"},
+		},
+		{
+			name: "Role ID Out Of Range",
+			broadcast: &model.BroadcastNotification{
+				Title: "お知らせ", Message: "本文", NotificationType: model.NotificationTypeSystem,
+				TargetType: model.BroadcastTargetRole, TargetID: targetID("40000"),
+			},
+			mockSetup:      func(m *notificationTestMocks) {},
+			expectedFields: []string{"target_id"},
+		},
+		{
+			name: "Target Not Found",
+			broadcast: &model.BroadcastNotification{
+				Title: "お知らせ", Message: "本文", NotificationType: model.NotificationTypeSystem,
+				TargetType: model.BroadcastTargetOrganization, TargetID: targetID("999"),
+			},
+			mockSetup: func(m *notificationTestMocks) {
+				expectTransaction(m.transactor)
+				m.broadcastRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(gorm.ErrRecordNotFound)
+			},
+			expectedFields: []string{"target_id"},
+		},
+		{
+			name: "Repository Error",
+			broadcast: &model.BroadcastNotification{
+				Title: "お知らせ", Message: "本文", NotificationType: model.NotificationTypeSystem,
+				TargetType: model.BroadcastTargetRegion, TargetID: targetID("3"),
+			},
+			mockSetup: func(m *notificationTestMocks) {
+				expectTransaction(m.transactor)
+				m.broadcastRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "Enqueue Error",
+			broadcast: &model.BroadcastNotification{
+				Title: "お知らせ", Message: "本文", NotificationType: model.NotificationTypeSystem,
+				TargetType: model.BroadcastTargetAll,
+			},
+			mockSetup: func(m *notificationTestMocks) {
+				expectTransaction(m.transactor)
+				m.broadcastRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks)
+
+			err := useCase.BroadcastNotification(ctx, tt.broadcast)
+
+			switch {
+			case tt.expectedFields != nil:
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, myerrors.ValidationError, apiErr.Code)
+
+					fields := make([]string, 0, len(apiErr.Fields))
+					for _, f := range apiErr.Fields {
+						fields = append(fields, f.Field)
+					}
+					assert.Equal(t, tt.expectedFields, fields)
+				}
+			case tt.expectedError:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRecipient, tt.broadcast.RecipientCount)
+			}
+		})
+	}
+}
+
+func TestNotificationUseCase_ExpandBroadcast(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(m *notificationTestMocks)
+		expectedError bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(m *notificationTestMocks) {
+				m.broadcastRepo.EXPECT().ExpandRecipients(gomock.Any(), int32(5)).Return(nil)
+			},
+		},
+		{
+			// 削除された一斉通知は再試行しても展開できない
+			name: "Broadcast Deleted",
+			mockSetup: func(m *notificationTestMocks) {
+				m.broadcastRepo.EXPECT().ExpandRecipients(gomock.Any(), int32(5)).Return(gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name: "Repository Error",
+			mockSetup: func(m *notificationTestMocks) {
+				m.broadcastRepo.EXPECT().ExpandRecipients(gomock.Any(), int32(5)).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks)
+
+			err := useCase.ExpandBroadcast(ctx, model.BroadcastNotificationPayload{BroadcastID: 5})
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationUseCase_MarkAllAsRead(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+	ctx := context.Background()
+
+	notificationType := model.NotificationTypeAssessment
+	invalidType := "イベント"
+	before := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Test cases
+	tests := []struct {
+		name          string
+		filter        *model.NotificationFilter
+		mockSetup     func(mockRepo *mockdomain.MockNotificationRepository)
+		expectedCount int64
+		expectedCode  myerrors.ErrorCode
+		expectedError bool
+	}{
+		{
+			name:   "Success All",
+			filter: &model.NotificationFilter{},
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().MarkAllAsReadByUserID(gomock.Any(), dispatchUserID, &model.NotificationFilter{}).Return(int64(5), nil)
+			},
+			expectedCount: 5,
+		},
+		{
+			name:   "Success By Type And Before",
+			filter: &model.NotificationFilter{NotificationType: &notificationType, Before: &before},
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().MarkAllAsReadByUserID(gomock.Any(), dispatchUserID,
+					&model.NotificationFilter{NotificationType: &notificationType, Before: &before}).Return(int64(2), nil)
+			},
+			expectedCount: 2,
+		},
+		{
+			name:         "Invalid Type",
+			filter:       &model.NotificationFilter{NotificationType: &invalidType},
+			mockSetup:    func(mockRepo *mockdomain.MockNotificationRepository) {},
+			expectedCode: myerrors.ValidationError,
+		},
+		{
+			name:   "Repository Error",
+			filter: &model.NotificationFilter{},
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().MarkAllAsReadByUserID(gomock.Any(), dispatchUserID, gomock.Any()).Return(int64(0), errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks.notificationRepo)
+
+			count, err := useCase.MarkAllAsRead(ctx, dispatchUserID, tt.filter)
+
+			switch {
+			case tt.expectedCode != "":
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
+			case tt.expectedError:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestNotificationUseCase_DeleteNotifications(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		filter        *model.NotificationFilter
+		mockSetup     func(mockRepo *mockdomain.MockNotificationRepository)
+		expectedCount int64
+		expectedCode  myerrors.ErrorCode
+	}{
+		{
+			name:   "Success By IDs",
+			filter: &model.NotificationFilter{IDs: []int32{1, 2, 3}},
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().DeleteByUserID(gomock.Any(), dispatchUserID, &model.NotificationFilter{IDs: []int32{1, 2, 3}}).Return(int64(3), nil)
+			},
+			expectedCount: 3,
+		},
+		{
+			name:         "No Condition",
+			filter:       &model.NotificationFilter{},
+			mockSetup:    func(mockRepo *mockdomain.MockNotificationRepository) {},
+			expectedCode: myerrors.ValidationError,
+		},
+		{
+			name:   "All",
+			filter: &model.NotificationFilter{All: true},
+			mockSetup: func(mockRepo *mockdomain.MockNotificationRepository) {
+				mockRepo.EXPECT().DeleteByUserID(gomock.Any(), dispatchUserID, &model.NotificationFilter{All: true}).Return(int64(5), nil)
+			},
+			expectedCount: 5,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mocks.notificationRepo)
+
+			count, err := useCase.DeleteNotifications(ctx, dispatchUserID, tt.filter)
+
+			if tt.expectedCode != "" {
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCount, count)
+		})
+	}
+}
+
+func TestNotificationUseCase_CountUnread(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+	ctx := context.Background()
+
+	mocks.notificationRepo.EXPECT().CountUnreadByUserID(gomock.Any(), dispatchUserID).Return(map[string]int64{
+		model.NotificationTypeDisaster: 3,
+		model.NotificationTypeSystem:   1,
+	}, nil)
+
+	counts, err := useCase.CountUnread(ctx, dispatchUserID)
+
+	assert.NoError(t, err)
+	// 未読のない種別も0件として含める
+	assert.Len(t, counts, len(model.NotificationTypes))
+	assert.Equal(t, int64(3), counts[model.NotificationTypeDisaster])
+	assert.Equal(t, int64(1), counts[model.NotificationTypeSystem])
+	assert.Equal(t, int64(0), counts[model.NotificationTypeAssessment])
+}
+
+func TestNotificationUseCase_ResolveRelatedEntities(t *testing.T) {
+	// Setup
+	mocks, useCase := setupNotificationTest(t)
+	ctx := context.Background()
+
+	disasterType, disasterID := "災害", "6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"
+	eventType, eventID := "イベント", "training-2025-06"
+	ref := model.RelatedEntityRef{Type: model.RelatedEntityDisaster, ID: disasterID}
+
+	// 未登録の種別と関連エンティティのない通知は解決対象に含めない
+	mocks.relatedEntityRepo.EXPECT().Resolve(gomock.Any(), []model.RelatedEntityRef{ref}).
+		Return(map[model.RelatedEntityRef]*model.RelatedEntityLink{
+			ref: {Type: ref.Type, ID: ref.ID, Deleted: true},
+		}, nil)
+
+	links, err := useCase.ResolveRelatedEntities(ctx, []*model.Notification{
+		{ID: 1, RelatedEntityType: &disasterType, RelatedEntityID: &disasterID},
+		{ID: 2, RelatedEntityType: &eventType, RelatedEntityID: &eventID},
+		{ID: 3},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, links, 1)
+	assert.True(t, links[ref].Deleted)
+
+	// 解決対象がなければリポジトリを呼ばない
+	links, err = useCase.ResolveRelatedEntities(ctx, []*model.Notification{{ID: 3}})
+
+	assert.NoError(t, err)
+	assert.Empty(t, links)
+}
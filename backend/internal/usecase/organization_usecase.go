@@ -3,6 +3,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
@@ -12,7 +13,7 @@ type OrganizationUseCase interface {
 	ListOrganizations(ctx context.Context) ([]*model.Organization, error)
 	GetOrganizationByID(ctx context.Context, id int64) (*model.Organization, error)
 	CreateOrganization(ctx context.Context, organization *model.Organization) error
-	UpdateOrganization(ctx context.Context, organization *model.Organization) error
+	UpdateOrganization(ctx context.Context, organization *model.Organization, expectedUpdatedAt time.Time) (*model.Organization, error)
 	DeleteOrganization(ctx context.Context, id int64) error
 }
 
@@ -29,6 +30,9 @@ func NewOrganizationUseCase(
 }
 
 func (u *organizationUseCase) ListOrganizations(ctx context.Context) ([]*model.Organization, error) {
+	ctx, span := startSpan(ctx, "OrganizationUseCase.ListOrganizations")
+	defer span.End()
+
 	organizations, err := u.organizationRepository.Find(ctx)
 	if err != nil {
 		return nil, err
@@ -38,6 +42,9 @@ func (u *organizationUseCase) ListOrganizations(ctx context.Context) ([]*model.O
 }
 
 func (u *organizationUseCase) GetOrganizationByID(ctx context.Context, id int64) (*model.Organization, error) {
+	ctx, span := startSpan(ctx, "OrganizationUseCase.GetOrganizationByID")
+	defer span.End()
+
 	organization, err := u.organizationRepository.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -47,13 +54,36 @@ func (u *organizationUseCase) GetOrganizationByID(ctx context.Context, id int64)
 }
 
 func (u *organizationUseCase) CreateOrganization(ctx context.Context, organization *model.Organization) error {
+	ctx, span := startSpan(ctx, "OrganizationUseCase.CreateOrganization")
+	defer span.End()
+
 	return u.organizationRepository.Create(ctx, organization)
 }
 
-func (u *organizationUseCase) UpdateOrganization(ctx context.Context, organization *model.Organization) error {
-	return u.organizationRepository.Update(ctx, organization)
+// UpdateOrganization saves organization if it has not been modified since expectedUpdatedAt and returns the stored record
+func (u *organizationUseCase) UpdateOrganization(
+	ctx context.Context,
+	organization *model.Organization,
+	expectedUpdatedAt time.Time,
+) (*model.Organization, error) {
+	ctx, span := startSpan(ctx, "OrganizationUseCase.UpdateOrganization")
+	defer span.End()
+
+	if err := checkVersion(organization.UpdatedAt, expectedUpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if err := u.organizationRepository.Update(ctx, organization, expectedUpdatedAt); err != nil {
+		return nil, translateVersionConflict(err)
+	}
+
+	// updated_atはDB側の精度で保存されるため、ETag算出用に再取得する
+	return u.organizationRepository.FindByID(ctx, organization.ID)
 }
 
 func (u *organizationUseCase) DeleteOrganization(ctx context.Context, id int64) error {
+	ctx, span := startSpan(ctx, "OrganizationUseCase.DeleteOrganization")
+	defer span.End()
+
 	return u.organizationRepository.Delete(ctx, id)
 }
@@ -10,13 +10,15 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
-	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
 )
 
-func setupOrganizationTest(t *testing.T) (*mockdatastore.MockOrganizationRepository, usecase.OrganizationUseCase) {
+func setupOrganizationTest(t *testing.T) (*mockdomain.MockOrganizationRepository, usecase.OrganizationUseCase) {
 	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockOrganizationRepository(ctrl)
+	mockRepo := mockdomain.NewMockOrganizationRepository(ctrl)
 	useCase := usecase.NewOrganizationUseCase(mockRepo)
 	return mockRepo, useCase
 }
@@ -29,41 +31,33 @@ func TestOrganizationUseCase_ListOrganizations(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		mockSetup     func(mockRepo *mockdatastore.MockOrganizationRepository)
+		mockSetup     func(mockRepo *mockdomain.MockOrganizationRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name: "Success",
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
 				now := time.Now()
-				prefectureID1 := int32(13)
-				description1 := "東京都の行政組織"
 
-				prefectureID2 := int32(27)
-				description2 := "大阪府の行政組織"
 				parentID2 := int32(1)
 
 				organizations := []*model.Organization{
 					{
-						ID:           1,
-						Name:         "東京都庁",
-						Type:         "都道府県",
-						PrefectureID: &prefectureID1,
-						ParentID:     nil,
-						Description:  &description1,
-						CreatedAt:    now,
-						UpdatedAt:    now,
+						ID:        1,
+						Name:      "東京都庁",
+						Type:      "都道府県",
+						ParentID:  nil,
+						CreatedAt: now,
+						UpdatedAt: now,
 					},
 					{
-						ID:           2,
-						Name:         "大阪府庁",
-						Type:         "都道府県",
-						PrefectureID: &prefectureID2,
-						ParentID:     &parentID2,
-						Description:  &description2,
-						CreatedAt:    now,
-						UpdatedAt:    now,
+						ID:        2,
+						Name:      "大阪府庁",
+						Type:      "都道府県",
+						ParentID:  &parentID2,
+						CreatedAt: now,
+						UpdatedAt: now,
 					},
 				}
 				mockRepo.EXPECT().Find(gomock.Any()).Return(organizations, nil)
@@ -73,7 +67,7 @@ func TestOrganizationUseCase_ListOrganizations(t *testing.T) {
 		},
 		{
 			name: "Error",
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
 				mockRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
@@ -111,37 +105,33 @@ func TestOrganizationUseCase_GetOrganizationByID(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockOrganizationRepository)
+		id            int64
+		mockSetup     func(mockRepo *mockdomain.MockOrganizationRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
 				now := time.Now()
-				prefectureID := int32(13)
-				description := "東京都の行政組織"
 
 				organization := &model.Organization{
-					ID:           1,
-					Name:         "東京都庁",
-					Type:         "都道府県",
-					PrefectureID: &prefectureID,
-					ParentID:     nil,
-					Description:  &description,
-					CreatedAt:    now,
-					UpdatedAt:    now,
+					ID:        1,
+					Name:      "東京都庁",
+					Type:      "都道府県",
+					ParentID:  nil,
+					CreatedAt: now,
+					UpdatedAt: now,
 				}
-				mockRepo.EXPECT().FindByID(gomock.Any(), int32(1)).Return(organization, nil)
+				mockRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(organization, nil)
 			},
 			expectedError: false,
 		},
 		{
 			name: "Not Found",
 			id:   999,
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
-				mockRepo.EXPECT().FindByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), int64(999)).Return(nil, errors.New("not found"))
 			},
 			expectedError: true,
 		},
@@ -178,24 +168,20 @@ func TestOrganizationUseCase_CreateOrganization(t *testing.T) {
 	tests := []struct {
 		name          string
 		organization  *model.Organization
-		mockSetup     func(mockRepo *mockdatastore.MockOrganizationRepository)
+		mockSetup     func(mockRepo *mockdomain.MockOrganizationRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			organization: func() *model.Organization {
-				prefectureID := int32(14)
-				description := "神奈川県の行政組織"
 
 				return &model.Organization{
-					Name:         "神奈川県庁",
-					Type:         "都道府県",
-					PrefectureID: &prefectureID,
-					ParentID:     nil,
-					Description:  &description,
+					Name:     "神奈川県庁",
+					Type:     "都道府県",
+					ParentID: nil,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
 				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedError: false,
@@ -208,7 +194,7 @@ func TestOrganizationUseCase_CreateOrganization(t *testing.T) {
 					Type: "都道府県",
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
 				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
@@ -238,47 +224,69 @@ func TestOrganizationUseCase_UpdateOrganization(t *testing.T) {
 	// Setup
 	mockRepo, useCase := setupOrganizationTest(t)
 	ctx := context.Background()
+	version := time.Date(2024, 1, 1, 9, 0, 0, 123456000, time.UTC)
 
 	// Test cases
 	tests := []struct {
-		name          string
-		organization  *model.Organization
-		mockSetup     func(mockRepo *mockdatastore.MockOrganizationRepository)
-		expectedError bool
+		name              string
+		organization      *model.Organization
+		mockSetup         func(mockRepo *mockdomain.MockOrganizationRepository)
+		expectedUpdatedAt time.Time
+		expectedError     bool
+		expectedCode      myerrors.ErrorCode
 	}{
 		{
 			name: "Success",
 			organization: func() *model.Organization {
-				prefectureID := int32(13)
-				description := "東京都の行政組織（更新）"
 
 				return &model.Organization{
-					ID:           1,
-					Name:         "東京都庁（更新）",
-					Type:         "都道府県",
-					PrefectureID: &prefectureID,
-					ParentID:     nil,
-					Description:  &description,
+					UpdatedAt: version,
+					ID:        1,
+					Name:      "東京都庁（更新）",
+					Type:      "都道府県",
+					ParentID:  nil,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), version).Return(nil)
+				mockRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(&model.Organization{ID: 1, UpdatedAt: version.Add(time.Second)}, nil)
 			},
-			expectedError: false,
+			expectedUpdatedAt: version,
+			expectedError:     false,
 		},
 		{
 			name: "Error",
 			organization: func() *model.Organization {
 				return &model.Organization{
-					ID:   1,
-					Name: "東京都庁（更新）",
-					Type: "都道府県",
+					UpdatedAt: version,
+					ID:        1,
+					Name:      "東京都庁（更新）",
+					Type:      "都道府県",
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), version).Return(errors.New("database error"))
 			},
-			expectedError: true,
+			expectedUpdatedAt: version,
+			expectedError:     true,
+		},
+		{
+			name:              "Stale Version",
+			organization:      &model.Organization{ID: 1, Name: "東京都庁（更新）", Type: "都道府県", UpdatedAt: version.Add(time.Minute)},
+			mockSetup:         func(mockRepo *mockdomain.MockOrganizationRepository) {},
+			expectedUpdatedAt: version,
+			expectedError:     true,
+			expectedCode:      myerrors.PreconditionFailedError,
+		},
+		{
+			name:         "Concurrent Modification",
+			organization: &model.Organization{ID: 1, Name: "東京都庁（更新）", Type: "都道府県", UpdatedAt: version},
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), version).Return(domain.ErrVersionConflict)
+			},
+			expectedUpdatedAt: version,
+			expectedError:     true,
+			expectedCode:      myerrors.PreconditionFailedError,
 		},
 	}
 
@@ -289,13 +297,21 @@ func TestOrganizationUseCase_UpdateOrganization(t *testing.T) {
 			tt.mockSetup(mockRepo)
 
 			// Call the method
-			err := useCase.UpdateOrganization(ctx, tt.organization)
+			updated, err := useCase.UpdateOrganization(ctx, tt.organization, tt.expectedUpdatedAt)
 
 			// Check results
 			if tt.expectedError {
 				assert.Error(t, err)
+				assert.Nil(t, updated)
+
+				if tt.expectedCode != "" {
+					var apiErr *myerrors.APIError
+					assert.True(t, errors.As(err, &apiErr))
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, version.Add(time.Second), updated.UpdatedAt)
 			}
 		})
 	}
@@ -309,23 +325,23 @@ func TestOrganizationUseCase_DeleteOrganization(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockOrganizationRepository)
+		id            int64
+		mockSetup     func(mockRepo *mockdomain.MockOrganizationRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
-				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(nil)
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
+				mockRepo.EXPECT().Delete(gomock.Any(), int64(1)).Return(nil)
 			},
 			expectedError: false,
 		},
 		{
 			name: "Error",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockOrganizationRepository) {
-				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(errors.New("database error"))
+			mockSetup: func(mockRepo *mockdomain.MockOrganizationRepository) {
+				mockRepo.EXPECT().Delete(gomock.Any(), int64(1)).Return(errors.New("database error"))
 			},
 			expectedError: true,
 		},
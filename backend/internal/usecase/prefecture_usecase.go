@@ -26,6 +26,9 @@ func NewPrefectureUseCase(
 }
 
 func (u *prefectureUseCase) ListPrefectures(ctx context.Context) ([]*model.Prefecture, error) {
+	ctx, span := startSpan(ctx, "PrefectureUseCase.ListPrefectures")
+	defer span.End()
+
 	prefectures, err := u.prefectureRepository.Find(ctx)
 	if err != nil {
 		return nil, err
@@ -35,6 +38,9 @@ func (u *prefectureUseCase) ListPrefectures(ctx context.Context) ([]*model.Prefe
 }
 
 func (u *prefectureUseCase) GetPrefectureByID(ctx context.Context, code string) (*model.Prefecture, error) {
+	ctx, span := startSpan(ctx, "PrefectureUseCase.GetPrefectureByID")
+	defer span.End()
+
 	prefecture, err := u.prefectureRepository.FindByID(ctx, code)
 	if err != nil {
 		return nil, err
@@ -10,12 +10,12 @@ import (
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
-	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
 )
 
-func setupPrefectureTest(t *testing.T) (*mockdatastore.MockPrefectureRepository, usecase.PrefectureUseCase) {
+func setupPrefectureTest(t *testing.T) (*mockdomain.MockPrefectureRepository, usecase.PrefectureUseCase) {
 	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockPrefectureRepository(ctrl)
+	mockRepo := mockdomain.NewMockPrefectureRepository(ctrl)
 	useCase := usecase.NewPrefectureUseCase(mockRepo)
 	return mockRepo, useCase
 }
@@ -28,13 +28,13 @@ func TestPrefectureUseCase_ListPrefectures(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		mockSetup     func(mockRepo *mockdatastore.MockPrefectureRepository)
+		mockSetup     func(mockRepo *mockdomain.MockPrefectureRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name: "Success",
-			mockSetup: func(mockRepo *mockdatastore.MockPrefectureRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockPrefectureRepository) {
 				prefectures := []*model.Prefecture{
 					{
 						ID:   1,
@@ -56,7 +56,7 @@ func TestPrefectureUseCase_ListPrefectures(t *testing.T) {
 		},
 		{
 			name: "Error",
-			mockSetup: func(mockRepo *mockdatastore.MockPrefectureRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockPrefectureRepository) {
 				mockRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
@@ -95,13 +95,13 @@ func TestPrefectureUseCase_GetPrefectureByID(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            string
-		mockSetup     func(mockRepo *mockdatastore.MockPrefectureRepository)
+		mockSetup     func(mockRepo *mockdomain.MockPrefectureRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   "13",
-			mockSetup: func(mockRepo *mockdatastore.MockPrefectureRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockPrefectureRepository) {
 				prefecture := &model.Prefecture{
 					ID:   13,
 					Name: "東京都",
@@ -114,7 +114,7 @@ func TestPrefectureUseCase_GetPrefectureByID(t *testing.T) {
 		{
 			name: "Not Found",
 			id:   "999",
-			mockSetup: func(mockRepo *mockdatastore.MockPrefectureRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockPrefectureRepository) {
 				mockRepo.EXPECT().FindByID(gomock.Any(), "999").Return(nil, errors.New("not found"))
 			},
 			expectedError: true,
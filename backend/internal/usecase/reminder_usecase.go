@@ -0,0 +1,176 @@
+//go:generate mockgen -source=reminder_usecase.go -destination=../../tests/mock/usecase/reminder_usecase.mock.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+)
+
+const (
+	reminderAssessmentStatus  = "進行中"
+	reminderApplicationStatus = "書類確認中"
+
+	assessmentReminderTitle    = "進行中の査定のリマインダー"
+	assessmentReminderMessage  = "担当の査定（ID: %d）が%d日以上「進行中」のまま更新されていません。進捗を確認してください。"
+	applicationReminderTitle   = "書類確認中の支援申請のリマインダー"
+	applicationReminderMessage = "支援申請（ID: %s）は%d日以上「書類確認中」のままです。不足書類がないか確認してください。"
+)
+
+// ReminderUseCase notifies users about records that have been waiting on them for too long.
+// A record is reminded at most once per staleAfter window.
+type ReminderUseCase interface {
+	// RemindStaleAssessments reminds assessors of assessments left in 進行中 for staleAfter and returns the number of reminders sent
+	RemindStaleAssessments(ctx context.Context, now time.Time, staleAfter time.Duration) (int, error)
+	// RemindPendingDocumentApplications reminds applicants of applications left in 書類確認中 for staleAfter
+	// and returns the number of reminders sent
+	RemindPendingDocumentApplications(ctx context.Context, now time.Time, staleAfter time.Duration) (int, error)
+}
+
+type reminderUseCase struct {
+	assessmentRepository         domain.AssessmentRepository
+	supportApplicationRepository domain.SupportApplicationRepository
+	reminderLogRepository        domain.ReminderLogRepository
+	notificationDispatcher       NotificationDispatcher
+	transactor                   domain.Transactor
+}
+
+func NewReminderUseCase(
+	assessmentRepository domain.AssessmentRepository,
+	supportApplicationRepository domain.SupportApplicationRepository,
+	reminderLogRepository domain.ReminderLogRepository,
+	notificationDispatcher NotificationDispatcher,
+	transactor domain.Transactor,
+) ReminderUseCase {
+	return &reminderUseCase{
+		assessmentRepository:         assessmentRepository,
+		supportApplicationRepository: supportApplicationRepository,
+		reminderLogRepository:        reminderLogRepository,
+		notificationDispatcher:       notificationDispatcher,
+		transactor:                   transactor,
+	}
+}
+
+func (u *reminderUseCase) RemindStaleAssessments(ctx context.Context, now time.Time, staleAfter time.Duration) (int, error) {
+	ctx, span := startSpan(ctx, "ReminderUseCase.RemindStaleAssessments")
+	defer span.End()
+
+	since := now.Add(-staleAfter)
+	assessments, err := u.assessmentRepository.FindByStatusUpdatedBefore(ctx, reminderAssessmentStatus, since)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		sent int
+		errs []error
+	)
+	for _, assessment := range assessments {
+		ref := model.RelatedEntityRef{Type: model.RelatedEntityAssessment, ID: strconv.FormatInt(assessment.ID, 10)}
+		message := fmt.Sprintf(assessmentReminderMessage, assessment.ID, staleDays(staleAfter))
+
+		ok, err := u.remind(ctx, assessment.UserID, ref, assessmentReminderTitle, message, now, since)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("assessment %d: %w", assessment.ID, err))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+func (u *reminderUseCase) RemindPendingDocumentApplications(ctx context.Context, now time.Time, staleAfter time.Duration) (int, error) {
+	ctx, span := startSpan(ctx, "ReminderUseCase.RemindPendingDocumentApplications")
+	defer span.End()
+
+	since := now.Add(-staleAfter)
+	applications, err := u.supportApplicationRepository.FindByStatusUpdatedBefore(ctx, reminderApplicationStatus, since)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		sent int
+		errs []error
+	)
+	for _, application := range applications {
+		if application.ApplicantUserID == nil {
+			continue
+		}
+
+		ref := model.RelatedEntityRef{Type: model.RelatedEntitySupportApplication, ID: application.ApplicationID}
+		message := fmt.Sprintf(applicationReminderMessage, application.ApplicationID, staleDays(staleAfter))
+
+		ok, err := u.remind(ctx, *application.ApplicantUserID, ref, applicationReminderTitle, message, now, since)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("support application %s: %w", application.ApplicationID, err))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// remind dispatches the reminder unless the user was already reminded about the entity since since.
+// Sent reminders are recorded in the reminder log rather than looked up among the notifications, so users who turned
+// off in-app reminders are not reminded on every run. The dispatch and the log are written in one transaction.
+func (u *reminderUseCase) remind(
+	ctx context.Context,
+	userID string,
+	ref model.RelatedEntityRef,
+	title, message string,
+	now, since time.Time,
+) (bool, error) {
+	var reminded bool
+	err := u.transactor.Transaction(ctx, func(ctx context.Context) error {
+		exists, err := u.reminderLogRepository.ExistsSince(ctx, userID, ref, since)
+		if err != nil || exists {
+			return err
+		}
+
+		entityType := string(ref.Type)
+		entityID := ref.ID
+		if _, err := u.notificationDispatcher.Dispatch(ctx, &model.Notification{
+			UserID:            userID,
+			Title:             title,
+			Message:           message,
+			NotificationType:  model.NotificationTypeReminder,
+			RelatedEntityType: &entityType,
+			RelatedEntityID:   &entityID,
+		}); err != nil {
+			return err
+		}
+
+		if err := u.reminderLogRepository.Create(ctx, &model.ReminderLog{
+			UserID:            userID,
+			RelatedEntityType: entityType,
+			RelatedEntityID:   entityID,
+			RemindedAt:        now,
+		}); err != nil {
+			return err
+		}
+
+		reminded = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return reminded, nil
+}
+
+func staleDays(staleAfter time.Duration) int {
+	return int(staleAfter / (24 * time.Hour))
+}
@@ -0,0 +1,165 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
+)
+
+type reminderTestMocks struct {
+	assessmentRepo         *mockdomain.MockAssessmentRepository
+	supportApplicationRepo *mockdomain.MockSupportApplicationRepository
+	reminderLogRepo        *mockdomain.MockReminderLogRepository
+	dispatcher             *mockusecase.MockNotificationDispatcher
+	transactor             *mockdomain.MockTransactor
+}
+
+func setupReminderTest(t *testing.T) (*reminderTestMocks, usecase.ReminderUseCase) {
+	ctrl := gomock.NewController(t)
+	mocks := &reminderTestMocks{
+		assessmentRepo:         mockdomain.NewMockAssessmentRepository(ctrl),
+		supportApplicationRepo: mockdomain.NewMockSupportApplicationRepository(ctrl),
+		reminderLogRepo:        mockdomain.NewMockReminderLogRepository(ctrl),
+		dispatcher:             mockusecase.NewMockNotificationDispatcher(ctrl),
+		transactor:             mockdomain.NewMockTransactor(ctrl),
+	}
+	useCase := usecase.NewReminderUseCase(
+		mocks.assessmentRepo,
+		mocks.supportApplicationRepo,
+		mocks.reminderLogRepo,
+		mocks.dispatcher,
+		mocks.transactor,
+	)
+	return mocks, useCase
+}
+
+func TestReminderUseCase_RemindStaleAssessments(t *testing.T) {
+	now := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	staleAfter := 7 * 24 * time.Hour
+	since := now.Add(-staleAfter)
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mocks *reminderTestMocks)
+		expectedSent  int
+		expectedError bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mocks *reminderTestMocks) {
+				mocks.assessmentRepo.EXPECT().FindByStatusUpdatedBefore(gomock.Any(), "進行中", since).Return([]*model.Assessment{
+					{ID: 1, UserID: dispatchUserID},
+					{ID: 2, UserID: dispatchUserID},
+				}, nil)
+				expectTransaction(mocks.transactor)
+				expectTransaction(mocks.transactor)
+				mocks.reminderLogRepo.EXPECT().
+					ExistsSince(gomock.Any(), dispatchUserID, model.RelatedEntityRef{Type: model.RelatedEntityAssessment, ID: "1"}, since).
+					Return(false, nil)
+				// アプリ内通知を無効にしていても、送信履歴があれば再送しない
+				mocks.reminderLogRepo.EXPECT().
+					ExistsSince(gomock.Any(), dispatchUserID, model.RelatedEntityRef{Type: model.RelatedEntityAssessment, ID: "2"}, since).
+					Return(true, nil)
+				mocks.dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, notification *model.Notification) (*model.NotificationDelivery, error) {
+						assert.Equal(t, dispatchUserID, notification.UserID)
+						assert.Equal(t, model.NotificationTypeReminder, notification.NotificationType)
+						assert.Equal(t, "査定", *notification.RelatedEntityType)
+						assert.Equal(t, "1", *notification.RelatedEntityID)
+						assert.Contains(t, notification.Message, "7日以上")
+						return &model.NotificationDelivery{InApp: model.DeliveryStatusDisabled}, nil
+					})
+				mocks.reminderLogRepo.EXPECT().Create(gomock.Any(), &model.ReminderLog{
+					UserID:            dispatchUserID,
+					RelatedEntityType: "査定",
+					RelatedEntityID:   "1",
+					RemindedAt:        now,
+				}).Return(nil)
+			},
+			expectedSent: 1,
+		},
+		{
+			name: "Partial Failure",
+			mockSetup: func(mocks *reminderTestMocks) {
+				mocks.assessmentRepo.EXPECT().FindByStatusUpdatedBefore(gomock.Any(), "進行中", since).Return([]*model.Assessment{
+					{ID: 1, UserID: dispatchUserID},
+					{ID: 2, UserID: dispatchUserID},
+				}, nil)
+				expectTransaction(mocks.transactor)
+				expectTransaction(mocks.transactor)
+				mocks.reminderLogRepo.EXPECT().ExistsSince(gomock.Any(), dispatchUserID, gomock.Any(), since).
+					Return(false, nil).Times(2)
+				mocks.dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+				mocks.dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(&model.NotificationDelivery{}, nil)
+				mocks.reminderLogRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedSent:  1,
+			expectedError: true,
+		},
+		{
+			name: "Repository Error",
+			mockSetup: func(mocks *reminderTestMocks) {
+				mocks.assessmentRepo.EXPECT().FindByStatusUpdatedBefore(gomock.Any(), "進行中", since).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocks, useCase := setupReminderTest(t)
+			tt.mockSetup(mocks)
+
+			sent, err := useCase.RemindStaleAssessments(context.Background(), now, staleAfter)
+
+			assert.Equal(t, tt.expectedSent, sent)
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReminderUseCase_RemindPendingDocumentApplications(t *testing.T) {
+	// Setup
+	mocks, useCase := setupReminderTest(t)
+	now := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	staleAfter := 3 * 24 * time.Hour
+	since := now.Add(-staleAfter)
+	applicantUserID := dispatchUserID
+
+	mocks.supportApplicationRepo.EXPECT().FindByStatusUpdatedBefore(gomock.Any(), "書類確認中", since).Return([]*model.SupportApplication{
+		{ApplicationID: "A001", ApplicantUserID: &applicantUserID},
+		{ApplicationID: "A002"},
+	}, nil)
+	expectTransaction(mocks.transactor)
+	mocks.reminderLogRepo.EXPECT().
+		ExistsSince(gomock.Any(), dispatchUserID, model.RelatedEntityRef{Type: model.RelatedEntitySupportApplication, ID: "A001"}, since).
+		Return(false, nil)
+	mocks.dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification *model.Notification) (*model.NotificationDelivery, error) {
+			assert.Equal(t, "支援申請", *notification.RelatedEntityType)
+			assert.Equal(t, "A001", *notification.RelatedEntityID)
+			assert.Contains(t, notification.Message, "3日以上")
+			return &model.NotificationDelivery{}, nil
+		})
+	mocks.reminderLogRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	sent, err := useCase.RemindPendingDocumentApplications(context.Background(), now, staleAfter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+}
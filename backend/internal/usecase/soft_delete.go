@@ -0,0 +1,23 @@
+package usecase
+
+import "github.com/AI1411/fullstack-react-go/internal/domain/model"
+
+// assessmentChildren are soft-deleted and restored together with their assessment
+var assessmentChildren = []model.SoftDeleteRelation{
+	{Table: model.TableNameAssessmentItem, ForeignKey: "assessment_id"},
+	{Table: model.TableNameAssessmentComment, ForeignKey: "assessment_id"},
+}
+
+// disasterChildren are soft-deleted and restored together with their disaster
+var disasterChildren = []model.SoftDeleteRelation{
+	{Table: model.TableNameTimeline, ForeignKey: "disaster_id"},
+	{Table: model.TableNameDisasterDocument, ForeignKey: "disaster_id"},
+	{Table: model.TableNameGisDatum, ForeignKey: "disaster_id"},
+	{Table: model.TableNameAssessment, ForeignKey: "disaster_id", Children: assessmentChildren},
+}
+
+// softDeleteCascades lists, per trash type, the children that share the soft delete of a record
+var softDeleteCascades = map[model.TrashType][]model.SoftDeleteRelation{
+	model.TrashTypeDisaster:   disasterChildren,
+	model.TrashTypeAssessment: assessmentChildren,
+}
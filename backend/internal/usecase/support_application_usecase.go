@@ -3,15 +3,38 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 )
 
+// supportApplicationStatuses lists every support application status
+var supportApplicationStatuses = []string{"審査中", "書類確認中", "承認済", "完了", "支払処理中", "却下"}
+
+// supportApplicationTransitions lists the statuses each status can change to. 完了 and 却下 are final.
+var supportApplicationTransitions = map[string][]string{
+	"審査中":   {"書類確認中", "承認済", "却下"},
+	"書類確認中": {"審査中", "承認済", "却下"},
+	"承認済":   {"支払処理中"},
+	"支払処理中": {"完了"},
+}
+
 type SupportApplicationUseCase interface {
 	ListSupportApplications(ctx context.Context) ([]*model.SupportApplication, error)
 	GetSupportApplicationByID(ctx context.Context, id string) (*model.SupportApplication, error)
 	CreateSupportApplication(ctx context.Context, supportApplication *model.SupportApplication) error
+	// UpdateSupportApplicationStatus changes the status if the application has not been modified since
+	// expectedUpdatedAt and the current status can change to it, and returns the updated application
+	UpdateSupportApplicationStatus(
+		ctx context.Context,
+		id, status string,
+		expectedUpdatedAt time.Time,
+	) (*model.SupportApplication, error)
 }
 
 type supportApplicationUseCase struct {
@@ -27,6 +50,9 @@ func NewSupportApplicationUseCase(
 }
 
 func (u *supportApplicationUseCase) ListSupportApplications(ctx context.Context) ([]*model.SupportApplication, error) {
+	ctx, span := startSpan(ctx, "SupportApplicationUseCase.ListSupportApplications")
+	defer span.End()
+
 	supportApplications, err := u.supportApplicationRepository.Find(ctx)
 	if err != nil {
 		return nil, err
@@ -36,6 +62,9 @@ func (u *supportApplicationUseCase) ListSupportApplications(ctx context.Context)
 }
 
 func (u *supportApplicationUseCase) GetSupportApplicationByID(ctx context.Context, id string) (*model.SupportApplication, error) {
+	ctx, span := startSpan(ctx, "SupportApplicationUseCase.GetSupportApplicationByID")
+	defer span.End()
+
 	supportApplication, err := u.supportApplicationRepository.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -45,5 +74,86 @@ func (u *supportApplicationUseCase) GetSupportApplicationByID(ctx context.Contex
 }
 
 func (u *supportApplicationUseCase) CreateSupportApplication(ctx context.Context, supportApplication *model.SupportApplication) error {
-	return u.supportApplicationRepository.Create(ctx, supportApplication)
+	ctx, span := startSpan(ctx, "SupportApplicationUseCase.CreateSupportApplication")
+	defer span.End()
+
+	event, err := newApplicationTransitionedEvent(supportApplication, nil)
+	if err != nil {
+		return err
+	}
+
+	return u.supportApplicationRepository.Create(ctx, supportApplication, event)
+}
+
+func (u *supportApplicationUseCase) UpdateSupportApplicationStatus(
+	ctx context.Context,
+	id, status string,
+	expectedUpdatedAt time.Time,
+) (*model.SupportApplication, error) {
+	ctx, span := startSpan(ctx, "SupportApplicationUseCase.UpdateSupportApplicationStatus")
+	defer span.End()
+
+	if !slices.Contains(supportApplicationStatuses, status) {
+		return nil, myerrors.NewValidationError(
+			myerrors.NewFieldError("status", myerrors.FieldOneOf, strings.Join(supportApplicationStatuses, ", ")),
+		)
+	}
+
+	supportApplication, err := u.supportApplicationRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVersion(supportApplication.UpdatedAt, expectedUpdatedAt); err != nil {
+		return nil, err
+	}
+
+	previousStatus := supportApplication.Status
+	if previousStatus == status {
+		return supportApplication, nil
+	}
+
+	if !slices.Contains(supportApplicationTransitions[previousStatus], status) {
+		return nil, myerrors.NewAPIError(
+			myerrors.InvalidStatusTransitionError,
+			myerrors.InvalidStatusTransitionErrorMessage,
+			fmt.Errorf("support application status cannot change from %s to %s", previousStatus, status),
+			"invalid status transition",
+		)
+	}
+
+	supportApplication.Status = status
+	supportApplication.UpdatedAt = time.Now()
+
+	event, err := newApplicationTransitionedEvent(supportApplication, &previousStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.supportApplicationRepository.UpdateStatus(
+		ctx, id, status, supportApplication.UpdatedAt, expectedUpdatedAt, event,
+	); err != nil {
+		return nil, translateVersionConflict(err)
+	}
+
+	// updated_atはDB側の精度で保存されるため、ETag算出用に再取得する
+	return u.supportApplicationRepository.FindByID(ctx, id)
+}
+
+func newApplicationTransitionedEvent(supportApplication *model.SupportApplication, previousStatus *string) (*model.OutboxEvent, error) {
+	return model.NewOutboxEvent(
+		model.DomainEventApplicationTransitioned,
+		model.AggregateSupportApplication,
+		supportApplication.ApplicationID,
+		&model.ApplicationTransitionedEvent{
+			ApplicationID:   supportApplication.ApplicationID,
+			ApplicationDate: supportApplication.ApplicationDate,
+			ApplicantName:   supportApplication.ApplicantName,
+			ApplicantUserID: supportApplication.ApplicantUserID,
+			DisasterName:    supportApplication.DisasterName,
+			RequestedAmount: supportApplication.RequestedAmount,
+			PreviousStatus:  previousStatus,
+			Status:          supportApplication.Status,
+		},
+	)
 }
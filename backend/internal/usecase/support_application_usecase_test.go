@@ -2,6 +2,7 @@ package usecase_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -10,13 +11,15 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
-	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
 )
 
-func setupSupportApplicationTest(t *testing.T) (*mockdatastore.MockSupportApplicationRepository, usecase.SupportApplicationUseCase) {
+func setupSupportApplicationTest(t *testing.T) (*mockdomain.MockSupportApplicationRepository, usecase.SupportApplicationUseCase) {
 	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockSupportApplicationRepository(ctrl)
+	mockRepo := mockdomain.NewMockSupportApplicationRepository(ctrl)
 	useCase := usecase.NewSupportApplicationUseCase(mockRepo)
 	return mockRepo, useCase
 }
@@ -29,13 +32,13 @@ func TestSupportApplicationUseCase_ListSupportApplications(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		mockSetup     func(mockRepo *mockdatastore.MockSupportApplicationRepository)
+		mockSetup     func(mockRepo *mockdomain.MockSupportApplicationRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name: "Success",
-			mockSetup: func(mockRepo *mockdatastore.MockSupportApplicationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockSupportApplicationRepository) {
 				now := time.Now()
 				reviewedAt := now.Add(-2 * time.Hour)
 				approvedAt := now.Add(-1 * time.Hour)
@@ -80,7 +83,7 @@ func TestSupportApplicationUseCase_ListSupportApplications(t *testing.T) {
 		},
 		{
 			name: "Error",
-			mockSetup: func(mockRepo *mockdatastore.MockSupportApplicationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockSupportApplicationRepository) {
 				mockRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
@@ -119,13 +122,13 @@ func TestSupportApplicationUseCase_GetSupportApplicationByID(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            string
-		mockSetup     func(mockRepo *mockdatastore.MockSupportApplicationRepository)
+		mockSetup     func(mockRepo *mockdomain.MockSupportApplicationRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   "A001",
-			mockSetup: func(mockRepo *mockdatastore.MockSupportApplicationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockSupportApplicationRepository) {
 				now := time.Now()
 				reviewedAt := now.Add(-2 * time.Hour)
 				approvedAt := now.Add(-1 * time.Hour)
@@ -152,7 +155,7 @@ func TestSupportApplicationUseCase_GetSupportApplicationByID(t *testing.T) {
 		{
 			name: "Not Found",
 			id:   "A999",
-			mockSetup: func(mockRepo *mockdatastore.MockSupportApplicationRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockSupportApplicationRepository) {
 				mockRepo.EXPECT().FindByID(gomock.Any(), "A999").Return(nil, errors.New("not found"))
 			},
 			expectedError: true,
@@ -190,7 +193,7 @@ func TestSupportApplicationUseCase_CreateSupportApplication(t *testing.T) {
 	tests := []struct {
 		name               string
 		supportApplication *model.SupportApplication
-		mockSetup          func(mockRepo *mockdatastore.MockSupportApplicationRepository)
+		mockSetup          func(mockRepo *mockdomain.MockSupportApplicationRepository)
 		expectedError      bool
 	}{
 		{
@@ -209,8 +212,15 @@ func TestSupportApplicationUseCase_CreateSupportApplication(t *testing.T) {
 					Notes:           &notes,
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockSupportApplicationRepository) {
-				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mockSetup: func(mockRepo *mockdomain.MockSupportApplicationRepository) {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *model.SupportApplication, events ...*model.OutboxEvent) error {
+						if assert.Len(t, events, 1) {
+							assert.Equal(t, model.DomainEventApplicationTransitioned, events[0].EventType)
+							assert.Equal(t, "A003", events[0].AggregateID)
+						}
+						return nil
+					})
 			},
 			expectedError: false,
 		},
@@ -228,8 +238,8 @@ func TestSupportApplicationUseCase_CreateSupportApplication(t *testing.T) {
 					Status:          "審査中",
 				}
 			}(),
-			mockSetup: func(mockRepo *mockdatastore.MockSupportApplicationRepository) {
-				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			mockSetup: func(mockRepo *mockdomain.MockSupportApplicationRepository) {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
 		},
@@ -253,3 +263,152 @@ func TestSupportApplicationUseCase_CreateSupportApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportApplicationUseCase_UpdateSupportApplicationStatus(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupSupportApplicationTest(t)
+	ctx := context.Background()
+
+	readAt := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	newApplication := func(status string, updatedAt time.Time) *model.SupportApplication {
+		return &model.SupportApplication{
+			ApplicationID:   "A001",
+			ApplicationDate: time.Now(),
+			ApplicantName:   "山田太郎",
+			DisasterName:    "東京地震",
+			RequestedAmount: 500000,
+			Status:          status,
+			UpdatedAt:       updatedAt,
+		}
+	}
+
+	// Test cases
+	tests := []struct {
+		name           string
+		status         string
+		mockSetup      func()
+		expectedCode   myerrors.ErrorCode
+		expectedError  bool
+		expectedStatus string
+	}{
+		{
+			name:   "Writes Transition Event",
+			status: "承認済",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("審査中", readAt), nil)
+				mockRepo.EXPECT().UpdateStatus(gomock.Any(), "A001", "承認済", gomock.Any(), readAt, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _, _ time.Time, events ...*model.OutboxEvent) error {
+						if assert.Len(t, events, 1) {
+							var payload model.ApplicationTransitionedEvent
+							assert.NoError(t, json.Unmarshal([]byte(events[0].Payload), &payload))
+							assert.Equal(t, "審査中", *payload.PreviousStatus)
+							assert.Equal(t, "承認済", payload.Status)
+						}
+						return nil
+					})
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("承認済", readAt.Add(time.Hour)), nil)
+			},
+			expectedStatus: "承認済",
+		},
+		{
+			name:   "Other Status",
+			status: "書類確認中",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("審査中", readAt), nil)
+				mockRepo.EXPECT().UpdateStatus(gomock.Any(), "A001", "書類確認中", gomock.Any(), readAt, gomock.Any()).Return(nil)
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("書類確認中", readAt.Add(time.Hour)), nil)
+			},
+			expectedStatus: "書類確認中",
+		},
+		{
+			name:   "Unchanged",
+			status: "承認済",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("承認済", readAt), nil)
+			},
+			expectedStatus: "承認済",
+		},
+		{
+			name:          "Invalid Status",
+			status:        "保留",
+			mockSetup:     func() {},
+			expectedCode:  myerrors.ValidationError,
+			expectedError: true,
+		},
+		{
+			name:   "Final Status",
+			status: "審査中",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("却下", readAt), nil)
+			},
+			expectedCode:  myerrors.InvalidStatusTransitionError,
+			expectedError: true,
+		},
+		{
+			name:   "Skips Payment",
+			status: "完了",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("承認済", readAt), nil)
+			},
+			expectedCode:  myerrors.InvalidStatusTransitionError,
+			expectedError: true,
+		},
+		{
+			name:   "Stale Version",
+			status: "承認済",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("審査中", readAt.Add(time.Minute)), nil)
+			},
+			expectedCode:  myerrors.PreconditionFailedError,
+			expectedError: true,
+		},
+		{
+			// 読み込み後に別のリクエストが先に更新した
+			name:   "Concurrent Update",
+			status: "承認済",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("審査中", readAt), nil)
+				mockRepo.EXPECT().UpdateStatus(gomock.Any(), "A001", "承認済", gomock.Any(), readAt, gomock.Any()).
+					Return(domain.ErrVersionConflict)
+			},
+			expectedCode:  myerrors.PreconditionFailedError,
+			expectedError: true,
+		},
+		{
+			name:   "Update Error",
+			status: "承認済",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "A001").Return(newApplication("審査中", readAt), nil)
+				mockRepo.EXPECT().UpdateStatus(gomock.Any(), "A001", "承認済", gomock.Any(), readAt, gomock.Any()).
+					Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup()
+
+			// Call the method
+			supportApplication, err := useCase.UpdateSupportApplicationStatus(ctx, "A001", tt.status, readAt)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, supportApplication)
+				if tt.expectedCode != "" {
+					var apiErr *myerrors.APIError
+					if assert.ErrorAs(t, err, &apiErr) {
+						assert.Equal(t, tt.expectedCode, apiErr.Code)
+					}
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, supportApplication.Status)
+			}
+		})
+	}
+}
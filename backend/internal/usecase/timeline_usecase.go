@@ -25,5 +25,8 @@ func NewTimelineUseCase(
 }
 
 func (u *timelineUseCase) GetTimelinesByDisasterID(ctx context.Context, disasterID string) ([]*model.Timeline, error) {
+	ctx, span := startSpan(ctx, "TimelineUseCase.GetTimelinesByDisasterID")
+	defer span.End()
+
 	return u.timelineRepository.FindByDisasterID(ctx, disasterID)
 }
@@ -11,12 +11,12 @@ import (
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
-	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
 )
 
-func setupTimelineTest(t *testing.T) (*mockdatastore.MockTimelineRepository, usecase.TimelineUseCase) {
+func setupTimelineTest(t *testing.T) (*mockdomain.MockTimelineRepository, usecase.TimelineUseCase) {
 	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockTimelineRepository(ctrl)
+	mockRepo := mockdomain.NewMockTimelineRepository(ctrl)
 	useCase := usecase.NewTimelineUseCase(mockRepo)
 	return mockRepo, useCase
 }
@@ -30,14 +30,14 @@ func TestTimelineUseCase_GetTimelinesByDisasterID(t *testing.T) {
 	tests := []struct {
 		name          string
 		disasterID    string
-		mockSetup     func(mockRepo *mockdatastore.MockTimelineRepository)
+		mockSetup     func(mockRepo *mockdomain.MockTimelineRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name:       "Success",
 			disasterID: "1",
-			mockSetup: func(mockRepo *mockdatastore.MockTimelineRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockTimelineRepository) {
 				now := time.Now()
 				severity1 := "高"
 				severity2 := "中"
@@ -72,7 +72,7 @@ func TestTimelineUseCase_GetTimelinesByDisasterID(t *testing.T) {
 		{
 			name:       "No Timelines",
 			disasterID: "2",
-			mockSetup: func(mockRepo *mockdatastore.MockTimelineRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockTimelineRepository) {
 				mockRepo.EXPECT().FindByDisasterID(gomock.Any(), "2").Return([]*model.Timeline{}, nil)
 			},
 			expectedError: false,
@@ -81,7 +81,7 @@ func TestTimelineUseCase_GetTimelinesByDisasterID(t *testing.T) {
 		{
 			name:       "Error",
 			disasterID: "1",
-			mockSetup: func(mockRepo *mockdatastore.MockTimelineRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockTimelineRepository) {
 				mockRepo.EXPECT().FindByDisasterID(gomock.Any(), "1").Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
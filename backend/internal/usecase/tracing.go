@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/AI1411/fullstack-react-go/internal/usecase")
+
+// startSpan starts the span of a use case call, named "<UseCase>.<Method>"
+func startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name)
+}
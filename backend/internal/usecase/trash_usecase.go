@@ -0,0 +1,114 @@
+//go:generate mockgen -source=trash_usecase.go -destination=../../tests/mock/usecase/trash_usecase.mock.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+type TrashUseCase interface {
+	ListTrash(ctx context.Context, trashType model.TrashType) ([]*model.TrashItem, error)
+	// Restore brings a record back from the trash and returns the number of children restored with it
+	Restore(ctx context.Context, trashType model.TrashType, id string) (int64, error)
+	Purge(ctx context.Context, trashType model.TrashType, id string) error
+}
+
+type trashUseCase struct {
+	trashRepository domain.TrashRepository
+}
+
+func NewTrashUseCase(trashRepository domain.TrashRepository) TrashUseCase {
+	return &trashUseCase{
+		trashRepository: trashRepository,
+	}
+}
+
+func (u *trashUseCase) ListTrash(ctx context.Context, trashType model.TrashType) ([]*model.TrashItem, error) {
+	ctx, span := startSpan(ctx, "TrashUseCase.ListTrash")
+	defer span.End()
+
+	if !trashType.Valid() {
+		return nil, newInvalidTrashTypeError()
+	}
+
+	return u.trashRepository.FindDeleted(ctx, trashType)
+}
+
+func (u *trashUseCase) Restore(ctx context.Context, trashType model.TrashType, id string) (int64, error) {
+	ctx, span := startSpan(ctx, "TrashUseCase.Restore")
+	defer span.End()
+
+	if err := validateTrashTarget(trashType, id); err != nil {
+		return 0, err
+	}
+
+	restored, err := u.trashRepository.Restore(ctx, trashType, id, softDeleteCascades[trashType])
+	if err != nil {
+		return 0, translateTrashError(err)
+	}
+
+	return restored, nil
+}
+
+func (u *trashUseCase) Purge(ctx context.Context, trashType model.TrashType, id string) error {
+	ctx, span := startSpan(ctx, "TrashUseCase.Purge")
+	defer span.End()
+
+	if err := validateTrashTarget(trashType, id); err != nil {
+		return err
+	}
+
+	if err := u.trashRepository.Purge(ctx, trashType, id); err != nil {
+		return translateTrashError(err)
+	}
+
+	return nil
+}
+
+func validateTrashTarget(trashType model.TrashType, id string) error {
+	if !trashType.Valid() {
+		return newInvalidTrashTypeError()
+	}
+
+	if trashType.UsesUUID() {
+		if _, err := uuid.Parse(id); err != nil {
+			return myerrors.NewValidationError(myerrors.NewFieldError("id", myerrors.FieldUUID))
+		}
+
+		return nil
+	}
+
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return myerrors.NewValidationError(myerrors.NewFieldError("id", myerrors.FieldNumeric))
+	}
+
+	return nil
+}
+
+func newInvalidTrashTypeError() error {
+	return myerrors.NewValidationError(
+		myerrors.NewFieldError("type", myerrors.FieldOneOf, "disaster, timeline, facility_equipment, gis_data, assessment, user"),
+	)
+}
+
+// translateTrashError maps repository errors to API errors
+func translateTrashError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return myerrors.NewAPIError(myerrors.TrashItemNotFoundError, myerrors.TrashItemNotFoundErrorMessage, err, "trash item not found")
+	case errors.Is(err, domain.ErrParentDeleted):
+		return myerrors.NewAPIError(myerrors.TrashParentDeletedError, myerrors.TrashParentDeletedErrorMessage, err, "parent is still deleted")
+	case errors.Is(err, domain.ErrReferenced):
+		return myerrors.NewAPIError(myerrors.TrashItemReferencedError, myerrors.TrashItemReferencedErrorMessage, err, "trash item is referenced")
+	default:
+		return err
+	}
+}
@@ -0,0 +1,245 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+const trashDisasterID = "0b3c4c8e-5a7f-4a55-9d7c-3c1f2d5e6a70"
+
+func setupTrashTest(t *testing.T) (*mockdomain.MockTrashRepository, usecase.TrashUseCase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockTrashRepository(ctrl)
+	useCase := usecase.NewTrashUseCase(mockRepo)
+	return mockRepo, useCase
+}
+
+func assertTrashErrorCode(t *testing.T, err error, expected myerrors.ErrorCode) {
+	t.Helper()
+
+	var apiErr *myerrors.APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, expected, apiErr.Code)
+	}
+}
+
+func TestTrashUseCase_ListTrash(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupTrashTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name         string
+		trashType    model.TrashType
+		mockSetup    func(mockRepo *mockdomain.MockTrashRepository)
+		expectedCode myerrors.ErrorCode
+		expectedLen  int
+	}{
+		{
+			name:      "Success",
+			trashType: model.TrashTypeDisaster,
+			mockSetup: func(mockRepo *mockdomain.MockTrashRepository) {
+				mockRepo.EXPECT().FindDeleted(gomock.Any(), model.TrashTypeDisaster).Return([]*model.TrashItem{
+					{Type: model.TrashTypeDisaster, ID: trashDisasterID, Label: "令和6年豪雨", DeletedAt: time.Now()},
+				}, nil)
+			},
+			expectedLen: 1,
+		},
+		{
+			name:         "Unknown Type",
+			trashType:    model.TrashType("organization"),
+			mockSetup:    func(mockRepo *mockdomain.MockTrashRepository) {},
+			expectedCode: myerrors.ValidationError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockRepo)
+
+			items, err := useCase.ListTrash(ctx, tt.trashType)
+
+			if tt.expectedCode != "" {
+				assertTrashErrorCode(t, err, tt.expectedCode)
+				assert.Nil(t, items)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, items, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestTrashUseCase_Restore(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupTrashTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name             string
+		trashType        model.TrashType
+		id               string
+		mockSetup        func(mockRepo *mockdomain.MockTrashRepository)
+		expectedCode     myerrors.ErrorCode
+		expectedError    bool
+		expectedRestored int64
+	}{
+		{
+			name:      "Success With Children",
+			trashType: model.TrashTypeDisaster,
+			id:        trashDisasterID,
+			mockSetup: func(mockRepo *mockdomain.MockTrashRepository) {
+				mockRepo.EXPECT().Restore(gomock.Any(), model.TrashTypeDisaster, trashDisasterID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ model.TrashType, _ string, children []model.SoftDeleteRelation) (int64, error) {
+						tables := make([]string, 0, len(children))
+						for _, child := range children {
+							tables = append(tables, child.Table)
+						}
+						assert.Equal(t, []string{"timelines", "disaster_documents", "gis_data", "assessments"}, tables)
+						assert.Len(t, children[3].Children, 2)
+						return int64(3), nil
+					})
+			},
+			expectedRestored: 3,
+		},
+		{
+			name:         "Invalid UUID",
+			trashType:    model.TrashTypeDisaster,
+			id:           "123",
+			mockSetup:    func(mockRepo *mockdomain.MockTrashRepository) {},
+			expectedCode: myerrors.ValidationError,
+		},
+		{
+			name:         "Invalid Serial ID",
+			trashType:    model.TrashTypeTimeline,
+			id:           "abc",
+			mockSetup:    func(mockRepo *mockdomain.MockTrashRepository) {},
+			expectedCode: myerrors.ValidationError,
+		},
+		{
+			name:      "Not In Trash",
+			trashType: model.TrashTypeTimeline,
+			id:        "10",
+			mockSetup: func(mockRepo *mockdomain.MockTrashRepository) {
+				mockRepo.EXPECT().Restore(gomock.Any(), model.TrashTypeTimeline, "10", gomock.Nil()).Return(int64(0), gorm.ErrRecordNotFound)
+			},
+			expectedCode: myerrors.TrashItemNotFoundError,
+		},
+		{
+			name:      "Parent Still Deleted",
+			trashType: model.TrashTypeGisData,
+			id:        "5",
+			mockSetup: func(mockRepo *mockdomain.MockTrashRepository) {
+				mockRepo.EXPECT().Restore(gomock.Any(), model.TrashTypeGisData, "5", gomock.Nil()).Return(int64(0), domain.ErrParentDeleted)
+			},
+			expectedCode: myerrors.TrashParentDeletedError,
+		},
+		{
+			name:      "Repository Error",
+			trashType: model.TrashTypeUser,
+			id:        trashDisasterID,
+			mockSetup: func(mockRepo *mockdomain.MockTrashRepository) {
+				mockRepo.EXPECT().Restore(gomock.Any(), model.TrashTypeUser, trashDisasterID, gomock.Nil()).Return(int64(0), errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockRepo)
+
+			restored, err := useCase.Restore(ctx, tt.trashType, tt.id)
+
+			switch {
+			case tt.expectedCode != "":
+				assertTrashErrorCode(t, err, tt.expectedCode)
+			case tt.expectedError:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRestored, restored)
+			}
+		})
+	}
+}
+
+func TestTrashUseCase_Purge(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupTrashTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name         string
+		trashType    model.TrashType
+		id           string
+		mockSetup    func(mockRepo *mockdomain.MockTrashRepository)
+		expectedCode myerrors.ErrorCode
+	}{
+		{
+			name:      "Success",
+			trashType: model.TrashTypeFacilityEquipment,
+			id:        "1",
+			mockSetup: func(mockRepo *mockdomain.MockTrashRepository) {
+				mockRepo.EXPECT().Purge(gomock.Any(), model.TrashTypeFacilityEquipment, "1").Return(nil)
+			},
+		},
+		{
+			name:      "Not In Trash",
+			trashType: model.TrashTypeFacilityEquipment,
+			id:        "2",
+			mockSetup: func(mockRepo *mockdomain.MockTrashRepository) {
+				mockRepo.EXPECT().Purge(gomock.Any(), model.TrashTypeFacilityEquipment, "2").Return(gorm.ErrRecordNotFound)
+			},
+			expectedCode: myerrors.TrashItemNotFoundError,
+		},
+		{
+			name:      "Referenced",
+			trashType: model.TrashTypeUser,
+			id:        trashDisasterID,
+			mockSetup: func(mockRepo *mockdomain.MockTrashRepository) {
+				mockRepo.EXPECT().Purge(gomock.Any(), model.TrashTypeUser, trashDisasterID).Return(domain.ErrReferenced)
+			},
+			expectedCode: myerrors.TrashItemReferencedError,
+		},
+		{
+			name:         "Unknown Type",
+			trashType:    model.TrashType("prefecture"),
+			id:           "1",
+			mockSetup:    func(mockRepo *mockdomain.MockTrashRepository) {},
+			expectedCode: myerrors.ValidationError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup(mockRepo)
+
+			err := useCase.Purge(ctx, tt.trashType, tt.id)
+
+			if tt.expectedCode != "" {
+				assertTrashErrorCode(t, err, tt.expectedCode)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,11 +3,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net/smtp"
 	"time"
 
 	"github.com/aws/smithy-go/ptr"
+	"github.com/google/uuid"
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
@@ -18,31 +19,47 @@ type UserUseCase interface {
 	ListUsers(ctx context.Context) ([]*model.User, error)
 	GetUserByID(ctx context.Context, id string) (*model.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	// CreateUser stores the user, the email verification token and the welcome email job
+	// in one transaction
 	CreateUser(ctx context.Context, user *model.User) error
 	UpdateUser(ctx context.Context, user *model.User) error
 	DeleteUser(ctx context.Context, id int32) error
 	VerifyEmail(ctx context.Context, token string) error
+	// SendWelcomeEmail sends the email verification mail queued by CreateUser and records it in email_histories
+	SendWelcomeEmail(ctx context.Context, payload model.WelcomeEmailPayload) error
 }
 
 type userUseCase struct {
+	transactor                       domain.Transactor
 	userRepository                   domain.UserRepository
 	emailHistoryRepository           domain.EmailHistoryRepository
 	emailVarificationTokenRepository domain.EmailVarificationTokenRepository
+	jobRepository                    domain.JobRepository
+	mailer                           domain.Mailer
 }
 
 func NewUserUseCase(
+	transactor domain.Transactor,
 	userRepository domain.UserRepository,
 	emailHistoryRepository domain.EmailHistoryRepository,
 	emailVarificationTokenRepository domain.EmailVarificationTokenRepository,
+	jobRepository domain.JobRepository,
+	mailer domain.Mailer,
 ) UserUseCase {
 	return &userUseCase{
+		transactor:                       transactor,
 		userRepository:                   userRepository,
 		emailHistoryRepository:           emailHistoryRepository,
 		emailVarificationTokenRepository: emailVarificationTokenRepository,
+		jobRepository:                    jobRepository,
+		mailer:                           mailer,
 	}
 }
 
 func (u *userUseCase) ListUsers(ctx context.Context) ([]*model.User, error) {
+	ctx, span := startSpan(ctx, "UserUseCase.ListUsers")
+	defer span.End()
+
 	users, err := u.userRepository.Find(ctx)
 	if err != nil {
 		return nil, err
@@ -52,6 +69,9 @@ func (u *userUseCase) ListUsers(ctx context.Context) ([]*model.User, error) {
 }
 
 func (u *userUseCase) GetUserByID(ctx context.Context, id string) (*model.User, error) {
+	ctx, span := startSpan(ctx, "UserUseCase.GetUserByID")
+	defer span.End()
+
 	user, err := u.userRepository.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -61,14 +81,12 @@ func (u *userUseCase) GetUserByID(ctx context.Context, id string) (*model.User,
 }
 
 func (u *userUseCase) CreateUser(ctx context.Context, user *model.User) error {
-	if err := u.userRepository.Create(ctx, user); err != nil {
-		return err
-	}
+	ctx, span := startSpan(ctx, "UserUseCase.CreateUser")
+	defer span.End()
 
-	// ユーザのIDを取得する
-	user, err := u.GetUserByEmail(ctx, user.Email)
-	if err != nil {
-		return fmt.Errorf("failed to get user by email: %w", err)
+	// ジョブに載せるためIDは登録前に採番する
+	if user.ID == "" {
+		user.ID = uuid.NewString()
 	}
 
 	// メールアドレス確認トークンを生成
@@ -78,27 +96,40 @@ func (u *userUseCase) CreateUser(ctx context.Context, user *model.User) error {
 		return fmt.Errorf("failed to generate email verification token: %w", err)
 	}
 
-	// トークンを保存
-	err = u.emailVarificationTokenRepository.Save(ctx, &model.EmailVerificationToken{
-		UserID:    user.ID,
-		Token:     token,
-		Email:     user.Email,
-		ExpiresAt: time.Now().Add(time.Hour * 2),
-	})
+	job, err := model.NewJob(model.JobTypeWelcomeEmail, &model.WelcomeEmailPayload{UserID: user.ID, Token: token})
 	if err != nil {
-		return fmt.Errorf("failed to save email verification token: %w", err)
-	}
-
-	// ユーザー作成後に認証用メールを送信
-	if err := u.sendWelcomeEmail(ctx, user, token); err != nil {
-		// メール送信エラーはログに記録するが、ユーザー作成は成功として扱う
-		fmt.Printf("ウェルカムメール送信に失敗しました: %v\n", err)
+		return fmt.Errorf("failed to build welcome email job: %w", err)
 	}
 
-	return nil
+	// ユーザ・トークン・認証用メールのジョブをまとめて登録し、トークンのないユーザが残らないようにする
+	return u.transactor.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.userRepository.Create(ctx, user); err != nil {
+			return err
+		}
+
+		err := u.emailVarificationTokenRepository.Save(ctx, &model.EmailVerificationToken{
+			UserID:    user.ID,
+			Token:     token,
+			Email:     user.Email,
+			ExpiresAt: time.Now().Add(time.Hour * 2),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save email verification token: %w", err)
+		}
+
+		// 認証用メールはジョブキュー経由で送信し、失敗時はワーカーが再試行する
+		if err := u.jobRepository.Enqueue(ctx, job); err != nil {
+			return fmt.Errorf("failed to enqueue welcome email job: %w", err)
+		}
+
+		return nil
+	})
 }
 
 func (u *userUseCase) UpdateUser(ctx context.Context, user *model.User) error {
+	ctx, span := startSpan(ctx, "UserUseCase.UpdateUser")
+	defer span.End()
+
 	if err := u.userRepository.Update(ctx, user); err != nil {
 		return err
 	}
@@ -107,6 +138,9 @@ func (u *userUseCase) UpdateUser(ctx context.Context, user *model.User) error {
 }
 
 func (u *userUseCase) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	ctx, span := startSpan(ctx, "UserUseCase.GetUserByEmail")
+	defer span.End()
+
 	user, err := u.userRepository.FindByEmail(ctx, email)
 	if err != nil {
 		return nil, err
@@ -116,68 +150,47 @@ func (u *userUseCase) GetUserByEmail(ctx context.Context, email string) (*model.
 }
 
 func (u *userUseCase) DeleteUser(ctx context.Context, id int32) error {
+	ctx, span := startSpan(ctx, "UserUseCase.DeleteUser")
+	defer span.End()
+
 	return u.userRepository.Delete(ctx, id)
 }
 
-// MailHogの設定
-var (
-	smtpHost = "mailhog" // docker-composeで指定したサービス名
-	smtpPort = 1025      // MailHogのSMTPポート
-	fromAddr = "noreply@agri-disaster.jp"
-)
+// SendWelcomeEmail はウェルカムメールを送信する
+func (u *userUseCase) SendWelcomeEmail(ctx context.Context, payload model.WelcomeEmailPayload) error {
+	ctx, span := startSpan(ctx, "UserUseCase.SendWelcomeEmail")
+	defer span.End()
 
-// sendWelcomeEmail はウェルカムメールを送信する
-func (u *userUseCase) sendWelcomeEmail(ctx context.Context, user *model.User, token string) error {
-	subject := "農業災害支援システムへようこそ"
-	body := u.generateWelcomeEmailBody(user.Name, token)
-
-	// SMTPサーバーのアドレス
-	smtpServer := fmt.Sprintf("%s:%d", smtpHost, smtpPort)
-
-	// RFC 5322準拠のメールメッセージを作成
-	message := fmt.Sprintf(
-		"From: %s\r\n"+
-			"To: %s\r\n"+
-			"Subject: %s\r\n"+
-			"MIME-Version: 1.0\r\n"+
-			"Content-Type: text/html; charset=UTF-8\r\n"+
-			"\r\n"+
-			"%s",
-		fromAddr,
-		user.Email,
-		subject,
-		body,
-	)
-
-	sendEmailStatus := "sent"
-	var errorMessage *string
-
-	// MailHogは認証不要なのでnilを渡す
-	if err := smtp.SendMail(smtpServer, nil, fromAddr, []string{user.Email}, []byte(message)); err != nil {
-		sendEmailStatus = "failed"
-		errorMessage = ptr.String(err.Error())
-		fmt.Printf("メール送信エラー: %v\n", err)
-	} else {
-		fmt.Printf("ウェルカムメール送信成功: %s\n", user.Email)
+	user, err := u.userRepository.FindByID(ctx, payload.UserID)
+	if err != nil {
+		return err
 	}
 
+	subject := "農業災害支援システムへようこそ"
+	body := u.generateWelcomeEmailBody(user.Name, payload.Token)
+
+	sendErr := u.mailer.Send(ctx, user.Email, subject, body)
+
 	// メール送信履歴をデータベースに保存
 	emailHistory := &model.EmailHistory{
-		UserID:       user.ID,
-		Email:        user.Email,
-		Subject:      subject,
-		EmailType:    "welcome",
-		Provider:     "mailhog",
-		Status:       sendEmailStatus,
-		ErrorMessage: errorMessage,
-		SentAt:       time.Now(),
+		UserID:    user.ID,
+		Email:     user.Email,
+		Subject:   subject,
+		EmailType: "welcome",
+		Provider:  u.mailer.Provider(),
+		Status:    model.DeliveryStatusSent,
+		SentAt:    time.Now(),
+	}
+	if sendErr != nil {
+		emailHistory.Status = model.DeliveryStatusFailed
+		emailHistory.ErrorMessage = ptr.String(sendErr.Error())
 	}
 
 	if err := u.emailHistoryRepository.SaveEmailHistory(ctx, emailHistory); err != nil {
-		fmt.Printf("メール履歴保存エラー: %v\n", err)
+		return errors.Join(sendErr, fmt.Errorf("failed to save email history: %w", err))
 	}
 
-	return nil
+	return sendErr
 }
 
 // generateWelcomeEmailBody はウェルカムメールの本文を生成する
@@ -250,6 +263,9 @@ func (u *userUseCase) generateWelcomeEmailBody(userName string, token string) st
 }
 
 func (u *userUseCase) VerifyEmail(ctx context.Context, token string) error {
+	ctx, span := startSpan(ctx, "UserUseCase.VerifyEmail")
+	defer span.End()
+
 	user, err := u.userRepository.FindByEmail(ctx, token)
 	if err != nil {
 		return fmt.Errorf("メールアドレスの認証に失敗しました: %w", err)
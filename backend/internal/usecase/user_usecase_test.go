@@ -11,16 +11,44 @@ import (
 
 	"github.com/AI1411/fullstack-react-go/internal/domain/model"
 	"github.com/AI1411/fullstack-react-go/internal/usecase"
-	mockdatastore "github.com/AI1411/fullstack-react-go/tests/mock/datastore"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
 )
 
-func setupUserTest(t *testing.T) (*mockdatastore.MockUserRepository, usecase.UserUseCase) {
-	ctrl := gomock.NewController(t)
-	mockRepo := mockdatastore.NewMockUserRepository(ctrl)
-	useCase := usecase.NewUserUseCase(mockRepo)
+type userTestMocks struct {
+	transactor       *mockdomain.MockTransactor
+	emailHistoryRepo *mockdomain.MockEmailHistoryRepository
+	tokenRepo        *mockdomain.MockEmailVarificationTokenRepository
+	jobRepo          *mockdomain.MockJobRepository
+	mailer           *mockdomain.MockMailer
+}
+
+func setupUserTest(t *testing.T) (*mockdomain.MockUserRepository, usecase.UserUseCase) {
+	mockRepo, _, useCase := setupUserTestWithMocks(t)
 	return mockRepo, useCase
 }
 
+func setupUserTestWithMocks(t *testing.T) (*mockdomain.MockUserRepository, *userTestMocks, usecase.UserUseCase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockUserRepository(ctrl)
+	mocks := &userTestMocks{
+		transactor:       mockdomain.NewMockTransactor(ctrl),
+		emailHistoryRepo: mockdomain.NewMockEmailHistoryRepository(ctrl),
+		tokenRepo:        mockdomain.NewMockEmailVarificationTokenRepository(ctrl),
+		jobRepo:          mockdomain.NewMockJobRepository(ctrl),
+		mailer:           mockdomain.NewMockMailer(ctrl),
+	}
+	useCase := usecase.NewUserUseCase(mocks.transactor, mockRepo, mocks.emailHistoryRepo, mocks.tokenRepo, mocks.jobRepo, mocks.mailer)
+	return mockRepo, mocks, useCase
+}
+
+// expectTransaction makes the transactor run the unit of work with the given ctx
+func expectTransaction(transactor *mockdomain.MockTransactor) {
+	transactor.EXPECT().Transaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
 func TestUserUseCase_ListUsers(t *testing.T) {
 	// Setup
 	mockRepo, useCase := setupUserTest(t)
@@ -29,18 +57,18 @@ func TestUserUseCase_ListUsers(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		mockSetup     func(mockRepo *mockdatastore.MockUserRepository)
+		mockSetup     func(mockRepo *mockdomain.MockUserRepository)
 		expectedError bool
 		expectedLen   int
 	}{
 		{
 			name: "Success",
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
 				now := time.Now()
 
 				users := []*model.User{
 					{
-						ID:        1,
+						ID:        "1",
 						Name:      "山田太郎",
 						Email:     "yamada@example.com",
 						Password:  "hashed_password_1",
@@ -48,7 +76,7 @@ func TestUserUseCase_ListUsers(t *testing.T) {
 						UpdatedAt: &now,
 					},
 					{
-						ID:        2,
+						ID:        "2",
 						Name:      "佐藤花子",
 						Email:     "sato@example.com",
 						Password:  "hashed_password_2",
@@ -63,7 +91,7 @@ func TestUserUseCase_ListUsers(t *testing.T) {
 		},
 		{
 			name: "Error",
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
 				mockRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedError: true,
@@ -101,33 +129,33 @@ func TestUserUseCase_GetUserByID(t *testing.T) {
 	// Test cases
 	tests := []struct {
 		name          string
-		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockUserRepository)
+		id            string
+		mockSetup     func(mockRepo *mockdomain.MockUserRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
-			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
+			id:   "1",
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
 				now := time.Now()
 
 				user := &model.User{
-					ID:        1,
+					ID:        "1",
 					Name:      "山田太郎",
 					Email:     "yamada@example.com",
 					Password:  "hashed_password_1",
 					CreatedAt: &now,
 					UpdatedAt: &now,
 				}
-				mockRepo.EXPECT().FindByID(gomock.Any(), int32(1)).Return(user, nil)
+				mockRepo.EXPECT().FindByID(gomock.Any(), "1").Return(user, nil)
 			},
 			expectedError: false,
 		},
 		{
 			name: "Not Found",
-			id:   999,
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
-				mockRepo.EXPECT().FindByID(gomock.Any(), int32(999)).Return(nil, errors.New("not found"))
+			id:   "999",
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), "999").Return(nil, errors.New("not found"))
 			},
 			expectedError: true,
 		},
@@ -157,40 +185,79 @@ func TestUserUseCase_GetUserByID(t *testing.T) {
 
 func TestUserUseCase_CreateUser(t *testing.T) {
 	// Setup
-	mockRepo, useCase := setupUserTest(t)
+	mockRepo, mocks, useCase := setupUserTestWithMocks(t)
 	ctx := context.Background()
 
+	newUser := func() *model.User {
+		return &model.User{
+			Name:     "鈴木一郎",
+			Email:    "suzuki@example.com",
+			Password: "password123",
+		}
+	}
+
 	// Test cases
 	tests := []struct {
 		name          string
-		user          *model.User
-		mockSetup     func(mockRepo *mockdatastore.MockUserRepository)
+		mockSetup     func(mockRepo *mockdomain.MockUserRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
-			user: &model.User{
-				Name:     "鈴木一郎",
-				Email:    "suzuki@example.com",
-				Password: "password123",
-			},
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
-				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
+				var userID, savedToken string
+				expectTransaction(mocks.transactor)
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, user *model.User) error {
+						assert.NotEmpty(t, user.ID)
+						userID = user.ID
+						return nil
+					})
+				mocks.tokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token *model.EmailVerificationToken) error {
+						assert.Equal(t, userID, token.UserID)
+						assert.Equal(t, "suzuki@example.com", token.Email)
+						savedToken = token.Token
+						return nil
+					})
+				mocks.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, job *model.Job) error {
+						assert.Equal(t, model.JobTypeWelcomeEmail, job.JobType)
+						assert.Equal(t, model.JobStatusPending, job.Status)
+						assert.Contains(t, job.Payload, `"user_id":"`+userID+`"`)
+						assert.Contains(t, job.Payload, savedToken)
+						return nil
+					})
 			},
 			expectedError: false,
 		},
 		{
 			name: "Error",
-			user: &model.User{
-				Name:     "鈴木一郎",
-				Email:    "suzuki@example.com",
-				Password: "password123",
-			},
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
+				expectTransaction(mocks.transactor)
 				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
 		},
+		{
+			name: "Token Save Error",
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
+				expectTransaction(mocks.transactor)
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.tokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "Enqueue Error",
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
+				expectTransaction(mocks.transactor)
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.tokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
 	}
 
 	// Run tests
@@ -200,7 +267,7 @@ func TestUserUseCase_CreateUser(t *testing.T) {
 			tt.mockSetup(mockRepo)
 
 			// Call the method
-			err := useCase.CreateUser(ctx, tt.user)
+			err := useCase.CreateUser(ctx, newUser())
 
 			// Check results
 			if tt.expectedError {
@@ -221,18 +288,18 @@ func TestUserUseCase_UpdateUser(t *testing.T) {
 	tests := []struct {
 		name          string
 		user          *model.User
-		mockSetup     func(mockRepo *mockdatastore.MockUserRepository)
+		mockSetup     func(mockRepo *mockdomain.MockUserRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			user: &model.User{
-				ID:       1,
+				ID:       "1",
 				Name:     "山田太郎（更新）",
 				Email:    "yamada_new@example.com",
 				Password: "new_password",
 			},
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
 				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedError: false,
@@ -240,12 +307,12 @@ func TestUserUseCase_UpdateUser(t *testing.T) {
 		{
 			name: "Error",
 			user: &model.User{
-				ID:       1,
+				ID:       "1",
 				Name:     "山田太郎（更新）",
 				Email:    "yamada_new@example.com",
 				Password: "new_password",
 			},
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
 				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedError: true,
@@ -280,13 +347,13 @@ func TestUserUseCase_DeleteUser(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            int32
-		mockSetup     func(mockRepo *mockdatastore.MockUserRepository)
+		mockSetup     func(mockRepo *mockdomain.MockUserRepository)
 		expectedError bool
 	}{
 		{
 			name: "Success",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
 				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(nil)
 			},
 			expectedError: false,
@@ -294,7 +361,7 @@ func TestUserUseCase_DeleteUser(t *testing.T) {
 		{
 			name: "Error",
 			id:   1,
-			mockSetup: func(mockRepo *mockdatastore.MockUserRepository) {
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository) {
 				mockRepo.EXPECT().Delete(gomock.Any(), int32(1)).Return(errors.New("database error"))
 			},
 			expectedError: true,
@@ -319,3 +386,59 @@ func TestUserUseCase_DeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestUserUseCase_SendWelcomeEmail(t *testing.T) {
+	payload := model.WelcomeEmailPayload{UserID: "1", Token: "token123"}
+
+	// Test cases
+	tests := []struct {
+		name           string
+		mockSetup      func(mockRepo *mockdomain.MockUserRepository, mocks *userTestMocks)
+		expectedStatus string
+		expectedError  bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository, mocks *userTestMocks) {
+				mocks.mailer.EXPECT().Send(gomock.Any(), "suzuki@example.com", gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus: model.DeliveryStatusSent,
+		},
+		{
+			name: "Send Error",
+			mockSetup: func(mockRepo *mockdomain.MockUserRepository, mocks *userTestMocks) {
+				mocks.mailer.EXPECT().Send(gomock.Any(), "suzuki@example.com", gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+			},
+			expectedStatus: model.DeliveryStatusFailed,
+			expectedError:  true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, mocks, useCase := setupUserTestWithMocks(t)
+			mockRepo.EXPECT().FindByID(gomock.Any(), "1").Return(&model.User{
+				ID:    "1",
+				Name:  "鈴木一郎",
+				Email: "suzuki@example.com",
+			}, nil)
+			mocks.mailer.EXPECT().Provider().Return("smtp")
+			mocks.emailHistoryRepo.EXPECT().SaveEmailHistory(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, history *model.EmailHistory) error {
+					assert.Equal(t, "welcome", history.EmailType)
+					assert.Equal(t, tt.expectedStatus, history.Status)
+					return nil
+				})
+			tt.mockSetup(mockRepo, mocks)
+
+			err := useCase.SendWelcomeEmail(context.Background(), payload)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
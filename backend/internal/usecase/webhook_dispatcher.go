@@ -0,0 +1,229 @@
+//go:generate mockgen -source=webhook_dispatcher.go -destination=../../tests/mock/usecase/webhook_dispatcher.mock.go
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+)
+
+// 送信するWebhookリクエストのヘッダー
+const (
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// ErrWebhookNotAccepted is returned by Deliver when the endpoint answered with a non-2xx status or did not answer
+var ErrWebhookNotAccepted = errors.New("webhook was not accepted")
+
+// WebhookDispatcher sends domain events to the webhook subscriptions. Events are delivered in the background
+// through the job queue, so a slow or failing subscriber never delays the request that raised the event.
+type WebhookDispatcher interface {
+	// Publish queues the event for every subscriber of the event type. Publishing the same event ID again does not
+	// deliver the event twice to a subscriber.
+	Publish(ctx context.Context, eventID string, eventType string, data any) error
+	// FanOut creates a delivery for each active subscription of the event and queues it
+	FanOut(ctx context.Context, event model.WebhookEvent) error
+	// Deliver posts the delivery to its subscription once and records the attempt in the delivery log.
+	// It fails when the endpoint did not accept the delivery so that the job queue retries it with backoff.
+	Deliver(ctx context.Context, deliveryID int64) error
+}
+
+type webhookDispatcher struct {
+	webhookSubscriptionRepository domain.WebhookSubscriptionRepository
+	webhookDeliveryRepository     domain.WebhookDeliveryRepository
+	jobRepository                 domain.JobRepository
+	webhookSender                 domain.WebhookSender
+}
+
+func NewWebhookDispatcher(
+	webhookSubscriptionRepository domain.WebhookSubscriptionRepository,
+	webhookDeliveryRepository domain.WebhookDeliveryRepository,
+	jobRepository domain.JobRepository,
+	webhookSender domain.WebhookSender,
+) WebhookDispatcher {
+	return &webhookDispatcher{
+		webhookSubscriptionRepository: webhookSubscriptionRepository,
+		webhookDeliveryRepository:     webhookDeliveryRepository,
+		jobRepository:                 jobRepository,
+		webhookSender:                 webhookSender,
+	}
+}
+
+func (d *webhookDispatcher) Publish(ctx context.Context, eventID string, eventType string, data any) error {
+	ctx, span := startSpan(ctx, "WebhookDispatcher.Publish")
+	defer span.End()
+
+	event, err := newWebhookEvent(eventID, eventType, data)
+	if err != nil {
+		return err
+	}
+
+	job, err := model.NewJob(model.JobTypeWebhookEvent, event)
+	if err != nil {
+		return err
+	}
+
+	return d.jobRepository.Enqueue(ctx, job)
+}
+
+func (d *webhookDispatcher) FanOut(ctx context.Context, event model.WebhookEvent) error {
+	ctx, span := startSpan(ctx, "WebhookDispatcher.FanOut")
+	defer span.End()
+
+	subscriptions, err := d.webhookSubscriptionRepository.FindActiveByEventType(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(&event)
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		delivery := &model.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        string(payload),
+			Status:         model.WebhookDeliveryStatusPending,
+		}
+		if err := d.webhookDeliveryRepository.Create(ctx, delivery); err != nil {
+			return err
+		}
+		if delivery.Status == model.WebhookDeliveryStatusSucceeded {
+			continue
+		}
+
+		// 購読先ごとに別のジョブにして、1つの送信先の失敗が他の送信先の再送を招かないようにする
+		job, err := model.NewJob(model.JobTypeWebhookDelivery, &model.WebhookDeliveryPayload{DeliveryID: delivery.ID})
+		if err != nil {
+			return err
+		}
+		if err := d.jobRepository.Enqueue(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *webhookDispatcher) Deliver(ctx context.Context, deliveryID int64) error {
+	ctx, span := startSpan(ctx, "WebhookDispatcher.Deliver")
+	defer span.End()
+
+	delivery, err := d.webhookDeliveryRepository.FindByID(ctx, deliveryID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 購読と一緒に削除された
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if delivery.Status == model.WebhookDeliveryStatusSucceeded {
+		return nil
+	}
+
+	subscription, err := d.webhookSubscriptionRepository.FindByID(ctx, delivery.SubscriptionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+
+	// テストイベントは無効化中の購読にも送り、有効化前に疎通を確認できるようにする
+	if !subscription.Active && delivery.EventType != model.WebhookEventTest {
+		errorMessage := "subscription is inactive"
+		delivery.Status = model.WebhookDeliveryStatusFailed
+		delivery.ErrorMessage = &errorMessage
+
+		return d.webhookDeliveryRepository.RecordAttempt(ctx, delivery)
+	}
+
+	body := []byte(delivery.Payload)
+	header := http.Header{}
+	header.Set(WebhookEventHeader, delivery.EventType)
+	header.Set(WebhookDeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	header.Set(WebhookTimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	header.Set(WebhookSignatureHeader, SignWebhookPayload(subscription.Secret, now.Unix(), body))
+
+	res, sendErr := d.webhookSender.Deliver(ctx, subscription.URL, header, body)
+
+	durationMs := int32(time.Since(now).Milliseconds())
+	delivery.DurationMs = &durationMs
+	delivery.ResponseStatus = nil
+	delivery.ResponseBody = nil
+	delivery.ErrorMessage = nil
+
+	var deliverErr error
+	switch {
+	case sendErr != nil:
+		deliverErr = fmt.Errorf("%w: %w", ErrWebhookNotAccepted, sendErr)
+	case !res.Succeeded():
+		deliverErr = fmt.Errorf("%w: responded with status %d", ErrWebhookNotAccepted, res.StatusCode)
+	}
+	if res != nil {
+		statusCode := int32(res.StatusCode)
+		delivery.ResponseStatus = &statusCode
+		delivery.ResponseBody = &res.Body
+	}
+
+	if deliverErr != nil {
+		errorMessage := deliverErr.Error()
+		delivery.Status = model.WebhookDeliveryStatusFailed
+		delivery.ErrorMessage = &errorMessage
+	} else {
+		delivery.Status = model.WebhookDeliveryStatusSucceeded
+		delivery.DeliveredAt = &now
+	}
+
+	if err := d.webhookDeliveryRepository.RecordAttempt(ctx, delivery); err != nil {
+		return errors.Join(deliverErr, fmt.Errorf("failed to record webhook delivery: %w", err))
+	}
+
+	return deliverErr
+}
+
+// SignWebhookPayload returns the X-Webhook-Signature value: the hex HMAC-SHA256 of "<timestamp>.<body>"
+// keyed with the subscription secret. Receivers should recompute it and reject stale timestamps to
+// prevent replays.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookEvent(id string, eventType string, data any) (*model.WebhookEvent, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.WebhookEvent{
+		ID:        id,
+		Type:      eventType,
+		CreatedAt: time.Now(),
+		Data:      b,
+	}, nil
+}
@@ -0,0 +1,291 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+)
+
+type webhookDispatcherTestMocks struct {
+	subscriptionRepo *mockdomain.MockWebhookSubscriptionRepository
+	deliveryRepo     *mockdomain.MockWebhookDeliveryRepository
+	jobRepo          *mockdomain.MockJobRepository
+	webhookSender    *mockdomain.MockWebhookSender
+}
+
+func setupWebhookDispatcherTest(t *testing.T) (*webhookDispatcherTestMocks, usecase.WebhookDispatcher) {
+	ctrl := gomock.NewController(t)
+	mocks := &webhookDispatcherTestMocks{
+		subscriptionRepo: mockdomain.NewMockWebhookSubscriptionRepository(ctrl),
+		deliveryRepo:     mockdomain.NewMockWebhookDeliveryRepository(ctrl),
+		jobRepo:          mockdomain.NewMockJobRepository(ctrl),
+		webhookSender:    mockdomain.NewMockWebhookSender(ctrl),
+	}
+	dispatcher := usecase.NewWebhookDispatcher(mocks.subscriptionRepo, mocks.deliveryRepo, mocks.jobRepo, mocks.webhookSender)
+	return mocks, dispatcher
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	// echo -n '1700000000.{"id":"1"}' | openssl dgst -sha256 -hmac 'whsec_test_secret'
+	signature := usecase.SignWebhookPayload("whsec_test_secret", 1700000000, []byte(`{"id":"1"}`))
+	assert.Equal(t, "sha256=c10a092032b032422619ec21b90dd2523fd5b2be59ed619283f25aa7c1d57567", signature)
+
+	// 秘密鍵・タイムスタンプ・本文のいずれかが異なれば署名も変わる
+	assert.NotEqual(t, signature, usecase.SignWebhookPayload("whsec_other_secret", 1700000000, []byte(`{"id":"1"}`)))
+	assert.NotEqual(t, signature, usecase.SignWebhookPayload("whsec_test_secret", 1700000001, []byte(`{"id":"1"}`)))
+	assert.NotEqual(t, signature, usecase.SignWebhookPayload("whsec_test_secret", 1700000000, []byte(`{"id":"2"}`)))
+}
+
+func TestWebhookDispatcher_Publish(t *testing.T) {
+	// Setup
+	mocks, dispatcher := setupWebhookDispatcherTest(t)
+	ctx := context.Background()
+
+	mocks.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *model.Job) error {
+		assert.Equal(t, model.JobTypeWebhookEvent, job.JobType)
+
+		var event model.WebhookEvent
+		assert.NoError(t, json.Unmarshal([]byte(job.Payload), &event))
+		assert.Equal(t, "0b7c5c1e-4d0a-4f3a-9a52-2f0c8f1f6b11", event.ID)
+		assert.Equal(t, model.WebhookEventDisasterCreated, event.Type)
+		assert.JSONEq(t, `{"id":"D001"}`, string(event.Data))
+		return nil
+	})
+
+	err := dispatcher.Publish(ctx, "0b7c5c1e-4d0a-4f3a-9a52-2f0c8f1f6b11", model.WebhookEventDisasterCreated, map[string]string{"id": "D001"})
+	assert.NoError(t, err)
+}
+
+func TestWebhookDispatcher_FanOut(t *testing.T) {
+	// Setup
+	mocks, dispatcher := setupWebhookDispatcherTest(t)
+	ctx := context.Background()
+
+	event := model.WebhookEvent{ID: "0b7c5c1e-4d0a-4f3a-9a52-2f0c8f1f6b11", Type: model.WebhookEventDisasterCreated, Data: json.RawMessage(`{}`)}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(m *webhookDispatcherTestMocks)
+		expectedError bool
+	}{
+		{
+			name: "Creates And Queues A Delivery Per Subscription",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.subscriptionRepo.EXPECT().FindActiveByEventType(gomock.Any(), model.WebhookEventDisasterCreated).
+					Return([]*model.WebhookSubscription{{ID: "sub-1"}, {ID: "sub-2"}}, nil)
+				nextID := int64(0)
+				m.deliveryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(2).
+					DoAndReturn(func(_ context.Context, d *model.WebhookDelivery) error {
+						assert.Equal(t, event.ID, d.EventID)
+						assert.Equal(t, model.WebhookDeliveryStatusPending, d.Status)
+						nextID++
+						d.ID = nextID
+						return nil
+					})
+				m.jobRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Times(2).
+					DoAndReturn(func(_ context.Context, job *model.Job) error {
+						assert.Equal(t, model.JobTypeWebhookDelivery, job.JobType)
+						return nil
+					})
+			},
+			expectedError: false,
+		},
+		{
+			name: "Skips Already Delivered On Redelivery Of The Event",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.subscriptionRepo.EXPECT().FindActiveByEventType(gomock.Any(), model.WebhookEventDisasterCreated).
+					Return([]*model.WebhookSubscription{{ID: "sub-1"}}, nil)
+				m.deliveryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *model.WebhookDelivery) error {
+						d.ID = 1
+						d.Status = model.WebhookDeliveryStatusSucceeded
+						return nil
+					})
+			},
+			expectedError: false,
+		},
+		{
+			name: "Lookup Error",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.subscriptionRepo.EXPECT().FindActiveByEventType(gomock.Any(), model.WebhookEventDisasterCreated).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			err := dispatcher.FanOut(ctx, event)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookDispatcher_Deliver(t *testing.T) {
+	// Setup
+	mocks, dispatcher := setupWebhookDispatcherTest(t)
+	ctx := context.Background()
+
+	newDelivery := func(eventType string) *model.WebhookDelivery {
+		return &model.WebhookDelivery{
+			ID:             7,
+			SubscriptionID: "sub-1",
+			EventID:        "0b7c5c1e-4d0a-4f3a-9a52-2f0c8f1f6b11",
+			EventType:      eventType,
+			Payload:        `{"id":"0b7c5c1e-4d0a-4f3a-9a52-2f0c8f1f6b11"}`,
+			Status:         model.WebhookDeliveryStatusPending,
+		}
+	}
+	subscription := &model.WebhookSubscription{ID: "sub-1", URL: "https://example.com/hook", Secret: "whsec_test_secret", Active: true}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(m *webhookDispatcherTestMocks)
+		expectedError bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(7)).Return(newDelivery(model.WebhookEventDisasterCreated), nil)
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(subscription, nil)
+				m.webhookSender.EXPECT().Deliver(gomock.Any(), "https://example.com/hook", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, header http.Header, body []byte) (*model.WebhookResponse, error) {
+						timestamp, err := strconv.ParseInt(header.Get(usecase.WebhookTimestampHeader), 10, 64)
+						assert.NoError(t, err)
+						assert.Equal(t, usecase.SignWebhookPayload("whsec_test_secret", timestamp, body), header.Get(usecase.WebhookSignatureHeader))
+						assert.Equal(t, model.WebhookEventDisasterCreated, header.Get(usecase.WebhookEventHeader))
+						assert.Equal(t, "7", header.Get(usecase.WebhookDeliveryHeader))
+						return &model.WebhookResponse{StatusCode: http.StatusOK, Body: "ok"}, nil
+					})
+				m.deliveryRepo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *model.WebhookDelivery) error {
+						assert.Equal(t, model.WebhookDeliveryStatusSucceeded, d.Status)
+						assert.Equal(t, int32(1), d.Attempts)
+						assert.Equal(t, int32(http.StatusOK), *d.ResponseStatus)
+						assert.NotNil(t, d.DeliveredAt)
+						return nil
+					})
+			},
+			expectedError: false,
+		},
+		{
+			name: "Rejected By Endpoint",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(7)).Return(newDelivery(model.WebhookEventDisasterCreated), nil)
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(subscription, nil)
+				m.webhookSender.EXPECT().Deliver(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&model.WebhookResponse{StatusCode: http.StatusServiceUnavailable, Body: "maintenance"}, nil)
+				m.deliveryRepo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *model.WebhookDelivery) error {
+						assert.Equal(t, model.WebhookDeliveryStatusFailed, d.Status)
+						assert.Equal(t, "maintenance", *d.ResponseBody)
+						assert.NotNil(t, d.ErrorMessage)
+						assert.Nil(t, d.DeliveredAt)
+						return nil
+					})
+			},
+			expectedError: true,
+		},
+		{
+			name: "Connection Error",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(7)).Return(newDelivery(model.WebhookEventDisasterCreated), nil)
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(subscription, nil)
+				m.webhookSender.EXPECT().Deliver(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+				m.deliveryRepo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *model.WebhookDelivery) error {
+						assert.Equal(t, model.WebhookDeliveryStatusFailed, d.Status)
+						assert.Nil(t, d.ResponseStatus)
+						return nil
+					})
+			},
+			expectedError: true,
+		},
+		{
+			name: "Inactive Subscription",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(7)).Return(newDelivery(model.WebhookEventDisasterCreated), nil)
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").
+					Return(&model.WebhookSubscription{ID: "sub-1", URL: "https://example.com/hook", Active: false}, nil)
+				m.deliveryRepo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *model.WebhookDelivery) error {
+						assert.Equal(t, model.WebhookDeliveryStatusFailed, d.Status)
+						return nil
+					})
+			},
+			expectedError: false,
+		},
+		{
+			name: "Test Event To Inactive Subscription",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(7)).Return(newDelivery(model.WebhookEventTest), nil)
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").
+					Return(&model.WebhookSubscription{ID: "sub-1", URL: "https://example.com/hook", Active: false}, nil)
+				m.webhookSender.EXPECT().Deliver(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&model.WebhookResponse{StatusCode: http.StatusNoContent}, nil)
+				m.deliveryRepo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedError: false,
+		},
+		{
+			name: "Already Delivered",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				delivery := newDelivery(model.WebhookEventDisasterCreated)
+				delivery.Status = model.WebhookDeliveryStatusSucceeded
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(7)).Return(delivery, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name: "Deleted With Subscription",
+			mockSetup: func(m *webhookDispatcherTestMocks) {
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(7)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedError: false,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			err := dispatcher.Deliver(ctx, 7)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+//go:generate mockgen -source=webhook_event_subscriber.go -destination=../../tests/mock/usecase/webhook_event_subscriber.mock.go
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+)
+
+// approvedApplicationStatus is the status that raises the support_application.approved webhook event
+const approvedApplicationStatus = "承認済"
+
+// webhookEventNamespace is the UUID namespace the webhook event IDs are derived from
+var webhookEventNamespace = uuid.MustParse("6f0f5d0e-6a3b-4c55-9d57-0c6f3c1c2b8e")
+
+// WebhookEventSubscriber turns the domain events relayed from the outbox into webhook events. The webhook event ID
+// is derived from the outbox event ID, so an event relayed again is delivered under the same ID.
+type WebhookEventSubscriber interface {
+	OnDisasterCreated(ctx context.Context, eventID int64, event model.DisasterCreatedEvent) error
+	OnDisasterStatusChanged(ctx context.Context, eventID int64, event model.DisasterStatusChangedEvent) error
+	// OnApplicationTransitioned publishes support_application.approved when the application entered 承認済
+	OnApplicationTransitioned(ctx context.Context, eventID int64, event model.ApplicationTransitionedEvent) error
+}
+
+type webhookEventSubscriber struct {
+	webhookDispatcher WebhookDispatcher
+}
+
+func NewWebhookEventSubscriber(webhookDispatcher WebhookDispatcher) WebhookEventSubscriber {
+	return &webhookEventSubscriber{
+		webhookDispatcher: webhookDispatcher,
+	}
+}
+
+func (s *webhookEventSubscriber) OnDisasterCreated(ctx context.Context, eventID int64, event model.DisasterCreatedEvent) error {
+	ctx, span := startSpan(ctx, "WebhookEventSubscriber.OnDisasterCreated")
+	defer span.End()
+
+	return s.webhookDispatcher.Publish(ctx, WebhookEventID(eventID), model.WebhookEventDisasterCreated, &event)
+}
+
+func (s *webhookEventSubscriber) OnDisasterStatusChanged(ctx context.Context, eventID int64, event model.DisasterStatusChangedEvent) error {
+	ctx, span := startSpan(ctx, "WebhookEventSubscriber.OnDisasterStatusChanged")
+	defer span.End()
+
+	return s.webhookDispatcher.Publish(ctx, WebhookEventID(eventID), model.WebhookEventDisasterStatusChanged, &event)
+}
+
+// supportApplicationWebhookData is the data of the support application webhook events
+type supportApplicationWebhookData struct {
+	ApplicationID   string    `json:"application_id"`
+	ApplicationDate time.Time `json:"application_date"`
+	ApplicantName   string    `json:"applicant_name"`
+	DisasterName    string    `json:"disaster_name"`
+	RequestedAmount int64     `json:"requested_amount"`
+	Status          string    `json:"status"`
+}
+
+func (s *webhookEventSubscriber) OnApplicationTransitioned(ctx context.Context, eventID int64, event model.ApplicationTransitionedEvent) error {
+	ctx, span := startSpan(ctx, "WebhookEventSubscriber.OnApplicationTransitioned")
+	defer span.End()
+
+	if event.Status != approvedApplicationStatus {
+		return nil
+	}
+
+	return s.webhookDispatcher.Publish(ctx, WebhookEventID(eventID), model.WebhookEventSupportApplicationApproved, &supportApplicationWebhookData{
+		ApplicationID:   event.ApplicationID,
+		ApplicationDate: event.ApplicationDate,
+		ApplicantName:   event.ApplicantName,
+		DisasterName:    event.DisasterName,
+		RequestedAmount: event.RequestedAmount,
+		Status:          event.Status,
+	})
+}
+
+// WebhookEventID returns the ID of the webhook event raised by the outbox event
+func WebhookEventID(outboxEventID int64) string {
+	return uuid.NewSHA1(webhookEventNamespace, []byte(strconv.FormatInt(outboxEventID, 10))).String()
+}
@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
+)
+
+func setupWebhookEventSubscriberTest(t *testing.T) (*mockusecase.MockWebhookDispatcher, usecase.WebhookEventSubscriber) {
+	ctrl := gomock.NewController(t)
+	mockDispatcher := mockusecase.NewMockWebhookDispatcher(ctrl)
+	subscriber := usecase.NewWebhookEventSubscriber(mockDispatcher)
+	return mockDispatcher, subscriber
+}
+
+func TestWebhookEventSubscriber_OnDisasterStatusChanged(t *testing.T) {
+	// Setup
+	mockDispatcher, subscriber := setupWebhookEventSubscriberTest(t)
+	ctx := context.Background()
+
+	event := model.DisasterStatusChangedEvent{ID: "d1", Name: "台風被害", Status: "completed", PreviousStatus: "in_progress"}
+
+	// Setup mock
+	mockDispatcher.EXPECT().Publish(gomock.Any(), usecase.WebhookEventID(1), model.WebhookEventDisasterStatusChanged, &event).Return(nil)
+
+	// Call the method
+	err := subscriber.OnDisasterStatusChanged(ctx, 1, event)
+
+	// Check results
+	assert.NoError(t, err)
+}
+
+func TestWebhookEventSubscriber_OnApplicationTransitioned(t *testing.T) {
+	// Setup
+	mockDispatcher, subscriber := setupWebhookEventSubscriberTest(t)
+	ctx := context.Background()
+
+	previousStatus := "審査中"
+
+	// Test cases
+	tests := []struct {
+		name      string
+		event     model.ApplicationTransitionedEvent
+		mockSetup func(mockDispatcher *mockusecase.MockWebhookDispatcher)
+	}{
+		{
+			name: "Approved",
+			event: model.ApplicationTransitionedEvent{
+				ApplicationID:   "SA-1",
+				ApplicationDate: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+				ApplicantName:   "山田太郎",
+				DisasterName:    "台風被害",
+				RequestedAmount: 1000000,
+				PreviousStatus:  &previousStatus,
+				Status:          "承認済",
+			},
+			mockSetup: func(mockDispatcher *mockusecase.MockWebhookDispatcher) {
+				mockDispatcher.EXPECT().Publish(gomock.Any(), usecase.WebhookEventID(1), model.WebhookEventSupportApplicationApproved, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Not Approved",
+			event: model.ApplicationTransitionedEvent{
+				ApplicationID:  "SA-1",
+				PreviousStatus: &previousStatus,
+				Status:         "却下",
+			},
+			mockSetup: func(mockDispatcher *mockusecase.MockWebhookDispatcher) {},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockDispatcher)
+
+			// Call the method
+			err := subscriber.OnApplicationTransitioned(ctx, 1, tt.event)
+
+			// Check results
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhookEventID(t *testing.T) {
+	// 同じアウトボックスイベントを再配信しても同じIDになる
+	assert.Equal(t, usecase.WebhookEventID(1), usecase.WebhookEventID(1))
+	assert.NotEqual(t, usecase.WebhookEventID(1), usecase.WebhookEventID(2))
+
+	_, err := uuid.Parse(usecase.WebhookEventID(1))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,254 @@
+//go:generate mockgen -source=webhook_usecase.go -destination=../../tests/mock/usecase/webhook_usecase.mock.go
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	domain "github.com/AI1411/fullstack-react-go/internal/domain/repository"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+)
+
+const (
+	webhookSecretPrefix       = "whsec_"
+	minWebhookSecretLength    = 16
+	defaultWebhookDeliveryLog = 50
+	maxWebhookDeliveryLog     = 200
+)
+
+// UpdateWebhookSubscriptionParams holds the fields to change. Nil fields are left as is.
+type UpdateWebhookSubscriptionParams struct {
+	URL         *string
+	EventTypes  []string
+	Description *string
+	Active      *bool
+}
+
+// WebhookUseCase manages webhook subscriptions and their delivery logs
+type WebhookUseCase interface {
+	ListSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error)
+	// CreateSubscription stores the subscription, generating a secret when none is given
+	CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) error
+	UpdateSubscription(ctx context.Context, id string, params *UpdateWebhookSubscriptionParams) (*model.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	// ListDeliveries returns the latest deliveries of the subscription, newest first
+	ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*model.WebhookDelivery, error)
+	// SendTestEvent posts a webhook.test event to the subscription right away and returns the logged delivery.
+	// A delivery the endpoint rejected is not an error; its status and response are in the result.
+	SendTestEvent(ctx context.Context, subscriptionID string) (*model.WebhookDelivery, error)
+}
+
+type webhookUseCase struct {
+	webhookSubscriptionRepository domain.WebhookSubscriptionRepository
+	webhookDeliveryRepository     domain.WebhookDeliveryRepository
+	webhookDispatcher             WebhookDispatcher
+}
+
+func NewWebhookUseCase(
+	webhookSubscriptionRepository domain.WebhookSubscriptionRepository,
+	webhookDeliveryRepository domain.WebhookDeliveryRepository,
+	webhookDispatcher WebhookDispatcher,
+) WebhookUseCase {
+	return &webhookUseCase{
+		webhookSubscriptionRepository: webhookSubscriptionRepository,
+		webhookDeliveryRepository:     webhookDeliveryRepository,
+		webhookDispatcher:             webhookDispatcher,
+	}
+}
+
+func (u *webhookUseCase) ListSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	ctx, span := startSpan(ctx, "WebhookUseCase.ListSubscriptions")
+	defer span.End()
+
+	return u.webhookSubscriptionRepository.Find(ctx)
+}
+
+func (u *webhookUseCase) GetSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	ctx, span := startSpan(ctx, "WebhookUseCase.GetSubscription")
+	defer span.End()
+
+	subscription, err := u.webhookSubscriptionRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateWebhookSubscriptionError(err)
+	}
+
+	return subscription, nil
+}
+
+func (u *webhookUseCase) CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	ctx, span := startSpan(ctx, "WebhookUseCase.CreateSubscription")
+	defer span.End()
+
+	if err := validateWebhookSubscription(subscription.URL, subscription.Events(), &subscription.Secret); err != nil {
+		return err
+	}
+
+	if subscription.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		subscription.Secret = secret
+	}
+	subscription.Active = true
+
+	return u.webhookSubscriptionRepository.Create(ctx, subscription)
+}
+
+func (u *webhookUseCase) UpdateSubscription(
+	ctx context.Context,
+	id string,
+	params *UpdateWebhookSubscriptionParams,
+) (*model.WebhookSubscription, error) {
+	ctx, span := startSpan(ctx, "WebhookUseCase.UpdateSubscription")
+	defer span.End()
+
+	subscription, err := u.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.URL != nil {
+		subscription.URL = *params.URL
+	}
+	if params.EventTypes != nil {
+		subscription.SetEvents(params.EventTypes)
+	}
+	if params.Description != nil {
+		subscription.Description = params.Description
+	}
+	if params.Active != nil {
+		subscription.Active = *params.Active
+	}
+
+	if err := validateWebhookSubscription(subscription.URL, subscription.Events(), nil); err != nil {
+		return nil, err
+	}
+
+	subscription.UpdatedAt = time.Now()
+	if err := u.webhookSubscriptionRepository.Update(ctx, subscription); err != nil {
+		return nil, translateWebhookSubscriptionError(err)
+	}
+
+	return subscription, nil
+}
+
+func (u *webhookUseCase) DeleteSubscription(ctx context.Context, id string) error {
+	ctx, span := startSpan(ctx, "WebhookUseCase.DeleteSubscription")
+	defer span.End()
+
+	return translateWebhookSubscriptionError(u.webhookSubscriptionRepository.Delete(ctx, id))
+}
+
+func (u *webhookUseCase) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*model.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "WebhookUseCase.ListDeliveries")
+	defer span.End()
+
+	if limit < 0 || limit > maxWebhookDeliveryLog {
+		return nil, myerrors.NewValidationError(myerrors.NewFieldError("limit", myerrors.FieldRange, 1, maxWebhookDeliveryLog))
+	}
+	if limit == 0 {
+		limit = defaultWebhookDeliveryLog
+	}
+
+	if _, err := u.GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	return u.webhookDeliveryRepository.FindBySubscriptionID(ctx, subscriptionID, limit)
+}
+
+func (u *webhookUseCase) SendTestEvent(ctx context.Context, subscriptionID string) (*model.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "WebhookUseCase.SendTestEvent")
+	defer span.End()
+
+	subscription, err := u.GetSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := newWebhookEvent(uuid.NewString(), model.WebhookEventTest, map[string]string{
+		"subscription_id": subscription.ID,
+		"message":         "これはテストイベントです",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &model.WebhookDelivery{
+		SubscriptionID: subscription.ID,
+		EventID:        event.ID,
+		EventType:      event.Type,
+		Payload:        string(payload),
+		Status:         model.WebhookDeliveryStatusPending,
+	}
+	if err := u.webhookDeliveryRepository.Create(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	if err := u.webhookDispatcher.Deliver(ctx, delivery.ID); err != nil && !errors.Is(err, ErrWebhookNotAccepted) {
+		return nil, err
+	}
+
+	return u.webhookDeliveryRepository.FindByID(ctx, delivery.ID)
+}
+
+func validateWebhookSubscription(rawURL string, eventTypes []string, secret *string) error {
+	var fields []myerrors.FieldError
+	if !isHTTPURL(&rawURL) {
+		fields = append(fields, myerrors.NewFieldError("url", myerrors.FieldURL))
+	}
+	if len(eventTypes) == 0 {
+		fields = append(fields, myerrors.NewFieldError("event_types", myerrors.FieldMinItems, 1))
+	}
+	for i, eventType := range eventTypes {
+		if !model.IsValidWebhookEventType(eventType) {
+			fields = append(fields, myerrors.NewFieldError(
+				fmt.Sprintf("event_types[%d]", i),
+				myerrors.FieldOneOf,
+				"disaster.created, disaster.status_changed, support_application.approved",
+			))
+		}
+	}
+	if secret != nil && *secret != "" && len(*secret) < minWebhookSecretLength {
+		fields = append(fields, myerrors.NewFieldError("secret", myerrors.FieldMinLength, minWebhookSecretLength))
+	}
+
+	if len(fields) > 0 {
+		return myerrors.NewValidationError(fields...)
+	}
+
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return webhookSecretPrefix + hex.EncodeToString(b), nil
+}
+
+func translateWebhookSubscriptionError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return myerrors.NewAPIError(myerrors.WebhookSubscriptionNotFound, myerrors.WebhookSubscriptionNotFoundErrorMessage, err, "webhook subscription not found")
+	}
+
+	return err
+}
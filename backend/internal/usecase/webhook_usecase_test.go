@@ -0,0 +1,338 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/AI1411/fullstack-react-go/internal/domain/model"
+	myerrors "github.com/AI1411/fullstack-react-go/internal/errors"
+	"github.com/AI1411/fullstack-react-go/internal/usecase"
+	mockdomain "github.com/AI1411/fullstack-react-go/tests/mock/domain"
+	mockusecase "github.com/AI1411/fullstack-react-go/tests/mock/usecase"
+)
+
+type webhookTestMocks struct {
+	subscriptionRepo *mockdomain.MockWebhookSubscriptionRepository
+	deliveryRepo     *mockdomain.MockWebhookDeliveryRepository
+	dispatcher       *mockusecase.MockWebhookDispatcher
+}
+
+func setupWebhookTest(t *testing.T) (*webhookTestMocks, usecase.WebhookUseCase) {
+	ctrl := gomock.NewController(t)
+	mocks := &webhookTestMocks{
+		subscriptionRepo: mockdomain.NewMockWebhookSubscriptionRepository(ctrl),
+		deliveryRepo:     mockdomain.NewMockWebhookDeliveryRepository(ctrl),
+		dispatcher:       mockusecase.NewMockWebhookDispatcher(ctrl),
+	}
+	useCase := usecase.NewWebhookUseCase(mocks.subscriptionRepo, mocks.deliveryRepo, mocks.dispatcher)
+	return mocks, useCase
+}
+
+func newWebhookSubscriptionFixture(secret string, eventTypes ...string) *model.WebhookSubscription {
+	subscription := &model.WebhookSubscription{URL: "https://example.com/hook", Secret: secret}
+	subscription.SetEvents(eventTypes)
+
+	return subscription
+}
+
+func TestWebhookUseCase_CreateSubscription(t *testing.T) {
+	// Setup
+	mocks, useCase := setupWebhookTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name           string
+		subscription   *model.WebhookSubscription
+		mockSetup      func(m *webhookTestMocks)
+		expectedError  bool
+		expectedFields []string
+	}{
+		{
+			name:         "Generates Secret",
+			subscription: newWebhookSubscriptionFixture("", model.WebhookEventDisasterCreated),
+			mockSetup: func(m *webhookTestMocks) {
+				m.subscriptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *model.WebhookSubscription) error {
+						assert.True(t, strings.HasPrefix(s.Secret, "whsec_"))
+						assert.Len(t, s.Secret, len("whsec_")+64)
+						assert.True(t, s.Active)
+						return nil
+					})
+			},
+			expectedError: false,
+		},
+		{
+			name:         "Keeps Given Secret",
+			subscription: newWebhookSubscriptionFixture("my-own-secret-value", model.WebhookEventSupportApplicationApproved),
+			mockSetup: func(m *webhookTestMocks) {
+				m.subscriptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *model.WebhookSubscription) error {
+						assert.Equal(t, "my-own-secret-value", s.Secret)
+						return nil
+					})
+			},
+			expectedError: false,
+		},
+		{
+			name: "Invalid",
+			subscription: func() *model.WebhookSubscription {
+				s := newWebhookSubscriptionFixture("short", "disaster.deleted")
+				s.URL = "ftp://example.com"
+				return s
+			}(),
+			mockSetup:      func(m *webhookTestMocks) {},
+			expectedError:  true,
+			expectedFields: []string{"url", "event_types[0]", "secret"},
+		},
+		{
+			name:           "No Event Types",
+			subscription:   newWebhookSubscriptionFixture(""),
+			mockSetup:      func(m *webhookTestMocks) {},
+			expectedError:  true,
+			expectedFields: []string{"event_types"},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			err := useCase.CreateSubscription(ctx, tt.subscription)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				if tt.expectedFields != nil {
+					assertValidationFields(t, err, tt.expectedFields)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_UpdateSubscription(t *testing.T) {
+	// Setup
+	mocks, useCase := setupWebhookTest(t)
+	ctx := context.Background()
+
+	inactive := false
+
+	// Test cases
+	tests := []struct {
+		name          string
+		params        *usecase.UpdateWebhookSubscriptionParams
+		mockSetup     func(m *webhookTestMocks)
+		expectedError bool
+		expectedCode  myerrors.ErrorCode
+	}{
+		{
+			name: "Success",
+			params: &usecase.UpdateWebhookSubscriptionParams{
+				EventTypes: []string{model.WebhookEventDisasterStatusChanged},
+				Active:     &inactive,
+			},
+			mockSetup: func(m *webhookTestMocks) {
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").
+					Return(newWebhookSubscriptionFixture("my-own-secret-value", model.WebhookEventDisasterCreated), nil)
+				m.subscriptionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *model.WebhookSubscription) error {
+						assert.Equal(t, []string{model.WebhookEventDisasterStatusChanged}, s.Events())
+						assert.False(t, s.Active)
+						return nil
+					})
+			},
+			expectedError: false,
+		},
+		{
+			name:   "Not Found",
+			params: &usecase.UpdateWebhookSubscriptionParams{Active: &inactive},
+			mockSetup: func(m *webhookTestMocks) {
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedError: true,
+			expectedCode:  myerrors.WebhookSubscriptionNotFound,
+		},
+		{
+			name:   "Invalid Event Type",
+			params: &usecase.UpdateWebhookSubscriptionParams{EventTypes: []string{"user.created"}},
+			mockSetup: func(m *webhookTestMocks) {
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").
+					Return(newWebhookSubscriptionFixture("my-own-secret-value", model.WebhookEventDisasterCreated), nil)
+			},
+			expectedError: true,
+			expectedCode:  myerrors.ValidationError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			subscription, err := useCase.UpdateSubscription(ctx, "sub-1", tt.params)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+
+				var apiErr *myerrors.APIError
+				if assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, tt.expectedCode, apiErr.Code)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_ListDeliveries(t *testing.T) {
+	// Setup
+	mocks, useCase := setupWebhookTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		limit         int
+		mockSetup     func(m *webhookTestMocks)
+		expectedError bool
+	}{
+		{
+			name:  "Default Limit",
+			limit: 0,
+			mockSetup: func(m *webhookTestMocks) {
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(&model.WebhookSubscription{ID: "sub-1"}, nil)
+				m.deliveryRepo.EXPECT().FindBySubscriptionID(gomock.Any(), "sub-1", 50).
+					Return([]*model.WebhookDelivery{{ID: 2}, {ID: 1}}, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name:          "Limit Too Large",
+			limit:         201,
+			mockSetup:     func(m *webhookTestMocks) {},
+			expectedError: true,
+		},
+		{
+			name:  "Subscription Not Found",
+			limit: 10,
+			mockSetup: func(m *webhookTestMocks) {
+				m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			deliveries, err := useCase.ListDeliveries(ctx, "sub-1", tt.limit)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, deliveries)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, deliveries, 2)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_SendTestEvent(t *testing.T) {
+	// Setup
+	mocks, useCase := setupWebhookTest(t)
+	ctx := context.Background()
+
+	expectCreate := func(m *webhookTestMocks) {
+		m.subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(&model.WebhookSubscription{ID: "sub-1"}, nil)
+		m.deliveryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, d *model.WebhookDelivery) error {
+				assert.Equal(t, model.WebhookEventTest, d.EventType)
+				d.ID = 9
+				return nil
+			})
+	}
+
+	// Test cases
+	tests := []struct {
+		name           string
+		mockSetup      func(m *webhookTestMocks)
+		expectedError  bool
+		expectedStatus string
+	}{
+		{
+			name: "Delivered",
+			mockSetup: func(m *webhookTestMocks) {
+				expectCreate(m)
+				m.dispatcher.EXPECT().Deliver(gomock.Any(), int64(9)).Return(nil)
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(9)).
+					Return(&model.WebhookDelivery{ID: 9, Status: model.WebhookDeliveryStatusSucceeded}, nil)
+			},
+			expectedError:  false,
+			expectedStatus: model.WebhookDeliveryStatusSucceeded,
+		},
+		{
+			name: "Rejected By Endpoint",
+			mockSetup: func(m *webhookTestMocks) {
+				expectCreate(m)
+				m.dispatcher.EXPECT().Deliver(gomock.Any(), int64(9)).
+					Return(fmt.Errorf("%w: responded with status 500", usecase.ErrWebhookNotAccepted))
+				m.deliveryRepo.EXPECT().FindByID(gomock.Any(), int64(9)).
+					Return(&model.WebhookDelivery{ID: 9, Status: model.WebhookDeliveryStatusFailed}, nil)
+			},
+			expectedError:  false,
+			expectedStatus: model.WebhookDeliveryStatusFailed,
+		},
+		{
+			name: "Record Error",
+			mockSetup: func(m *webhookTestMocks) {
+				expectCreate(m)
+				m.dispatcher.EXPECT().Deliver(gomock.Any(), int64(9)).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			delivery, err := useCase.SendTestEvent(ctx, "sub-1")
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, delivery)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, delivery.Status)
+			}
+		})
+	}
+}
@@ -120,7 +120,7 @@ COMMENT ON COLUMN unit_prices.id IS '単価ID（主キー、自動掲番）';
 COMMENT ON COLUMN unit_prices.category_id IS '工種区分ID（外部キー、work_categoriesテーブルのID）';
 COMMENT ON COLUMN unit_prices.prefecture_code IS '都道府県コード（外部キー、prefecturesテーブルのコード）';
 COMMENT ON COLUMN unit_prices.unit_price IS '単価（円）';
-COMMENT ON COLUMN unit_prices.unit_type IS '単位タイプ（"per_meter", "per_sqm", "per_unit"）';
+COMMENT ON COLUMN unit_prices.unit_type IS '単位タイプ（per_meter, per_sqm, per_unit）';
 COMMENT ON COLUMN unit_prices.valid_from IS '有効開始日';
 COMMENT ON COLUMN unit_prices.valid_to IS '有効終了日';
 COMMENT ON COLUMN unit_prices.notes IS '備考';
@@ -0,0 +1,2 @@
+-- Idempotency-Keyテーブル削除
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    scope                 TEXT                     NOT NULL,
+    key                   VARCHAR(255)             NOT NULL,
+    request_method        VARCHAR(10)              NOT NULL,
+    request_path          TEXT                     NOT NULL,
+    request_fingerprint   CHAR(64)                 NOT NULL,
+    response_status       INTEGER,
+    response_content_type VARCHAR(255),
+    response_body         BYTEA,
+    expires_at            TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (scope, key)
+);
+
+-- インデックスの作成
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+
+-- コメント追加
+COMMENT ON TABLE idempotency_keys IS 'Idempotency-Keyヘッダー付きリクエストの処理結果を保持するテーブル';
+COMMENT ON COLUMN idempotency_keys.scope IS 'キーの有効範囲（リクエストしたユーザーまたはIPアドレスとパス）';
+COMMENT ON COLUMN idempotency_keys.key IS 'クライアントが指定したIdempotency-Key';
+COMMENT ON COLUMN idempotency_keys.request_method IS 'リクエストのHTTPメソッド';
+COMMENT ON COLUMN idempotency_keys.request_path IS 'リクエストのパス（クエリ文字列を含む）';
+COMMENT ON COLUMN idempotency_keys.request_fingerprint IS 'メソッド・パス・ボディから算出したSHA-256ハッシュ';
+COMMENT ON COLUMN idempotency_keys.response_status IS 'レスポンスのステータスコード（処理中はNULL）';
+COMMENT ON COLUMN idempotency_keys.response_content_type IS 'レスポンスのContent-Type';
+COMMENT ON COLUMN idempotency_keys.response_body IS 'レスポンスボディ';
+COMMENT ON COLUMN idempotency_keys.expires_at IS 'キーの有効期限';
+COMMENT ON COLUMN idempotency_keys.created_at IS '作成日時';
+COMMENT ON COLUMN idempotency_keys.updated_at IS '更新日時';
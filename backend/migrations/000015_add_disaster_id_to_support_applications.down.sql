@@ -0,0 +1,2 @@
+-- 支援申請の災害IDを削除
+ALTER TABLE support_applications DROP COLUMN IF EXISTS disaster_id;
@@ -0,0 +1,16 @@
+-- 災害の削除時に支払済みの申請を確認できるよう、支援申請と災害をIDで紐付ける
+ALTER TABLE support_applications
+    ADD COLUMN disaster_id UUID REFERENCES disasters (id);
+
+-- インデックスの作成
+CREATE INDEX idx_support_applications_disaster_id ON support_applications (disaster_id);
+
+-- 既存の申請は災害名が一意に一致する災害に紐付ける
+UPDATE support_applications sa
+SET disaster_id = d.id
+FROM disasters d
+WHERE d.name = sa.disaster_name
+  AND (SELECT COUNT(*) FROM disasters same_name WHERE same_name.name = sa.disaster_name) = 1;
+
+-- コメント追加
+COMMENT ON COLUMN support_applications.disaster_id IS '災害ID - 対象となる災害（災害マスタのID、未登録の災害はNULL）';
@@ -0,0 +1,3 @@
+-- 通知配信設定テーブル削除
+DROP TABLE IF EXISTS notification_digest_entries;
+DROP TABLE IF EXISTS notification_preferences;
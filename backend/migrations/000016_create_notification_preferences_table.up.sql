@@ -0,0 +1,58 @@
+CREATE TABLE IF NOT EXISTS notification_preferences
+(
+    user_id           UUID                     NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    notification_type VARCHAR(50)              NOT NULL,
+    CHECK (notification_type IN ('システム', '災害情報', '査定', '申請', 'リマインダー', 'その他')),
+    in_app            BOOLEAN                  NOT NULL DEFAULT TRUE,
+    email             BOOLEAN                  NOT NULL DEFAULT FALSE,
+    email_frequency   VARCHAR(20)              NOT NULL DEFAULT 'immediate',
+    CHECK (email_frequency IN ('immediate', 'daily_digest')),
+    webhook           BOOLEAN                  NOT NULL DEFAULT FALSE,
+    webhook_url       VARCHAR(2048),
+    CHECK (NOT webhook OR webhook_url IS NOT NULL),
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, notification_type)
+);
+
+CREATE TABLE IF NOT EXISTS notification_digest_entries
+(
+    id                BIGSERIAL PRIMARY KEY,
+    user_id           UUID                     NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    notification_type VARCHAR(50)              NOT NULL,
+    title             VARCHAR(200)             NOT NULL,
+    message           TEXT                     NOT NULL,
+    sent_at           TIMESTAMP WITH TIME ZONE,
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- インデックスの作成
+CREATE INDEX idx_notification_digest_entries_pending ON notification_digest_entries (user_id, id) WHERE sent_at IS NULL;
+
+-- 更新日時を自動更新するトリガー
+CREATE TRIGGER update_notification_preferences_updated_at
+    BEFORE UPDATE
+    ON notification_preferences
+    FOR EACH ROW
+EXECUTE FUNCTION update_master_updated_at_column();
+
+-- コメント追加
+COMMENT ON TABLE notification_preferences IS '通知種別ごとのユーザー配信設定テーブル（行がない種別はアプリ内通知のみ）';
+COMMENT ON COLUMN notification_preferences.user_id IS 'ユーザーID';
+COMMENT ON COLUMN notification_preferences.notification_type IS '通知種別';
+COMMENT ON COLUMN notification_preferences.in_app IS 'アプリ内通知を受け取るか';
+COMMENT ON COLUMN notification_preferences.email IS 'メール通知を受け取るか';
+COMMENT ON COLUMN notification_preferences.email_frequency IS 'メール配信頻度（immediate: 即時, daily_digest: 日次まとめ）';
+COMMENT ON COLUMN notification_preferences.webhook IS 'Webhook通知を受け取るか';
+COMMENT ON COLUMN notification_preferences.webhook_url IS 'Webhook通知の送信先URL';
+COMMENT ON COLUMN notification_preferences.created_at IS '作成日時';
+COMMENT ON COLUMN notification_preferences.updated_at IS '更新日時';
+
+COMMENT ON TABLE notification_digest_entries IS '日次まとめメールで送信待ちの通知を保持するテーブル';
+COMMENT ON COLUMN notification_digest_entries.id IS 'ID';
+COMMENT ON COLUMN notification_digest_entries.user_id IS '宛先ユーザーID';
+COMMENT ON COLUMN notification_digest_entries.notification_type IS '通知種別';
+COMMENT ON COLUMN notification_digest_entries.title IS '通知タイトル';
+COMMENT ON COLUMN notification_digest_entries.message IS '通知本文';
+COMMENT ON COLUMN notification_digest_entries.sent_at IS 'まとめメール送信日時（未送信はNULL）';
+COMMENT ON COLUMN notification_digest_entries.created_at IS '作成日時';
@@ -0,0 +1,8 @@
+-- 一斉通知テーブル削除
+DELETE FROM notifications WHERE broadcast_id IS NOT NULL;
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS chk_notifications_content;
+ALTER TABLE notifications
+    ALTER COLUMN title SET NOT NULL,
+    ALTER COLUMN message SET NOT NULL;
+ALTER TABLE notifications DROP COLUMN IF EXISTS broadcast_id;
+DROP TABLE IF EXISTS broadcast_notifications;
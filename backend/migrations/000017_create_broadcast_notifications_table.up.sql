@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS broadcast_notifications
+(
+    id                  SERIAL PRIMARY KEY,
+    title               VARCHAR(200)             NOT NULL,
+    message             TEXT                     NOT NULL,
+    notification_type   VARCHAR(50)              NOT NULL,
+    CHECK (notification_type IN ('システム', '災害情報', '査定', '申請', 'リマインダー', 'その他')),
+    related_entity_type VARCHAR(50),
+    related_entity_id   VARCHAR(100),
+    target_type         VARCHAR(20)              NOT NULL,
+    CHECK (target_type IN ('all', 'role', 'organization', 'prefecture', 'region')),
+    target_id           VARCHAR(20),
+    recipient_count     INTEGER                  NOT NULL DEFAULT 0,
+    created_by          UUID                     REFERENCES users (id) ON DELETE SET NULL,
+    created_at          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 一斉通知の受信者はnotificationsに既読状態のみを持ち、本文はbroadcast_notificationsを参照する
+ALTER TABLE notifications
+    ADD COLUMN broadcast_id INTEGER REFERENCES broadcast_notifications (id) ON DELETE CASCADE;
+ALTER TABLE notifications
+    ALTER COLUMN title DROP NOT NULL,
+    ALTER COLUMN message DROP NOT NULL;
+ALTER TABLE notifications
+    ADD CONSTRAINT chk_notifications_content CHECK (broadcast_id IS NOT NULL OR (title IS NOT NULL AND message IS NOT NULL));
+
+-- インデックスの作成
+CREATE UNIQUE INDEX idx_notifications_broadcast_id_user_id ON notifications (broadcast_id, user_id) WHERE broadcast_id IS NOT NULL;
+CREATE INDEX idx_broadcast_notifications_created_at ON broadcast_notifications (created_at);
+
+-- コメント追加
+COMMENT ON TABLE broadcast_notifications IS '一斉通知テーブル - 複数ユーザーに送る通知の本文を1件だけ保持';
+COMMENT ON COLUMN broadcast_notifications.id IS '一斉通知ID';
+COMMENT ON COLUMN broadcast_notifications.title IS 'タイトル';
+COMMENT ON COLUMN broadcast_notifications.message IS 'メッセージ';
+COMMENT ON COLUMN broadcast_notifications.notification_type IS '通知種別';
+COMMENT ON COLUMN broadcast_notifications.related_entity_type IS '関連エンティティ種別';
+COMMENT ON COLUMN broadcast_notifications.related_entity_id IS '関連エンティティID';
+COMMENT ON COLUMN broadcast_notifications.target_type IS '配信対象の種類（all: 全ユーザー, role: ロール, organization: 組織配下, prefecture: 都道府県, region: 地方農政局管内）';
+COMMENT ON COLUMN broadcast_notifications.target_id IS '配信対象のID（ロールID・組織ID・都道府県コード。allの場合はNULL）';
+COMMENT ON COLUMN broadcast_notifications.recipient_count IS '受信者数';
+COMMENT ON COLUMN broadcast_notifications.created_by IS '送信者のユーザーID';
+COMMENT ON COLUMN broadcast_notifications.created_at IS '作成日時';
+COMMENT ON COLUMN notifications.broadcast_id IS '一斉通知ID - 一斉通知の受信者の場合に本文の参照先となる';
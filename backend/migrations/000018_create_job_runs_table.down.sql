@@ -0,0 +1,2 @@
+-- 定期ジョブ実行履歴テーブル削除
+DROP TABLE IF EXISTS job_runs;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS job_runs
+(
+    id              BIGSERIAL PRIMARY KEY,
+    job_name        VARCHAR(100)             NOT NULL,
+    scheduled_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    status          VARCHAR(20)              NOT NULL DEFAULT 'running',
+    CHECK (status IN ('running', 'succeeded', 'failed')),
+    hostname        VARCHAR(255)             NOT NULL,
+    processed_count INTEGER                  NOT NULL DEFAULT 0,
+    error_message   TEXT,
+    started_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at     TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT uq_job_runs_job_name_scheduled_at UNIQUE (job_name, scheduled_at)
+);
+
+-- インデックスの作成
+CREATE INDEX idx_job_runs_started_at ON job_runs (started_at);
+
+-- コメント追加
+COMMENT ON TABLE job_runs IS '定期ジョブの実行履歴テーブル';
+COMMENT ON COLUMN job_runs.id IS '実行ID';
+COMMENT ON COLUMN job_runs.job_name IS 'ジョブ名';
+COMMENT ON COLUMN job_runs.scheduled_at IS '実行予定日時（同じ予定日時のジョブは1回だけ実行する）';
+COMMENT ON COLUMN job_runs.status IS 'ステータス（running, succeeded, failed）';
+COMMENT ON COLUMN job_runs.hostname IS '実行したAPIインスタンスのホスト名';
+COMMENT ON COLUMN job_runs.processed_count IS '処理件数';
+COMMENT ON COLUMN job_runs.error_message IS '失敗時のエラーメッセージ';
+COMMENT ON COLUMN job_runs.started_at IS '開始日時';
+COMMENT ON COLUMN job_runs.finished_at IS '終了日時';
@@ -0,0 +1,2 @@
+-- 支援申請の申請者ユーザーIDを削除
+ALTER TABLE support_applications DROP COLUMN IF EXISTS applicant_user_id;
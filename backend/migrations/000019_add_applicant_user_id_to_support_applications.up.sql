@@ -0,0 +1,9 @@
+-- 申請者へのリマインダーのため、支援申請と申請者ユーザーを紐付ける
+ALTER TABLE support_applications
+    ADD COLUMN applicant_user_id UUID REFERENCES users (id) ON DELETE SET NULL;
+
+-- インデックスの作成
+CREATE INDEX idx_support_applications_applicant_user_id ON support_applications (applicant_user_id);
+
+-- コメント追加
+COMMENT ON COLUMN support_applications.applicant_user_id IS '申請者ユーザーID - リマインダー等の通知先（未登録の申請者はNULL）';
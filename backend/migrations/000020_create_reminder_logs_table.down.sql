@@ -0,0 +1,2 @@
+-- リマインダー送信履歴テーブル削除
+DROP TABLE IF EXISTS reminder_logs;
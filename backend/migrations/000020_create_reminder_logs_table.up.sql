@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS reminder_logs
+(
+    id                  BIGSERIAL PRIMARY KEY,
+    user_id             UUID                     NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    related_entity_type VARCHAR(50)              NOT NULL,
+    related_entity_id   VARCHAR(100)             NOT NULL,
+    reminded_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- インデックスの作成
+CREATE INDEX idx_reminder_logs_target ON reminder_logs (user_id, related_entity_type, related_entity_id, reminded_at);
+
+-- コメント追加
+COMMENT ON TABLE reminder_logs IS 'リマインダーの送信履歴テーブル（通知チャネルの設定に関係なく、同じ対象への再送を抑止する）';
+COMMENT ON COLUMN reminder_logs.id IS 'ID';
+COMMENT ON COLUMN reminder_logs.user_id IS '宛先ユーザーID';
+COMMENT ON COLUMN reminder_logs.related_entity_type IS '対象エンティティ種別';
+COMMENT ON COLUMN reminder_logs.related_entity_id IS '対象エンティティID';
+COMMENT ON COLUMN reminder_logs.reminded_at IS 'リマインダー送信日時';
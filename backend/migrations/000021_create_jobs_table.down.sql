@@ -0,0 +1,2 @@
+-- ジョブキューテーブル削除
+DROP TABLE IF EXISTS jobs;
@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS jobs
+(
+    id           BIGSERIAL PRIMARY KEY,
+    job_type     VARCHAR(100)             NOT NULL,
+    payload      JSONB                    NOT NULL DEFAULT '{}',
+    status       VARCHAR(20)              NOT NULL DEFAULT 'pending',
+    CHECK (status IN ('pending', 'running', 'succeeded', 'dead')),
+    attempts     INTEGER                  NOT NULL DEFAULT 0,
+    max_attempts INTEGER                  NOT NULL DEFAULT 5,
+    run_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_by    VARCHAR(255),
+    locked_at    TIMESTAMP WITH TIME ZONE,
+    last_error   TEXT,
+    finished_at  TIMESTAMP WITH TIME ZONE,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- インデックスの作成
+-- ワーカーは実行可能なジョブを実行予定日時順に取り出す
+CREATE INDEX idx_jobs_pending_run_at ON jobs (run_at) WHERE status = 'pending';
+CREATE INDEX idx_jobs_running_locked_at ON jobs (locked_at) WHERE status = 'running';
+CREATE INDEX idx_jobs_status_job_type ON jobs (status, job_type);
+
+-- コメント追加
+COMMENT ON TABLE jobs IS 'バックグラウンドジョブのキューテーブル';
+COMMENT ON COLUMN jobs.id IS 'ジョブID';
+COMMENT ON COLUMN jobs.job_type IS 'ジョブ種別（welcome_email等）';
+COMMENT ON COLUMN jobs.payload IS 'ジョブの引数（JSON）';
+COMMENT ON COLUMN jobs.status IS 'ステータス（pending: 実行待ち, running: 実行中, succeeded: 成功, dead: 再試行上限に達した失敗）';
+COMMENT ON COLUMN jobs.attempts IS '実行回数';
+COMMENT ON COLUMN jobs.max_attempts IS '最大実行回数（超えるとdeadになる）';
+COMMENT ON COLUMN jobs.run_at IS '実行予定日時（再試行時はバックオフ後の日時）';
+COMMENT ON COLUMN jobs.locked_by IS '実行中のワーカーID';
+COMMENT ON COLUMN jobs.locked_at IS '実行開始日時（一定時間を過ぎたジョブは別のワーカーが再実行する）';
+COMMENT ON COLUMN jobs.last_error IS '直近の失敗時のエラーメッセージ';
+COMMENT ON COLUMN jobs.finished_at IS '終了日時（成功またはdeadになった日時）';
+COMMENT ON COLUMN jobs.created_at IS '作成日時';
+COMMENT ON COLUMN jobs.updated_at IS '更新日時';
@@ -0,0 +1,3 @@
+-- Webhook購読・配信ログテーブル削除
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,64 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id          UUID PRIMARY KEY         DEFAULT gen_random_uuid(),
+    url         VARCHAR(2048)            NOT NULL,
+    event_types JSONB                    NOT NULL DEFAULT '[]',
+    secret      VARCHAR(100)             NOT NULL,
+    description VARCHAR(255),
+    active      BOOLEAN                  NOT NULL DEFAULT TRUE,
+    created_by  UUID                     REFERENCES users (id) ON DELETE SET NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              BIGSERIAL PRIMARY KEY,
+    subscription_id UUID                     NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id        UUID                     NOT NULL,
+    event_type      VARCHAR(100)             NOT NULL,
+    payload         JSONB                    NOT NULL,
+    status          VARCHAR(20)              NOT NULL DEFAULT 'pending',
+    CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts        INTEGER                  NOT NULL DEFAULT 0,
+    response_status INTEGER,
+    response_body   TEXT,
+    error_message   TEXT,
+    duration_ms     INTEGER,
+    last_attempt_at TIMESTAMP WITH TIME ZONE,
+    delivered_at    TIMESTAMP WITH TIME ZONE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_webhook_deliveries_subscription_id_event_id UNIQUE (subscription_id, event_id)
+);
+
+-- インデックスの作成
+CREATE INDEX idx_webhook_subscriptions_event_types ON webhook_subscriptions USING GIN (event_types);
+CREATE INDEX idx_webhook_deliveries_subscription_id_id ON webhook_deliveries (subscription_id, id DESC);
+
+-- コメント追加
+COMMENT ON TABLE webhook_subscriptions IS 'Webhook購読テーブル - ドメインイベントを外部システムへ送信する';
+COMMENT ON COLUMN webhook_subscriptions.id IS '購読ID';
+COMMENT ON COLUMN webhook_subscriptions.url IS '送信先URL';
+COMMENT ON COLUMN webhook_subscriptions.event_types IS '購読するイベント種別（JSON配列）';
+COMMENT ON COLUMN webhook_subscriptions.secret IS '署名用シークレット（HMAC-SHA256）';
+COMMENT ON COLUMN webhook_subscriptions.description IS '説明';
+COMMENT ON COLUMN webhook_subscriptions.active IS '有効フラグ（falseの間は送信しない）';
+COMMENT ON COLUMN webhook_subscriptions.created_by IS '作成者ID';
+COMMENT ON COLUMN webhook_subscriptions.created_at IS '作成日時';
+COMMENT ON COLUMN webhook_subscriptions.updated_at IS '更新日時';
+
+COMMENT ON TABLE webhook_deliveries IS 'Webhook配信ログテーブル';
+COMMENT ON COLUMN webhook_deliveries.id IS '配信ID';
+COMMENT ON COLUMN webhook_deliveries.subscription_id IS '購読ID';
+COMMENT ON COLUMN webhook_deliveries.event_id IS 'イベントID（受信側の重複排除に使用）';
+COMMENT ON COLUMN webhook_deliveries.event_type IS 'イベント種別';
+COMMENT ON COLUMN webhook_deliveries.payload IS '送信するリクエストボディ';
+COMMENT ON COLUMN webhook_deliveries.status IS 'ステータス（pending: 未送信, succeeded: 成功, failed: 直近の送信が失敗）';
+COMMENT ON COLUMN webhook_deliveries.attempts IS '送信回数';
+COMMENT ON COLUMN webhook_deliveries.response_status IS '直近の送信のHTTPステータスコード';
+COMMENT ON COLUMN webhook_deliveries.response_body IS '直近の送信のレスポンスボディ（先頭のみ）';
+COMMENT ON COLUMN webhook_deliveries.error_message IS '直近の送信のエラーメッセージ';
+COMMENT ON COLUMN webhook_deliveries.duration_ms IS '直近の送信の所要時間（ミリ秒）';
+COMMENT ON COLUMN webhook_deliveries.last_attempt_at IS '直近の送信日時';
+COMMENT ON COLUMN webhook_deliveries.delivered_at IS '送信成功日時';
+COMMENT ON COLUMN webhook_deliveries.created_at IS '作成日時';
@@ -0,0 +1,2 @@
+-- アウトボックステーブル削除
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS outbox_events
+(
+    id             BIGSERIAL PRIMARY KEY,
+    event_type     VARCHAR(100)             NOT NULL,
+    aggregate_type VARCHAR(50)              NOT NULL,
+    aggregate_id   VARCHAR(255)             NOT NULL,
+    payload        JSONB                    NOT NULL DEFAULT '{}',
+    occurred_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    available_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    attempts       INTEGER                  NOT NULL DEFAULT 0,
+    last_error     TEXT,
+    published_at   TIMESTAMP WITH TIME ZONE,
+    dead_at        TIMESTAMP WITH TIME ZONE,
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- インデックスの作成
+-- リレーは未配信のイベントを登録順に取り出し、配信を諦めたイベントは取り出さない
+CREATE INDEX idx_outbox_events_unpublished ON outbox_events (available_at, id) WHERE published_at IS NULL AND dead_at IS NULL;
+CREATE INDEX idx_outbox_events_published_at ON outbox_events (published_at) WHERE published_at IS NOT NULL;
+CREATE INDEX idx_outbox_events_dead_at ON outbox_events (dead_at) WHERE dead_at IS NOT NULL;
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events (aggregate_type, aggregate_id);
+
+-- コメント追加
+COMMENT ON TABLE outbox_events IS 'ドメインイベントのアウトボックステーブル（データ変更と同じトランザクションで書き込む）';
+COMMENT ON COLUMN outbox_events.id IS 'イベントID（登録順）';
+COMMENT ON COLUMN outbox_events.event_type IS 'イベント種別（DisasterCreated等）';
+COMMENT ON COLUMN outbox_events.aggregate_type IS '集約の種類（disaster, support_application等）';
+COMMENT ON COLUMN outbox_events.aggregate_id IS '集約のID';
+COMMENT ON COLUMN outbox_events.payload IS 'イベントの内容（JSON）';
+COMMENT ON COLUMN outbox_events.occurred_at IS '発生日時';
+COMMENT ON COLUMN outbox_events.available_at IS '配信可能日時（配信中・再試行待ちの間は未来の日時になる）';
+COMMENT ON COLUMN outbox_events.attempts IS '配信回数';
+COMMENT ON COLUMN outbox_events.last_error IS '直近の配信失敗時のエラーメッセージ';
+COMMENT ON COLUMN outbox_events.published_at IS '配信完了日時（NULLは未配信）';
+COMMENT ON COLUMN outbox_events.dead_at IS '配信を諦めた日時（最大配信回数を超えて失敗した場合に設定。NULLは配信対象）';
+COMMENT ON COLUMN outbox_events.created_at IS '作成日時';
@@ -0,0 +1,2 @@
+-- レート制限テーブル削除
+DROP TABLE IF EXISTS rate_limit_buckets;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS rate_limit_buckets
+(
+    key        VARCHAR(255) PRIMARY KEY,
+    tokens     DOUBLE PRECISION         NOT NULL,
+    allowed    BOOLEAN                  NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- インデックスの作成
+CREATE INDEX idx_rate_limit_buckets_expires_at ON rate_limit_buckets (expires_at);
+
+-- コメント追加
+COMMENT ON TABLE rate_limit_buckets IS 'レート制限のトークンバケット（複数インスタンスで共有する）';
+COMMENT ON COLUMN rate_limit_buckets.key IS 'ルートグループとクライアント（ユーザーIDまたはIPアドレス）のキー';
+COMMENT ON COLUMN rate_limit_buckets.tokens IS 'updated_at時点の残りトークン数';
+COMMENT ON COLUMN rate_limit_buckets.allowed IS '直近のリクエストを許可したかどうか';
+COMMENT ON COLUMN rate_limit_buckets.updated_at IS '更新日時';
+COMMENT ON COLUMN rate_limit_buckets.expires_at IS 'バケットが満杯に戻り削除できる日時';
@@ -0,0 +1,38 @@
+// Package migrations embeds the SQL migrations so the API can tell which schema version it was built against.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.up.sql
+var files embed.FS
+
+// LatestVersion returns the version of the newest up migration, e.g. 23 for 000023_create_outbox_events_table.up.sql
+func LatestVersion() (uint, error) {
+	names, err := fs.Glob(files, "*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint
+	for _, name := range names {
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return 0, fmt.Errorf("invalid migration file name: %s", name)
+		}
+
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid migration file name: %s", name)
+		}
+
+		latest = max(latest, uint(version))
+	}
+
+	return latest, nil
+}
@@ -12,6 +12,7 @@ package mock_datastore
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
 	datastore "github.com/AI1411/fullstack-react-go/internal/infra/datastore"
@@ -43,31 +44,22 @@ func (m *MockDisasterRepository) EXPECT() *MockDisasterRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockDisasterRepository) Create(ctx context.Context, disaster *model.Disaster) error {
+func (m *MockDisasterRepository) Create(ctx context.Context, disaster *model.Disaster, events ...*model.OutboxEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, disaster)
+	varargs := []any{ctx, disaster}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockDisasterRepositoryMockRecorder) Create(ctx, disaster any) *gomock.Call {
+func (mr *MockDisasterRepositoryMockRecorder) Create(ctx, disaster any, events ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDisasterRepository)(nil).Create), ctx, disaster)
-}
-
-// Delete mocks base method.
-func (m *MockDisasterRepository) Delete(ctx context.Context, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Delete indicates an expected call of Delete.
-func (mr *MockDisasterRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDisasterRepository)(nil).Delete), ctx, id)
+	varargs := append([]any{ctx, disaster}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDisasterRepository)(nil).Create), varargs...)
 }
 
 // Find mocks base method.
@@ -100,16 +92,50 @@ func (mr *MockDisasterRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDisasterRepository)(nil).FindByID), ctx, id)
 }
 
+// LockByID mocks base method.
+func (m *MockDisasterRepository) LockByID(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockByID indicates an expected call of LockByID.
+func (mr *MockDisasterRepositoryMockRecorder) LockByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByID", reflect.TypeOf((*MockDisasterRepository)(nil).LockByID), ctx, id)
+}
+
+// SoftDelete mocks base method.
+func (m *MockDisasterRepository) SoftDelete(ctx context.Context, id string, children []model.SoftDeleteRelation) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id, children)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockDisasterRepositoryMockRecorder) SoftDelete(ctx, id, children any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockDisasterRepository)(nil).SoftDelete), ctx, id, children)
+}
+
 // Update mocks base method.
-func (m *MockDisasterRepository) Update(ctx context.Context, disaster *model.Disaster) error {
+func (m *MockDisasterRepository) Update(ctx context.Context, disaster *model.Disaster, expectedUpdatedAt time.Time, events ...*model.OutboxEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, disaster)
+	varargs := []any{ctx, disaster, expectedUpdatedAt}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Update", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockDisasterRepositoryMockRecorder) Update(ctx, disaster any) *gomock.Call {
+func (mr *MockDisasterRepositoryMockRecorder) Update(ctx, disaster, expectedUpdatedAt any, events ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDisasterRepository)(nil).Update), ctx, disaster)
+	varargs := append([]any{ctx, disaster, expectedUpdatedAt}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDisasterRepository)(nil).Update), varargs...)
 }
@@ -1,10 +0,0 @@
-// Code generated by MockGen. DO NOT EDIT.
-// Source: user_repository.go
-//
-// Generated by this command:
-//
-//	mockgen -source=user_repository.go -destination=../../../tests/mock/datastore/user_repository.mock.go
-//
-
-// Package mock_datastore is a generated GoMock package.
-package mock_datastore
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: assessment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=assessment.go -destination=../../../tests/mock/domain/assessment.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAssessmentRepository is a mock of AssessmentRepository interface.
+type MockAssessmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssessmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAssessmentRepositoryMockRecorder is the mock recorder for MockAssessmentRepository.
+type MockAssessmentRepositoryMockRecorder struct {
+	mock *MockAssessmentRepository
+}
+
+// NewMockAssessmentRepository creates a new mock instance.
+func NewMockAssessmentRepository(ctrl *gomock.Controller) *MockAssessmentRepository {
+	mock := &MockAssessmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAssessmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssessmentRepository) EXPECT() *MockAssessmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByDisasterIDForUpdate mocks base method.
+func (m *MockAssessmentRepository) CountByDisasterIDForUpdate(ctx context.Context, disasterID string, statuses []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByDisasterIDForUpdate", ctx, disasterID, statuses)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByDisasterIDForUpdate indicates an expected call of CountByDisasterIDForUpdate.
+func (mr *MockAssessmentRepositoryMockRecorder) CountByDisasterIDForUpdate(ctx, disasterID, statuses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByDisasterIDForUpdate", reflect.TypeOf((*MockAssessmentRepository)(nil).CountByDisasterIDForUpdate), ctx, disasterID, statuses)
+}
+
+// FindByStatusUpdatedBefore mocks base method.
+func (m *MockAssessmentRepository) FindByStatusUpdatedBefore(ctx context.Context, status string, before time.Time) ([]*model.Assessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStatusUpdatedBefore", ctx, status, before)
+	ret0, _ := ret[0].([]*model.Assessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByStatusUpdatedBefore indicates an expected call of FindByStatusUpdatedBefore.
+func (mr *MockAssessmentRepositoryMockRecorder) FindByStatusUpdatedBefore(ctx, status, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatusUpdatedBefore", reflect.TypeOf((*MockAssessmentRepository)(nil).FindByStatusUpdatedBefore), ctx, status, before)
+}
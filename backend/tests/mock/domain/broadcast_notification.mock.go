@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: broadcast_notification.go
+//
+// Generated by this command:
+//
+//	mockgen -source=broadcast_notification.go -destination=../../../tests/mock/domain/broadcast_notification.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBroadcastNotificationRepository is a mock of BroadcastNotificationRepository interface.
+type MockBroadcastNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBroadcastNotificationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBroadcastNotificationRepositoryMockRecorder is the mock recorder for MockBroadcastNotificationRepository.
+type MockBroadcastNotificationRepositoryMockRecorder struct {
+	mock *MockBroadcastNotificationRepository
+}
+
+// NewMockBroadcastNotificationRepository creates a new mock instance.
+func NewMockBroadcastNotificationRepository(ctrl *gomock.Controller) *MockBroadcastNotificationRepository {
+	mock := &MockBroadcastNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockBroadcastNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroadcastNotificationRepository) EXPECT() *MockBroadcastNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBroadcastNotificationRepository) Create(ctx context.Context, broadcast *model.BroadcastNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, broadcast)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBroadcastNotificationRepositoryMockRecorder) Create(ctx, broadcast any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBroadcastNotificationRepository)(nil).Create), ctx, broadcast)
+}
+
+// ExpandRecipients mocks base method.
+func (m *MockBroadcastNotificationRepository) ExpandRecipients(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpandRecipients", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpandRecipients indicates an expected call of ExpandRecipients.
+func (mr *MockBroadcastNotificationRepositoryMockRecorder) ExpandRecipients(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpandRecipients", reflect.TypeOf((*MockBroadcastNotificationRepository)(nil).ExpandRecipients), ctx, id)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business_metrics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business_metrics.go -destination=../../../tests/mock/domain/business_metrics.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBusinessMetricsRepository is a mock of BusinessMetricsRepository interface.
+type MockBusinessMetricsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBusinessMetricsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBusinessMetricsRepositoryMockRecorder is the mock recorder for MockBusinessMetricsRepository.
+type MockBusinessMetricsRepositoryMockRecorder struct {
+	mock *MockBusinessMetricsRepository
+}
+
+// NewMockBusinessMetricsRepository creates a new mock instance.
+func NewMockBusinessMetricsRepository(ctrl *gomock.Controller) *MockBusinessMetricsRepository {
+	mock := &MockBusinessMetricsRepository{ctrl: ctrl}
+	mock.recorder = &MockBusinessMetricsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBusinessMetricsRepository) EXPECT() *MockBusinessMetricsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountDisastersByStatus mocks base method.
+func (m *MockBusinessMetricsRepository) CountDisastersByStatus(ctx context.Context) ([]*model.GroupCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDisastersByStatus", ctx)
+	ret0, _ := ret[0].([]*model.GroupCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDisastersByStatus indicates an expected call of CountDisastersByStatus.
+func (mr *MockBusinessMetricsRepositoryMockRecorder) CountDisastersByStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDisastersByStatus", reflect.TypeOf((*MockBusinessMetricsRepository)(nil).CountDisastersByStatus), ctx)
+}
+
+// CountEmailsByTypeAndStatus mocks base method.
+func (m *MockBusinessMetricsRepository) CountEmailsByTypeAndStatus(ctx context.Context, status string) ([]*model.GroupCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEmailsByTypeAndStatus", ctx, status)
+	ret0, _ := ret[0].([]*model.GroupCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEmailsByTypeAndStatus indicates an expected call of CountEmailsByTypeAndStatus.
+func (mr *MockBusinessMetricsRepositoryMockRecorder) CountEmailsByTypeAndStatus(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEmailsByTypeAndStatus", reflect.TypeOf((*MockBusinessMetricsRepository)(nil).CountEmailsByTypeAndStatus), ctx, status)
+}
+
+// CountJobsByTypeAndStatus mocks base method.
+func (m *MockBusinessMetricsRepository) CountJobsByTypeAndStatus(ctx context.Context, statuses []string) ([]*model.GroupCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobsByTypeAndStatus", ctx, statuses)
+	ret0, _ := ret[0].([]*model.GroupCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobsByTypeAndStatus indicates an expected call of CountJobsByTypeAndStatus.
+func (mr *MockBusinessMetricsRepositoryMockRecorder) CountJobsByTypeAndStatus(ctx, statuses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobsByTypeAndStatus", reflect.TypeOf((*MockBusinessMetricsRepository)(nil).CountJobsByTypeAndStatus), ctx, statuses)
+}
+
+// CountSupportApplicationsByStatus mocks base method.
+func (m *MockBusinessMetricsRepository) CountSupportApplicationsByStatus(ctx context.Context, excludeStatuses []string) ([]*model.GroupCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSupportApplicationsByStatus", ctx, excludeStatuses)
+	ret0, _ := ret[0].([]*model.GroupCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSupportApplicationsByStatus indicates an expected call of CountSupportApplicationsByStatus.
+func (mr *MockBusinessMetricsRepositoryMockRecorder) CountSupportApplicationsByStatus(ctx, excludeStatuses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSupportApplicationsByStatus", reflect.TypeOf((*MockBusinessMetricsRepository)(nil).CountSupportApplicationsByStatus), ctx, excludeStatuses)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=damage_level.go -destination=../../../tests/mock/datastore/damage_level.mock.go
+//	mockgen -source=damage_level.go -destination=../../../tests/mock/domain/damage_level.mock.go
 //
 
 // Package mock_domain is a generated GoMock package.
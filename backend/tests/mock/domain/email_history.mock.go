@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: email_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=email_history.go -destination=../../../tests/mock/domain/email_history.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailHistoryRepository is a mock of EmailHistoryRepository interface.
+type MockEmailHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailHistoryRepositoryMockRecorder is the mock recorder for MockEmailHistoryRepository.
+type MockEmailHistoryRepositoryMockRecorder struct {
+	mock *MockEmailHistoryRepository
+}
+
+// NewMockEmailHistoryRepository creates a new mock instance.
+func NewMockEmailHistoryRepository(ctrl *gomock.Controller) *MockEmailHistoryRepository {
+	mock := &MockEmailHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockEmailHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailHistoryRepository) EXPECT() *MockEmailHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListEmailHistoriesByUserID mocks base method.
+func (m *MockEmailHistoryRepository) ListEmailHistoriesByUserID(ctx context.Context, userID string) ([]*model.EmailHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmailHistoriesByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.EmailHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmailHistoriesByUserID indicates an expected call of ListEmailHistoriesByUserID.
+func (mr *MockEmailHistoryRepositoryMockRecorder) ListEmailHistoriesByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmailHistoriesByUserID", reflect.TypeOf((*MockEmailHistoryRepository)(nil).ListEmailHistoriesByUserID), ctx, userID)
+}
+
+// SaveEmailHistory mocks base method.
+func (m *MockEmailHistoryRepository) SaveEmailHistory(ctx context.Context, email *model.EmailHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEmailHistory", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEmailHistory indicates an expected call of SaveEmailHistory.
+func (mr *MockEmailHistoryRepositoryMockRecorder) SaveEmailHistory(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEmailHistory", reflect.TypeOf((*MockEmailHistoryRepository)(nil).SaveEmailHistory), ctx, email)
+}
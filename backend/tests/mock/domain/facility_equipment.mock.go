@@ -12,6 +12,7 @@ package mock_domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
 	gomock "go.uber.org/mock/gomock"
@@ -100,15 +101,15 @@ func (mr *MockFacilityEquipmentRepositoryMockRecorder) FindByID(ctx, id any) *go
 }
 
 // Update mocks base method.
-func (m *MockFacilityEquipmentRepository) Update(ctx context.Context, facilityEquipment *model.FacilityEquipment) error {
+func (m *MockFacilityEquipmentRepository) Update(ctx context.Context, facilityEquipment *model.FacilityEquipment, expectedUpdatedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, facilityEquipment)
+	ret := m.ctrl.Call(m, "Update", ctx, facilityEquipment, expectedUpdatedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockFacilityEquipmentRepositoryMockRecorder) Update(ctx, facilityEquipment any) *gomock.Call {
+func (mr *MockFacilityEquipmentRepositoryMockRecorder) Update(ctx, facilityEquipment, expectedUpdatedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFacilityEquipmentRepository)(nil).Update), ctx, facilityEquipment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFacilityEquipmentRepository)(nil).Update), ctx, facilityEquipment, expectedUpdatedAt)
 }
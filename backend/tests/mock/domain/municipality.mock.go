@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: municipality.go
+//
+// Generated by this command:
+//
+//	mockgen -source=municipality.go -destination=../../../tests/mock/domain/municipality.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMunicipalityRepository is a mock of MunicipalityRepository interface.
+type MockMunicipalityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMunicipalityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMunicipalityRepositoryMockRecorder is the mock recorder for MockMunicipalityRepository.
+type MockMunicipalityRepositoryMockRecorder struct {
+	mock *MockMunicipalityRepository
+}
+
+// NewMockMunicipalityRepository creates a new mock instance.
+func NewMockMunicipalityRepository(ctrl *gomock.Controller) *MockMunicipalityRepository {
+	mock := &MockMunicipalityRepository{ctrl: ctrl}
+	mock.recorder = &MockMunicipalityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMunicipalityRepository) EXPECT() *MockMunicipalityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockMunicipalityRepository) FindByID(ctx context.Context, id int32) (*model.Municipality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.Municipality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMunicipalityRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMunicipalityRepository)(nil).FindByID), ctx, id)
+}
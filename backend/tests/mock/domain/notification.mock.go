@@ -41,6 +41,21 @@ func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRec
 	return m.recorder
 }
 
+// CountUnreadByUserID mocks base method.
+func (m *MockNotificationRepository) CountUnreadByUserID(ctx context.Context, userID string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadByUserID", ctx, userID)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadByUserID indicates an expected call of CountUnreadByUserID.
+func (mr *MockNotificationRepositoryMockRecorder) CountUnreadByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadByUserID", reflect.TypeOf((*MockNotificationRepository)(nil).CountUnreadByUserID), ctx, userID)
+}
+
 // Create mocks base method.
 func (m *MockNotificationRepository) Create(ctx context.Context, notification *model.Notification) error {
 	m.ctrl.T.Helper()
@@ -69,6 +84,21 @@ func (mr *MockNotificationRepositoryMockRecorder) Delete(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNotificationRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteByUserID mocks base method.
+func (m *MockNotificationRepository) DeleteByUserID(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", ctx, userID, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockNotificationRepositoryMockRecorder) DeleteByUserID(ctx, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockNotificationRepository)(nil).DeleteByUserID), ctx, userID, filter)
+}
+
 // Find mocks base method.
 func (m *MockNotificationRepository) Find(ctx context.Context) ([]*model.Notification, error) {
 	m.ctrl.T.Helper()
@@ -100,7 +130,7 @@ func (mr *MockNotificationRepositoryMockRecorder) FindByID(ctx, id any) *gomock.
 }
 
 // FindByUserID mocks base method.
-func (m *MockNotificationRepository) FindByUserID(ctx context.Context, userID int32) ([]*model.Notification, error) {
+func (m *MockNotificationRepository) FindByUserID(ctx context.Context, userID string) ([]*model.Notification, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
 	ret0, _ := ret[0].([]*model.Notification)
@@ -114,6 +144,51 @@ func (mr *MockNotificationRepositoryMockRecorder) FindByUserID(ctx, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockNotificationRepository)(nil).FindByUserID), ctx, userID)
 }
 
+// FindByUserIDAfter mocks base method.
+func (m *MockNotificationRepository) FindByUserIDAfter(ctx context.Context, userID string, afterID int32) ([]*model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDAfter", ctx, userID, afterID)
+	ret0, _ := ret[0].([]*model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDAfter indicates an expected call of FindByUserIDAfter.
+func (mr *MockNotificationRepositoryMockRecorder) FindByUserIDAfter(ctx, userID, afterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAfter", reflect.TypeOf((*MockNotificationRepository)(nil).FindByUserIDAfter), ctx, userID, afterID)
+}
+
+// FindLatestIDByUserID mocks base method.
+func (m *MockNotificationRepository) FindLatestIDByUserID(ctx context.Context, userID string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestIDByUserID", ctx, userID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestIDByUserID indicates an expected call of FindLatestIDByUserID.
+func (mr *MockNotificationRepositoryMockRecorder) FindLatestIDByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestIDByUserID", reflect.TypeOf((*MockNotificationRepository)(nil).FindLatestIDByUserID), ctx, userID)
+}
+
+// MarkAllAsReadByUserID mocks base method.
+func (m *MockNotificationRepository) MarkAllAsReadByUserID(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllAsReadByUserID", ctx, userID, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllAsReadByUserID indicates an expected call of MarkAllAsReadByUserID.
+func (mr *MockNotificationRepositoryMockRecorder) MarkAllAsReadByUserID(ctx, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllAsReadByUserID", reflect.TypeOf((*MockNotificationRepository)(nil).MarkAllAsReadByUserID), ctx, userID, filter)
+}
+
 // MarkAsRead mocks base method.
 func (m *MockNotificationRepository) MarkAsRead(ctx context.Context, id int32) error {
 	m.ctrl.T.Helper()
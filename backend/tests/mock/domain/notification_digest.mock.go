@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_digest.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_digest.go -destination=../../../tests/mock/domain/notification_digest.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationDigestRepository is a mock of NotificationDigestRepository interface.
+type MockNotificationDigestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationDigestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationDigestRepositoryMockRecorder is the mock recorder for MockNotificationDigestRepository.
+type MockNotificationDigestRepositoryMockRecorder struct {
+	mock *MockNotificationDigestRepository
+}
+
+// NewMockNotificationDigestRepository creates a new mock instance.
+func NewMockNotificationDigestRepository(ctrl *gomock.Controller) *MockNotificationDigestRepository {
+	mock := &MockNotificationDigestRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationDigestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationDigestRepository) EXPECT() *MockNotificationDigestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockNotificationDigestRepository) Create(ctx context.Context, entry *model.NotificationDigestEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationDigestRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationDigestRepository)(nil).Create), ctx, entry)
+}
+
+// FindPending mocks base method.
+func (m *MockNotificationDigestRepository) FindPending(ctx context.Context) ([]*model.NotificationDigestEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx)
+	ret0, _ := ret[0].([]*model.NotificationDigestEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockNotificationDigestRepositoryMockRecorder) FindPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockNotificationDigestRepository)(nil).FindPending), ctx)
+}
+
+// MarkSent mocks base method.
+func (m *MockNotificationDigestRepository) MarkSent(ctx context.Context, ids []int64, sentAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSent", ctx, ids, sentAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSent indicates an expected call of MarkSent.
+func (mr *MockNotificationDigestRepositoryMockRecorder) MarkSent(ctx, ids, sentAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSent", reflect.TypeOf((*MockNotificationDigestRepository)(nil).MarkSent), ctx, ids, sentAt)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_stream.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_stream.go -destination=../../../tests/mock/domain/notification_stream.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationStream is a mock of NotificationStream interface.
+type MockNotificationStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationStreamMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationStreamMockRecorder is the mock recorder for MockNotificationStream.
+type MockNotificationStreamMockRecorder struct {
+	mock *MockNotificationStream
+}
+
+// NewMockNotificationStream creates a new mock instance.
+func NewMockNotificationStream(ctrl *gomock.Controller) *MockNotificationStream {
+	mock := &MockNotificationStream{ctrl: ctrl}
+	mock.recorder = &MockNotificationStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationStream) EXPECT() *MockNotificationStreamMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockNotificationStream) Subscribe(userID string) (<-chan struct{}, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", userID)
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockNotificationStreamMockRecorder) Subscribe(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockNotificationStream)(nil).Subscribe), userID)
+}
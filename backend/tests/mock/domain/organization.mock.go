@@ -12,6 +12,7 @@ package mock_domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
 	gomock "go.uber.org/mock/gomock"
@@ -100,15 +101,15 @@ func (mr *MockOrganizationRepositoryMockRecorder) FindByID(ctx, id any) *gomock.
 }
 
 // Update mocks base method.
-func (m *MockOrganizationRepository) Update(ctx context.Context, organization *model.Organization) error {
+func (m *MockOrganizationRepository) Update(ctx context.Context, organization *model.Organization, expectedUpdatedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, organization)
+	ret := m.ctrl.Call(m, "Update", ctx, organization, expectedUpdatedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockOrganizationRepositoryMockRecorder) Update(ctx, organization any) *gomock.Call {
+func (mr *MockOrganizationRepositoryMockRecorder) Update(ctx, organization, expectedUpdatedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationRepository)(nil).Update), ctx, organization)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationRepository)(nil).Update), ctx, organization, expectedUpdatedAt)
 }
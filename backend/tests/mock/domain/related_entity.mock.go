@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: related_entity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=related_entity.go -destination=../../../tests/mock/domain/related_entity.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRelatedEntityRepository is a mock of RelatedEntityRepository interface.
+type MockRelatedEntityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelatedEntityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRelatedEntityRepositoryMockRecorder is the mock recorder for MockRelatedEntityRepository.
+type MockRelatedEntityRepositoryMockRecorder struct {
+	mock *MockRelatedEntityRepository
+}
+
+// NewMockRelatedEntityRepository creates a new mock instance.
+func NewMockRelatedEntityRepository(ctrl *gomock.Controller) *MockRelatedEntityRepository {
+	mock := &MockRelatedEntityRepository{ctrl: ctrl}
+	mock.recorder = &MockRelatedEntityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelatedEntityRepository) EXPECT() *MockRelatedEntityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Resolve mocks base method.
+func (m *MockRelatedEntityRepository) Resolve(ctx context.Context, refs []model.RelatedEntityRef) (map[model.RelatedEntityRef]*model.RelatedEntityLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, refs)
+	ret0, _ := ret[0].(map[model.RelatedEntityRef]*model.RelatedEntityLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockRelatedEntityRepositoryMockRecorder) Resolve(ctx, refs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockRelatedEntityRepository)(nil).Resolve), ctx, refs)
+}
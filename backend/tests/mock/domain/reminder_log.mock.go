@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminder_log.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reminder_log.go -destination=../../../tests/mock/domain/reminder_log.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReminderLogRepository is a mock of ReminderLogRepository interface.
+type MockReminderLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderLogRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReminderLogRepositoryMockRecorder is the mock recorder for MockReminderLogRepository.
+type MockReminderLogRepositoryMockRecorder struct {
+	mock *MockReminderLogRepository
+}
+
+// NewMockReminderLogRepository creates a new mock instance.
+func NewMockReminderLogRepository(ctrl *gomock.Controller) *MockReminderLogRepository {
+	mock := &MockReminderLogRepository{ctrl: ctrl}
+	mock.recorder = &MockReminderLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderLogRepository) EXPECT() *MockReminderLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReminderLogRepository) Create(ctx context.Context, log *model.ReminderLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReminderLogRepositoryMockRecorder) Create(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReminderLogRepository)(nil).Create), ctx, log)
+}
+
+// ExistsSince mocks base method.
+func (m *MockReminderLogRepository) ExistsSince(ctx context.Context, userID string, ref model.RelatedEntityRef, since time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsSince", ctx, userID, ref, since)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsSince indicates an expected call of ExistsSince.
+func (mr *MockReminderLogRepositoryMockRecorder) ExistsSince(ctx, userID, ref, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsSince", reflect.TypeOf((*MockReminderLogRepository)(nil).ExistsSince), ctx, userID, ref, since)
+}
@@ -12,6 +12,7 @@ package mock_domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
 	gomock "go.uber.org/mock/gomock"
@@ -41,18 +42,38 @@ func (m *MockSupportApplicationRepository) EXPECT() *MockSupportApplicationRepos
 	return m.recorder
 }
 
+// CountByDisasterIDForUpdate mocks base method.
+func (m *MockSupportApplicationRepository) CountByDisasterIDForUpdate(ctx context.Context, disasterID string, statuses []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByDisasterIDForUpdate", ctx, disasterID, statuses)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByDisasterIDForUpdate indicates an expected call of CountByDisasterIDForUpdate.
+func (mr *MockSupportApplicationRepositoryMockRecorder) CountByDisasterIDForUpdate(ctx, disasterID, statuses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByDisasterIDForUpdate", reflect.TypeOf((*MockSupportApplicationRepository)(nil).CountByDisasterIDForUpdate), ctx, disasterID, statuses)
+}
+
 // Create mocks base method.
-func (m *MockSupportApplicationRepository) Create(ctx context.Context, supportApplication *model.SupportApplication) error {
+func (m *MockSupportApplicationRepository) Create(ctx context.Context, supportApplication *model.SupportApplication, events ...*model.OutboxEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, supportApplication)
+	varargs := []any{ctx, supportApplication}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockSupportApplicationRepositoryMockRecorder) Create(ctx, supportApplication any) *gomock.Call {
+func (mr *MockSupportApplicationRepositoryMockRecorder) Create(ctx, supportApplication any, events ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSupportApplicationRepository)(nil).Create), ctx, supportApplication)
+	varargs := append([]any{ctx, supportApplication}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSupportApplicationRepository)(nil).Create), varargs...)
 }
 
 // Find mocks base method.
@@ -84,3 +105,37 @@ func (mr *MockSupportApplicationRepositoryMockRecorder) FindByID(ctx, id any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSupportApplicationRepository)(nil).FindByID), ctx, id)
 }
+
+// FindByStatusUpdatedBefore mocks base method.
+func (m *MockSupportApplicationRepository) FindByStatusUpdatedBefore(ctx context.Context, status string, before time.Time) ([]*model.SupportApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStatusUpdatedBefore", ctx, status, before)
+	ret0, _ := ret[0].([]*model.SupportApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByStatusUpdatedBefore indicates an expected call of FindByStatusUpdatedBefore.
+func (mr *MockSupportApplicationRepositoryMockRecorder) FindByStatusUpdatedBefore(ctx, status, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatusUpdatedBefore", reflect.TypeOf((*MockSupportApplicationRepository)(nil).FindByStatusUpdatedBefore), ctx, status, before)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockSupportApplicationRepository) UpdateStatus(ctx context.Context, id, status string, updatedAt, expectedUpdatedAt time.Time, events ...*model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, status, updatedAt, expectedUpdatedAt}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockSupportApplicationRepositoryMockRecorder) UpdateStatus(ctx, id, status, updatedAt, expectedUpdatedAt any, events ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, status, updatedAt, expectedUpdatedAt}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockSupportApplicationRepository)(nil).UpdateStatus), varargs...)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: work_category.go
+//
+// Generated by this command:
+//
+//	mockgen -source=work_category.go -destination=../../../tests/mock/domain/work_category.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkCategoryRepository is a mock of WorkCategoryRepository interface.
+type MockWorkCategoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkCategoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkCategoryRepositoryMockRecorder is the mock recorder for MockWorkCategoryRepository.
+type MockWorkCategoryRepositoryMockRecorder struct {
+	mock *MockWorkCategoryRepository
+}
+
+// NewMockWorkCategoryRepository creates a new mock instance.
+func NewMockWorkCategoryRepository(ctrl *gomock.Controller) *MockWorkCategoryRepository {
+	mock := &MockWorkCategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkCategoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkCategoryRepository) EXPECT() *MockWorkCategoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockWorkCategoryRepository) FindByID(ctx context.Context, id int64) (*model.WorkCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.WorkCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWorkCategoryRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWorkCategoryRepository)(nil).FindByID), ctx, id)
+}
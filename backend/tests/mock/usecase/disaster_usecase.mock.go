@@ -15,6 +15,7 @@ import (
 
 	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
 	datastore "github.com/AI1411/fullstack-react-go/internal/infra/datastore"
+	usecase "github.com/AI1411/fullstack-react-go/internal/usecase"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -57,17 +58,18 @@ func (mr *MockDisasterUseCaseMockRecorder) CreateDisaster(ctx, disaster any) *go
 }
 
 // DeleteDisaster mocks base method.
-func (m *MockDisasterUseCase) DeleteDisaster(ctx context.Context, id string) error {
+func (m *MockDisasterUseCase) DeleteDisaster(ctx context.Context, id string, force bool) (*model.DisasterDeleteSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDisaster", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DeleteDisaster", ctx, id, force)
+	ret0, _ := ret[0].(*model.DisasterDeleteSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteDisaster indicates an expected call of DeleteDisaster.
-func (mr *MockDisasterUseCaseMockRecorder) DeleteDisaster(ctx, id any) *gomock.Call {
+func (mr *MockDisasterUseCaseMockRecorder) DeleteDisaster(ctx, id, force any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDisaster", reflect.TypeOf((*MockDisasterUseCase)(nil).DeleteDisaster), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDisaster", reflect.TypeOf((*MockDisasterUseCase)(nil).DeleteDisaster), ctx, id, force)
 }
 
 // GetDisasterByID mocks base method.
@@ -101,15 +103,16 @@ func (mr *MockDisasterUseCaseMockRecorder) ListDisasters(ctx, params any) *gomoc
 }
 
 // UpdateDisaster mocks base method.
-func (m *MockDisasterUseCase) UpdateDisaster(ctx context.Context, disaster *model.Disaster) error {
+func (m *MockDisasterUseCase) UpdateDisaster(ctx context.Context, id string, params *usecase.UpdateDisasterParams) (*model.Disaster, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDisaster", ctx, disaster)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateDisaster", ctx, id, params)
+	ret0, _ := ret[0].(*model.Disaster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateDisaster indicates an expected call of UpdateDisaster.
-func (mr *MockDisasterUseCaseMockRecorder) UpdateDisaster(ctx, disaster any) *gomock.Call {
+func (mr *MockDisasterUseCaseMockRecorder) UpdateDisaster(ctx, id, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDisaster", reflect.TypeOf((*MockDisasterUseCase)(nil).UpdateDisaster), ctx, disaster)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDisaster", reflect.TypeOf((*MockDisasterUseCase)(nil).UpdateDisaster), ctx, id, params)
 }
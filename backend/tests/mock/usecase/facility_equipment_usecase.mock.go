@@ -12,6 +12,7 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
 	gomock "go.uber.org/mock/gomock"
@@ -100,15 +101,16 @@ func (mr *MockFacilityEquipmentUseCaseMockRecorder) ListFacilityEquipments(ctx a
 }
 
 // UpdateFacilityEquipment mocks base method.
-func (m *MockFacilityEquipmentUseCase) UpdateFacilityEquipment(ctx context.Context, facilityEquipment *model.FacilityEquipment) error {
+func (m *MockFacilityEquipmentUseCase) UpdateFacilityEquipment(ctx context.Context, facilityEquipment *model.FacilityEquipment, expectedUpdatedAt time.Time) (*model.FacilityEquipment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateFacilityEquipment", ctx, facilityEquipment)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateFacilityEquipment", ctx, facilityEquipment, expectedUpdatedAt)
+	ret0, _ := ret[0].(*model.FacilityEquipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateFacilityEquipment indicates an expected call of UpdateFacilityEquipment.
-func (mr *MockFacilityEquipmentUseCaseMockRecorder) UpdateFacilityEquipment(ctx, facilityEquipment any) *gomock.Call {
+func (mr *MockFacilityEquipmentUseCaseMockRecorder) UpdateFacilityEquipment(ctx, facilityEquipment, expectedUpdatedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFacilityEquipment", reflect.TypeOf((*MockFacilityEquipmentUseCase)(nil).UpdateFacilityEquipment), ctx, facilityEquipment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFacilityEquipment", reflect.TypeOf((*MockFacilityEquipmentUseCase)(nil).UpdateFacilityEquipment), ctx, facilityEquipment, expectedUpdatedAt)
 }
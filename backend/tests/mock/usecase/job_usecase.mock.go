@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=job_usecase.go -destination=../../tests/mock/usecase/job_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJobUseCase is a mock of JobUseCase interface.
+type MockJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockJobUseCaseMockRecorder is the mock recorder for MockJobUseCase.
+type MockJobUseCaseMockRecorder struct {
+	mock *MockJobUseCase
+}
+
+// NewMockJobUseCase creates a new mock instance.
+func NewMockJobUseCase(ctrl *gomock.Controller) *MockJobUseCase {
+	mock := &MockJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobUseCase) EXPECT() *MockJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListJobs mocks base method.
+func (m *MockJobUseCase) ListJobs(ctx context.Context, filter model.JobFilter) ([]*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", ctx, filter)
+	ret0, _ := ret[0].([]*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockJobUseCaseMockRecorder) ListJobs(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockJobUseCase)(nil).ListJobs), ctx, filter)
+}
+
+// RetryJob mocks base method.
+func (m *MockJobUseCase) RetryJob(ctx context.Context, id int64) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryJob", ctx, id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryJob indicates an expected call of RetryJob.
+func (mr *MockJobUseCaseMockRecorder) RetryJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryJob", reflect.TypeOf((*MockJobUseCase)(nil).RetryJob), ctx, id)
+}
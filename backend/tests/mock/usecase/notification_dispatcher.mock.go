@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_dispatcher.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_dispatcher.go -destination=../../tests/mock/usecase/notification_dispatcher.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationDispatcher is a mock of NotificationDispatcher interface.
+type MockNotificationDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationDispatcherMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationDispatcherMockRecorder is the mock recorder for MockNotificationDispatcher.
+type MockNotificationDispatcherMockRecorder struct {
+	mock *MockNotificationDispatcher
+}
+
+// NewMockNotificationDispatcher creates a new mock instance.
+func NewMockNotificationDispatcher(ctrl *gomock.Controller) *MockNotificationDispatcher {
+	mock := &MockNotificationDispatcher{ctrl: ctrl}
+	mock.recorder = &MockNotificationDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationDispatcher) EXPECT() *MockNotificationDispatcherMockRecorder {
+	return m.recorder
+}
+
+// Dispatch mocks base method.
+func (m *MockNotificationDispatcher) Dispatch(ctx context.Context, notification *model.Notification) (*model.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dispatch", ctx, notification)
+	ret0, _ := ret[0].(*model.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dispatch indicates an expected call of Dispatch.
+func (mr *MockNotificationDispatcherMockRecorder) Dispatch(ctx, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockNotificationDispatcher)(nil).Dispatch), ctx, notification)
+}
+
+// PostWebhook mocks base method.
+func (m *MockNotificationDispatcher) PostWebhook(ctx context.Context, payload model.NotificationWebhookPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostWebhook", ctx, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostWebhook indicates an expected call of PostWebhook.
+func (mr *MockNotificationDispatcherMockRecorder) PostWebhook(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWebhook", reflect.TypeOf((*MockNotificationDispatcher)(nil).PostWebhook), ctx, payload)
+}
+
+// SendDigests mocks base method.
+func (m *MockNotificationDispatcher) SendDigests(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDigests", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendDigests indicates an expected call of SendDigests.
+func (mr *MockNotificationDispatcherMockRecorder) SendDigests(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDigests", reflect.TypeOf((*MockNotificationDispatcher)(nil).SendDigests), ctx, now)
+}
+
+// SendEmail mocks base method.
+func (m *MockNotificationDispatcher) SendEmail(ctx context.Context, payload model.NotificationEmailPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmail", ctx, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmail indicates an expected call of SendEmail.
+func (mr *MockNotificationDispatcherMockRecorder) SendEmail(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmail", reflect.TypeOf((*MockNotificationDispatcher)(nil).SendEmail), ctx, payload)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_preference_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_preference_usecase.go -destination=../../tests/mock/usecase/notification_preference_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferenceUseCase is a mock of NotificationPreferenceUseCase interface.
+type MockNotificationPreferenceUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferenceUseCaseMockRecorder is the mock recorder for MockNotificationPreferenceUseCase.
+type MockNotificationPreferenceUseCaseMockRecorder struct {
+	mock *MockNotificationPreferenceUseCase
+}
+
+// NewMockNotificationPreferenceUseCase creates a new mock instance.
+func NewMockNotificationPreferenceUseCase(ctrl *gomock.Controller) *MockNotificationPreferenceUseCase {
+	mock := &MockNotificationPreferenceUseCase{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceUseCase) EXPECT() *MockNotificationPreferenceUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListPreferences mocks base method.
+func (m *MockNotificationPreferenceUseCase) ListPreferences(ctx context.Context, userID string) ([]*model.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPreferences", ctx, userID)
+	ret0, _ := ret[0].([]*model.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPreferences indicates an expected call of ListPreferences.
+func (mr *MockNotificationPreferenceUseCaseMockRecorder) ListPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPreferences", reflect.TypeOf((*MockNotificationPreferenceUseCase)(nil).ListPreferences), ctx, userID)
+}
+
+// UpdatePreferences mocks base method.
+func (m *MockNotificationPreferenceUseCase) UpdatePreferences(ctx context.Context, userID string, preferences []*model.NotificationPreference) ([]*model.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", ctx, userID, preferences)
+	ret0, _ := ret[0].([]*model.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockNotificationPreferenceUseCaseMockRecorder) UpdatePreferences(ctx, userID, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockNotificationPreferenceUseCase)(nil).UpdatePreferences), ctx, userID, preferences)
+}
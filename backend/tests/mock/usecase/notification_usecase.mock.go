@@ -41,14 +41,44 @@ func (m *MockNotificationUseCase) EXPECT() *MockNotificationUseCaseMockRecorder
 	return m.recorder
 }
 
-// CreateNotification mocks base method.
-func (m *MockNotificationUseCase) CreateNotification(ctx context.Context, notification *model.Notification) error {
+// BroadcastNotification mocks base method.
+func (m *MockNotificationUseCase) BroadcastNotification(ctx context.Context, broadcast *model.BroadcastNotification) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNotification", ctx, notification)
+	ret := m.ctrl.Call(m, "BroadcastNotification", ctx, broadcast)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// BroadcastNotification indicates an expected call of BroadcastNotification.
+func (mr *MockNotificationUseCaseMockRecorder) BroadcastNotification(ctx, broadcast any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastNotification", reflect.TypeOf((*MockNotificationUseCase)(nil).BroadcastNotification), ctx, broadcast)
+}
+
+// CountUnread mocks base method.
+func (m *MockNotificationUseCase) CountUnread(ctx context.Context, userID string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", ctx, userID)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread.
+func (mr *MockNotificationUseCaseMockRecorder) CountUnread(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockNotificationUseCase)(nil).CountUnread), ctx, userID)
+}
+
+// CreateNotification mocks base method.
+func (m *MockNotificationUseCase) CreateNotification(ctx context.Context, notification *model.Notification) (*model.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, notification)
+	ret0, _ := ret[0].(*model.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // CreateNotification indicates an expected call of CreateNotification.
 func (mr *MockNotificationUseCaseMockRecorder) CreateNotification(ctx, notification any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -69,23 +99,52 @@ func (mr *MockNotificationUseCaseMockRecorder) DeleteNotification(ctx, id any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockNotificationUseCase)(nil).DeleteNotification), ctx, id)
 }
 
+// DeleteNotifications mocks base method.
+func (m *MockNotificationUseCase) DeleteNotifications(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotifications", ctx, userID, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotifications indicates an expected call of DeleteNotifications.
+func (mr *MockNotificationUseCaseMockRecorder) DeleteNotifications(ctx, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).DeleteNotifications), ctx, userID, filter)
+}
+
+// ExpandBroadcast mocks base method.
+func (m *MockNotificationUseCase) ExpandBroadcast(ctx context.Context, payload model.BroadcastNotificationPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpandBroadcast", ctx, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpandBroadcast indicates an expected call of ExpandBroadcast.
+func (mr *MockNotificationUseCaseMockRecorder) ExpandBroadcast(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpandBroadcast", reflect.TypeOf((*MockNotificationUseCase)(nil).ExpandBroadcast), ctx, payload)
+}
+
 // GetNotificationByID mocks base method.
-func (m *MockNotificationUseCase) GetNotificationByID(ctx context.Context, id int32) (*model.Notification, error) {
+func (m *MockNotificationUseCase) GetNotificationByID(ctx context.Context, userID string, id int32) (*model.Notification, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNotificationByID", ctx, id)
+	ret := m.ctrl.Call(m, "GetNotificationByID", ctx, userID, id)
 	ret0, _ := ret[0].(*model.Notification)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNotificationByID indicates an expected call of GetNotificationByID.
-func (mr *MockNotificationUseCaseMockRecorder) GetNotificationByID(ctx, id any) *gomock.Call {
+func (mr *MockNotificationUseCaseMockRecorder) GetNotificationByID(ctx, userID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationByID", reflect.TypeOf((*MockNotificationUseCase)(nil).GetNotificationByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationByID", reflect.TypeOf((*MockNotificationUseCase)(nil).GetNotificationByID), ctx, userID, id)
 }
 
 // GetNotificationsByUserID mocks base method.
-func (m *MockNotificationUseCase) GetNotificationsByUserID(ctx context.Context, userID int32) ([]*model.Notification, error) {
+func (m *MockNotificationUseCase) GetNotificationsByUserID(ctx context.Context, userID string) ([]*model.Notification, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetNotificationsByUserID", ctx, userID)
 	ret0, _ := ret[0].([]*model.Notification)
@@ -99,6 +158,21 @@ func (mr *MockNotificationUseCaseMockRecorder) GetNotificationsByUserID(ctx, use
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsByUserID", reflect.TypeOf((*MockNotificationUseCase)(nil).GetNotificationsByUserID), ctx, userID)
 }
 
+// LatestNotificationID mocks base method.
+func (m *MockNotificationUseCase) LatestNotificationID(ctx context.Context, userID string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestNotificationID", ctx, userID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestNotificationID indicates an expected call of LatestNotificationID.
+func (mr *MockNotificationUseCaseMockRecorder) LatestNotificationID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestNotificationID", reflect.TypeOf((*MockNotificationUseCase)(nil).LatestNotificationID), ctx, userID)
+}
+
 // ListNotifications mocks base method.
 func (m *MockNotificationUseCase) ListNotifications(ctx context.Context) ([]*model.Notification, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +188,36 @@ func (mr *MockNotificationUseCaseMockRecorder) ListNotifications(ctx any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).ListNotifications), ctx)
 }
 
+// ListNotificationsAfter mocks base method.
+func (m *MockNotificationUseCase) ListNotificationsAfter(ctx context.Context, userID string, afterID int32) ([]*model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationsAfter", ctx, userID, afterID)
+	ret0, _ := ret[0].([]*model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationsAfter indicates an expected call of ListNotificationsAfter.
+func (mr *MockNotificationUseCaseMockRecorder) ListNotificationsAfter(ctx, userID, afterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationsAfter", reflect.TypeOf((*MockNotificationUseCase)(nil).ListNotificationsAfter), ctx, userID, afterID)
+}
+
+// MarkAllAsRead mocks base method.
+func (m *MockNotificationUseCase) MarkAllAsRead(ctx context.Context, userID string, filter *model.NotificationFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllAsRead", ctx, userID, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllAsRead indicates an expected call of MarkAllAsRead.
+func (mr *MockNotificationUseCaseMockRecorder) MarkAllAsRead(ctx, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllAsRead", reflect.TypeOf((*MockNotificationUseCase)(nil).MarkAllAsRead), ctx, userID, filter)
+}
+
 // MarkAsRead mocks base method.
 func (m *MockNotificationUseCase) MarkAsRead(ctx context.Context, id int32) error {
 	m.ctrl.T.Helper()
@@ -128,6 +232,36 @@ func (mr *MockNotificationUseCaseMockRecorder) MarkAsRead(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockNotificationUseCase)(nil).MarkAsRead), ctx, id)
 }
 
+// ResolveRelatedEntities mocks base method.
+func (m *MockNotificationUseCase) ResolveRelatedEntities(ctx context.Context, notifications []*model.Notification) (map[model.RelatedEntityRef]*model.RelatedEntityLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveRelatedEntities", ctx, notifications)
+	ret0, _ := ret[0].(map[model.RelatedEntityRef]*model.RelatedEntityLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveRelatedEntities indicates an expected call of ResolveRelatedEntities.
+func (mr *MockNotificationUseCaseMockRecorder) ResolveRelatedEntities(ctx, notifications any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveRelatedEntities", reflect.TypeOf((*MockNotificationUseCase)(nil).ResolveRelatedEntities), ctx, notifications)
+}
+
+// SubscribeNotifications mocks base method.
+func (m *MockNotificationUseCase) SubscribeNotifications(userID string) (<-chan struct{}, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNotifications", userID)
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// SubscribeNotifications indicates an expected call of SubscribeNotifications.
+func (mr *MockNotificationUseCaseMockRecorder) SubscribeNotifications(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).SubscribeNotifications), userID)
+}
+
 // UpdateNotification mocks base method.
 func (m *MockNotificationUseCase) UpdateNotification(ctx context.Context, notification *model.Notification) error {
 	m.ctrl.T.Helper()
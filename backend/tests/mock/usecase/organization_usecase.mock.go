@@ -12,6 +12,7 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
 	gomock "go.uber.org/mock/gomock"
@@ -100,15 +101,16 @@ func (mr *MockOrganizationUseCaseMockRecorder) ListOrganizations(ctx any) *gomoc
 }
 
 // UpdateOrganization mocks base method.
-func (m *MockOrganizationUseCase) UpdateOrganization(ctx context.Context, organization *model.Organization) error {
+func (m *MockOrganizationUseCase) UpdateOrganization(ctx context.Context, organization *model.Organization, expectedUpdatedAt time.Time) (*model.Organization, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOrganization", ctx, organization)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateOrganization", ctx, organization, expectedUpdatedAt)
+	ret0, _ := ret[0].(*model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateOrganization indicates an expected call of UpdateOrganization.
-func (mr *MockOrganizationUseCaseMockRecorder) UpdateOrganization(ctx, organization any) *gomock.Call {
+func (mr *MockOrganizationUseCaseMockRecorder) UpdateOrganization(ctx, organization, expectedUpdatedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*MockOrganizationUseCase)(nil).UpdateOrganization), ctx, organization)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*MockOrganizationUseCase)(nil).UpdateOrganization), ctx, organization, expectedUpdatedAt)
 }
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminder_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reminder_usecase.go -destination=../../tests/mock/usecase/reminder_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReminderUseCase is a mock of ReminderUseCase interface.
+type MockReminderUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReminderUseCaseMockRecorder is the mock recorder for MockReminderUseCase.
+type MockReminderUseCaseMockRecorder struct {
+	mock *MockReminderUseCase
+}
+
+// NewMockReminderUseCase creates a new mock instance.
+func NewMockReminderUseCase(ctrl *gomock.Controller) *MockReminderUseCase {
+	mock := &MockReminderUseCase{ctrl: ctrl}
+	mock.recorder = &MockReminderUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderUseCase) EXPECT() *MockReminderUseCaseMockRecorder {
+	return m.recorder
+}
+
+// RemindPendingDocumentApplications mocks base method.
+func (m *MockReminderUseCase) RemindPendingDocumentApplications(ctx context.Context, now time.Time, staleAfter time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemindPendingDocumentApplications", ctx, now, staleAfter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemindPendingDocumentApplications indicates an expected call of RemindPendingDocumentApplications.
+func (mr *MockReminderUseCaseMockRecorder) RemindPendingDocumentApplications(ctx, now, staleAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemindPendingDocumentApplications", reflect.TypeOf((*MockReminderUseCase)(nil).RemindPendingDocumentApplications), ctx, now, staleAfter)
+}
+
+// RemindStaleAssessments mocks base method.
+func (m *MockReminderUseCase) RemindStaleAssessments(ctx context.Context, now time.Time, staleAfter time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemindStaleAssessments", ctx, now, staleAfter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemindStaleAssessments indicates an expected call of RemindStaleAssessments.
+func (mr *MockReminderUseCaseMockRecorder) RemindStaleAssessments(ctx, now, staleAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemindStaleAssessments", reflect.TypeOf((*MockReminderUseCase)(nil).RemindStaleAssessments), ctx, now, staleAfter)
+}
@@ -12,6 +12,7 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
 	gomock "go.uber.org/mock/gomock"
@@ -84,3 +85,18 @@ func (mr *MockSupportApplicationUseCaseMockRecorder) ListSupportApplications(ctx
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportApplications", reflect.TypeOf((*MockSupportApplicationUseCase)(nil).ListSupportApplications), ctx)
 }
+
+// UpdateSupportApplicationStatus mocks base method.
+func (m *MockSupportApplicationUseCase) UpdateSupportApplicationStatus(ctx context.Context, id, status string, expectedUpdatedAt time.Time) (*model.SupportApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSupportApplicationStatus", ctx, id, status, expectedUpdatedAt)
+	ret0, _ := ret[0].(*model.SupportApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSupportApplicationStatus indicates an expected call of UpdateSupportApplicationStatus.
+func (mr *MockSupportApplicationUseCaseMockRecorder) UpdateSupportApplicationStatus(ctx, id, status, expectedUpdatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSupportApplicationStatus", reflect.TypeOf((*MockSupportApplicationUseCase)(nil).UpdateSupportApplicationStatus), ctx, id, status, expectedUpdatedAt)
+}
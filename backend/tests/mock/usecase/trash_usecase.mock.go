@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trash_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=trash_usecase.go -destination=../../tests/mock/usecase/trash_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTrashUseCase is a mock of TrashUseCase interface.
+type MockTrashUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockTrashUseCaseMockRecorder is the mock recorder for MockTrashUseCase.
+type MockTrashUseCaseMockRecorder struct {
+	mock *MockTrashUseCase
+}
+
+// NewMockTrashUseCase creates a new mock instance.
+func NewMockTrashUseCase(ctrl *gomock.Controller) *MockTrashUseCase {
+	mock := &MockTrashUseCase{ctrl: ctrl}
+	mock.recorder = &MockTrashUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashUseCase) EXPECT() *MockTrashUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListTrash mocks base method.
+func (m *MockTrashUseCase) ListTrash(ctx context.Context, trashType model.TrashType) ([]*model.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, trashType)
+	ret0, _ := ret[0].([]*model.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockTrashUseCaseMockRecorder) ListTrash(ctx, trashType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockTrashUseCase)(nil).ListTrash), ctx, trashType)
+}
+
+// Purge mocks base method.
+func (m *MockTrashUseCase) Purge(ctx context.Context, trashType model.TrashType, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, trashType, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTrashUseCaseMockRecorder) Purge(ctx, trashType, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTrashUseCase)(nil).Purge), ctx, trashType, id)
+}
+
+// Restore mocks base method.
+func (m *MockTrashUseCase) Restore(ctx context.Context, trashType model.TrashType, id string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, trashType, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTrashUseCaseMockRecorder) Restore(ctx, trashType, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTrashUseCase)(nil).Restore), ctx, trashType, id)
+}
@@ -114,6 +114,20 @@ func (mr *MockUserUseCaseMockRecorder) ListUsers(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserUseCase)(nil).ListUsers), ctx)
 }
 
+// SendWelcomeEmail mocks base method.
+func (m *MockUserUseCase) SendWelcomeEmail(ctx context.Context, payload model.WelcomeEmailPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWelcomeEmail", ctx, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendWelcomeEmail indicates an expected call of SendWelcomeEmail.
+func (mr *MockUserUseCaseMockRecorder) SendWelcomeEmail(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWelcomeEmail", reflect.TypeOf((*MockUserUseCase)(nil).SendWelcomeEmail), ctx, payload)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserUseCase) UpdateUser(ctx context.Context, user *model.User) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_event_subscriber.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook_event_subscriber.go -destination=../../tests/mock/usecase/webhook_event_subscriber.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/AI1411/fullstack-react-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookEventSubscriber is a mock of WebhookEventSubscriber interface.
+type MockWebhookEventSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookEventSubscriberMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookEventSubscriberMockRecorder is the mock recorder for MockWebhookEventSubscriber.
+type MockWebhookEventSubscriberMockRecorder struct {
+	mock *MockWebhookEventSubscriber
+}
+
+// NewMockWebhookEventSubscriber creates a new mock instance.
+func NewMockWebhookEventSubscriber(ctrl *gomock.Controller) *MockWebhookEventSubscriber {
+	mock := &MockWebhookEventSubscriber{ctrl: ctrl}
+	mock.recorder = &MockWebhookEventSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookEventSubscriber) EXPECT() *MockWebhookEventSubscriberMockRecorder {
+	return m.recorder
+}
+
+// OnApplicationTransitioned mocks base method.
+func (m *MockWebhookEventSubscriber) OnApplicationTransitioned(ctx context.Context, eventID int64, event model.ApplicationTransitionedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnApplicationTransitioned", ctx, eventID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnApplicationTransitioned indicates an expected call of OnApplicationTransitioned.
+func (mr *MockWebhookEventSubscriberMockRecorder) OnApplicationTransitioned(ctx, eventID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnApplicationTransitioned", reflect.TypeOf((*MockWebhookEventSubscriber)(nil).OnApplicationTransitioned), ctx, eventID, event)
+}
+
+// OnDisasterCreated mocks base method.
+func (m *MockWebhookEventSubscriber) OnDisasterCreated(ctx context.Context, eventID int64, event model.DisasterCreatedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnDisasterCreated", ctx, eventID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnDisasterCreated indicates an expected call of OnDisasterCreated.
+func (mr *MockWebhookEventSubscriberMockRecorder) OnDisasterCreated(ctx, eventID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnDisasterCreated", reflect.TypeOf((*MockWebhookEventSubscriber)(nil).OnDisasterCreated), ctx, eventID, event)
+}
+
+// OnDisasterStatusChanged mocks base method.
+func (m *MockWebhookEventSubscriber) OnDisasterStatusChanged(ctx context.Context, eventID int64, event model.DisasterStatusChangedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnDisasterStatusChanged", ctx, eventID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnDisasterStatusChanged indicates an expected call of OnDisasterStatusChanged.
+func (mr *MockWebhookEventSubscriberMockRecorder) OnDisasterStatusChanged(ctx, eventID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnDisasterStatusChanged", reflect.TypeOf((*MockWebhookEventSubscriber)(nil).OnDisasterStatusChanged), ctx, eventID, event)
+}
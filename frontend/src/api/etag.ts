@@ -0,0 +1,11 @@
+// Helpers for the optimistic concurrency control of the update APIs
+import type { AxiosResponse } from "axios"
+
+// Returns the ETag header of a GET response. Updates must send it back as If-Match:
+// the API rejects an update without it (428) or when the record was modified after it was fetched (412).
+export const getETag = (response?: AxiosResponse): string | undefined => {
+  return response?.headers.etag as string | undefined
+}
+
+// Request options that send the ETag as If-Match
+export const ifMatch = (etag: string) => ({ headers: { "If-Match": etag } })
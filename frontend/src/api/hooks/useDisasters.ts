@@ -7,6 +7,7 @@ import {
   listDisasters,
   updateDisaster,
 } from "../generated/client"
+import { ifMatch } from "../etag"
 import type { HandlerDisasterResponse, HandlerUpdateDisasterRequest, ListDisastersParams } from "../generated/model"
 
 // Hook for fetching all disasters
@@ -40,12 +41,13 @@ export const useCreateDisaster = () => {
 }
 
 // Hook for updating an existing disaster
+// etag is the ETag of the disaster fetched with useDisaster: getETag(useDisaster(id).data)
 export const useUpdateDisaster = () => {
   const queryClient = useQueryClient()
 
   return useMutation({
-    mutationFn: ({ id, data }: { id: string; data: HandlerUpdateDisasterRequest }) => 
-      updateDisaster(id, data),
+    mutationFn: ({ id, data, etag }: { id: string; data: HandlerUpdateDisasterRequest; etag: string }) =>
+      updateDisaster(id, data, ifMatch(etag)),
     onSuccess: (response) => {
       const disaster = response.data as HandlerDisasterResponse
       // Invalidate specific queries
@@ -1,7 +1,7 @@
 // Base Axios configuration for API requests
 import axios from 'axios'
 
-const baseURL = import.meta.env.VITE_API_BASE_URL || 'http://localhost:8080/api'
+const baseURL = import.meta.env.VITE_API_BASE_URL || 'http://localhost:8080/api/v1'
 
 // Create a custom Axios instance with base configuration
 const baseAxios = axios.create({
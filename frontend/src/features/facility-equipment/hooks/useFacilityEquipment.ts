@@ -0,0 +1,16 @@
+import { useQuery } from "@tanstack/react-query"
+import axios from "axios"
+import { getETag } from "../../../api/etag"
+import type { FacilityEquipment } from "./useFacilityEquipments"
+
+// etag is sent back as If-Match by useUpdateFacilityEquipment
+export const useFacilityEquipment = (facilityEquipmentId: string) => {
+  return useQuery<{ facilityEquipment: FacilityEquipment; etag?: string }>({
+    queryKey: ["facilityEquipment", facilityEquipmentId],
+    queryFn: async () => {
+      const response = await axios.get(`/facility-equipment/${facilityEquipmentId}`)
+      return { facilityEquipment: response.data, etag: getETag(response) }
+    },
+    enabled: !!facilityEquipmentId,
+  })
+}
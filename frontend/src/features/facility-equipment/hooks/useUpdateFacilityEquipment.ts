@@ -0,0 +1,18 @@
+import { useMutation, useQueryClient } from "@tanstack/react-query"
+import { ifMatch } from "../../../api/etag"
+import { updateFacilityEquipment } from "../../../api/generated/client"
+import type { HandlerUpdateFacilityEquipmentRequest } from "../../../api/generated/model"
+
+// etag is the ETag of the facility equipment fetched with useFacilityEquipment
+export const useUpdateFacilityEquipment = () => {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({ id, data, etag }: { id: number; data: HandlerUpdateFacilityEquipmentRequest; etag: string }) =>
+      updateFacilityEquipment(id, data, ifMatch(etag)),
+    onSuccess: (_, { id }) => {
+      queryClient.invalidateQueries({ queryKey: ["facilityEquipments"] })
+      queryClient.invalidateQueries({ queryKey: ["facilityEquipment", String(id)] })
+    },
+  })
+}
@@ -7,7 +7,8 @@ export const OrganizationDetail = () => {
   const { organizationId } = useParams({ from: "/organizations/$organizationId" })
 
   // Fetch the organization details
-  const { data: organization, isLoading, error } = useOrganization(organizationId)
+  const { data, isLoading, error } = useOrganization(organizationId)
+  const organization = data?.organization
 
   if (isLoading) {
     return <div className="p-4">読み込み中...</div>
@@ -1,13 +1,15 @@
 import { useQuery } from "@tanstack/react-query"
 import axios from "axios"
+import { getETag } from "../../../api/etag"
 import type { Organization } from "./useOrganizations"
 
+// etag is sent back as If-Match by useUpdateOrganization
 export const useOrganization = (organizationId: string) => {
-  return useQuery<Organization>({
+  return useQuery<{ organization: Organization; etag?: string }>({
     queryKey: ["organization", organizationId],
     queryFn: async () => {
-      const { data } = await axios.get(`/organizations/${organizationId}`)
-      return data
+      const response = await axios.get(`/organizations/${organizationId}`)
+      return { organization: response.data, etag: getETag(response) }
     },
     enabled: !!organizationId,
   })
@@ -0,0 +1,18 @@
+import { useMutation, useQueryClient } from "@tanstack/react-query"
+import { ifMatch } from "../../../api/etag"
+import { updateOrganization } from "../../../api/generated/client"
+import type { HandlerUpdateOrganizationRequest } from "../../../api/generated/model"
+
+// etag is the ETag of the organization fetched with useOrganization
+export const useUpdateOrganization = () => {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({ id, data, etag }: { id: number; data: HandlerUpdateOrganizationRequest; etag: string }) =>
+      updateOrganization(id, data, ifMatch(etag)),
+    onSuccess: (_, { id }) => {
+      queryClient.invalidateQueries({ queryKey: ["organizations"] })
+      queryClient.invalidateQueries({ queryKey: ["organization", String(id)] })
+    },
+  })
+}
@@ -9,7 +9,7 @@ export const AxiosProvider: React.FC<{ children: React.ReactNode }> = ({
   children,
 }) => {
   // Configure axios defaults if needed
-  axios.defaults.baseURL = import.meta.env.VITE_API_URL || "http://localhost:8080"
+  axios.defaults.baseURL = import.meta.env.VITE_API_URL || "http://localhost:8080/api/v1"
 
   return (
     <AxiosContext.Provider value={axios}>{children}</AxiosContext.Provider>